@@ -0,0 +1,145 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const blackoutDateLayout = "2006-01-02"
+
+var weekdays = map[Weekday]time.Weekday{
+	"Sun": time.Sunday,
+	"Mon": time.Monday,
+	"Tue": time.Tuesday,
+	"Wed": time.Wednesday,
+	"Thu": time.Thursday,
+	"Fri": time.Friday,
+	"Sat": time.Saturday,
+}
+
+// Location returns the time zone of the scheduler
+func (in *SchedulerSpec) Location() (*time.Location, error) {
+	if in.TimeZone == "" {
+		return time.Local, nil
+	}
+	return time.LoadLocation(in.TimeZone)
+}
+
+// Weekday converts the day to time.Weekday
+func (in Weekday) Weekday() (time.Weekday, error) {
+	day, ok := weekdays[in]
+	if !ok {
+		return 0, fmt.Errorf("unknown day %q", in)
+	}
+	return day, nil
+}
+
+// Includes returns whether the window applies to the day
+func (in *TimeWindow) Includes(day time.Weekday) bool {
+	if len(in.Days) == 0 {
+		return true
+	}
+	for _, d := range in.Days {
+		if weekday, err := d.Weekday(); err == nil && weekday == day {
+			return true
+		}
+	}
+	return false
+}
+
+// Parse returns the start and the end of the window as the offsets from the start of the day
+func (in *TimeWindow) Parse() (start, end time.Duration, err error) {
+	if start, err = parseTimeOfDay(in.Start); err != nil {
+		return 0, 0, err
+	}
+	if end, err = parseTimeOfDay(in.End); err != nil {
+		return 0, 0, err
+	}
+	if end <= start {
+		return 0, 0, fmt.Errorf("the end %s should be after the start %s", in.End, in.Start)
+	}
+	for _, day := range in.Days {
+		if _, err := day.Weekday(); err != nil {
+			return 0, 0, err
+		}
+	}
+	return start, end, nil
+}
+
+// parseTimeOfDay parses a time in the format 15:04, and 24:00 means the end of the day
+func parseTimeOfDay(value string) (time.Duration, error) {
+	parts := strings.Split(value, ":")
+	if len(parts) != 2 || len(parts[0]) != 2 || len(parts[1]) != 2 {
+		return 0, fmt.Errorf("time %q should be in the format 15:04", value)
+	}
+	hour, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, fmt.Errorf("time %q should be in the format 15:04", value)
+	}
+	minute, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, fmt.Errorf("time %q should be in the format 15:04", value)
+	}
+	if hour < 0 || hour > 24 || minute < 0 || minute > 59 || (hour == 24 && minute != 0) {
+		return 0, fmt.Errorf("time %q is out of range", value)
+	}
+	return time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute, nil
+}
+
+// NewBlackoutPeriod creates a BlackoutPeriod from a date like 2020-12-25,
+// or a period like 2020-12-24/2020-12-27 whose start and end are dates or RFC3339 times
+func NewBlackoutPeriod(name, value string) BlackoutPeriod {
+	start, end := value, value
+	if parts := strings.SplitN(value, "/", 2); len(parts) == 2 {
+		start, end = parts[0], parts[1]
+	}
+	return BlackoutPeriod{
+		Name:  name,
+		Start: strings.TrimSpace(start),
+		End:   strings.TrimSpace(end),
+	}
+}
+
+// Parse returns the start and the end of the blackout, the dates are parsed in the location
+func (in *BlackoutPeriod) Parse(loc *time.Location) (start, end time.Time, err error) {
+	if start, err = parseBlackoutTime(in.Start, loc, false); err != nil {
+		return
+	}
+	if end, err = parseBlackoutTime(in.End, loc, true); err != nil {
+		return
+	}
+	if !end.After(start) {
+		err = fmt.Errorf("the end %s of blackout %q should be after the start %s", in.End, in.Name, in.Start)
+	}
+	return
+}
+
+// parseBlackoutTime parses a date or a RFC3339 time, the end of a date is the start of the next day
+func parseBlackoutTime(value string, loc *time.Location, isEnd bool) (time.Time, error) {
+	if t, err := time.ParseInLocation(blackoutDateLayout, value, loc); err == nil {
+		if isEnd {
+			t = t.AddDate(0, 0, 1)
+		}
+		return t, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%q should be a date like 2006-01-02 or a RFC3339 time", value)
+	}
+	return t, nil
+}
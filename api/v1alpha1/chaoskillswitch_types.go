@@ -0,0 +1,68 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DefaultChaosKillSwitchName is the name of the ChaosKillSwitch tripped by the dashboard
+const DefaultChaosKillSwitchName = "chaos-mesh"
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+
+// ChaosKillSwitch stops all the chaos experiments in the cluster while it is enabled.
+// The running experiments are recovered and marked as paused, and no chaos is applied
+// until all the ChaosKillSwitches are disabled or deleted.
+type ChaosKillSwitch struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines whether the kill switch is tripped
+	Spec ChaosKillSwitchSpec `json:"spec"`
+}
+
+// ChaosKillSwitchSpec defines whether the kill switch is tripped
+type ChaosKillSwitchSpec struct {
+	// Enabled stops all the chaos experiments
+	Enabled bool `json:"enabled"`
+
+	// Reason describes why the kill switch is tripped
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ChaosKillSwitchList contains a list of ChaosKillSwitch
+type ChaosKillSwitchList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ChaosKillSwitch `json:"items"`
+}
+
+// Enabled returns the first enabled ChaosKillSwitch, or nil if all of them are disabled
+func (in *ChaosKillSwitchList) Enabled() *ChaosKillSwitch {
+	for i := range in.Items {
+		if in.Items[i].Spec.Enabled {
+			return &in.Items[i]
+		}
+	}
+	return nil
+}
+
+func init() {
+	SchemeBuilder.Register(&ChaosKillSwitch{}, &ChaosKillSwitchList{})
+}
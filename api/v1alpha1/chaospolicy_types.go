@@ -0,0 +1,108 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+
+// ChaosPolicy limits the blast radius of the chaos experiments in the cluster.
+// All the ChaosPolicies are enforced together, so the most restrictive limit takes effect.
+type ChaosPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the limits of the chaos experiments
+	Spec ChaosPolicySpec `json:"spec"`
+}
+
+// ChaosPolicySpec defines the limits of the chaos experiments
+type ChaosPolicySpec struct {
+	// MaxPods is the max number of targets injected by one experiment.
+	// The targets of NodeChaos draining nodes are the evicted pods, and the experiments
+	// whose affected pods can't be counted are rejected if MaxPods or MaxPercent is set.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxPods *int32 `json:"maxPods,omitempty"`
+
+	// MaxPercent is the max percent of the candidates injected by one experiment.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	MaxPercent *int32 `json:"maxPercent,omitempty"`
+
+	// MaxConcurrentExperiments is the max number of experiments running at the same time.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxConcurrentExperiments *int32 `json:"maxConcurrentExperiments,omitempty"`
+
+	// Namespaces restricts the kinds of experiments allowed in the namespaces.
+	// The namespaces not listed here allow all kinds.
+	// +optional
+	Namespaces []NamespacePolicy `json:"namespaces,omitempty"`
+}
+
+// NamespacePolicy defines the kinds of experiments allowed in a namespace
+type NamespacePolicy struct {
+	// Namespace is the namespace of the experiments or of their targets
+	Namespace string `json:"namespace"`
+
+	// AllowedKinds is the kinds of experiments allowed in the namespace, e.g. PodChaos
+	// +optional
+	AllowedKinds []string `json:"allowedKinds,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ChaosPolicyList contains a list of ChaosPolicy
+type ChaosPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ChaosPolicy `json:"items"`
+}
+
+// +kubebuilder:object:generate=false
+
+// ChaosPolicyChecker checks whether a chaos experiment is allowed by the ChaosPolicies
+type ChaosPolicyChecker interface {
+	// Check returns an error describing the violated policy if the chaos is not allowed.
+	// The number of running experiments is only checked if checkRunning is true.
+	Check(ctx context.Context, chaos InnerObject, checkRunning bool) error
+}
+
+var chaosPolicyChecker ChaosPolicyChecker
+
+// RegisterChaosPolicyChecker registers checker into webhook
+func RegisterChaosPolicyChecker(checker ChaosPolicyChecker) {
+	chaosPolicyChecker = checker
+}
+
+// validateChaosPolicy checks the chaos against the ChaosPolicies if a checker is registered
+// the running experiments are only counted when the chaos is created.
+// The chaos in the dry run mode is admitted, so that the violations are reported in its status.
+func validateChaosPolicy(chaos InnerObject, create bool) error {
+	if chaosPolicyChecker == nil || chaos.IsDryRun() {
+		return nil
+	}
+	return chaosPolicyChecker.Check(context.Background(), chaos, create)
+}
+
+func init() {
+	SchemeBuilder.Register(&ChaosPolicy{}, &ChaosPolicyList{})
+}
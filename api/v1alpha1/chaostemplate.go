@@ -0,0 +1,212 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// parameterRefRegex matches the references to the parameters like "$(latency)"
+var parameterRefRegex = regexp.MustCompile(`\$\(([a-zA-Z_][a-zA-Z0-9_]*)\)`)
+
+// OrDefault returns the type, or String if it is not set
+func (in TemplateParameterType) OrDefault() TemplateParameterType {
+	if in == "" {
+		return StringParameterType
+	}
+	return in
+}
+
+// Instantiate renders the template with the values of the parameters into a chaos named
+// name in namespace. The default values are used for the parameters missing in values.
+func (in *ChaosTemplate) Instantiate(name, namespace string, values map[string]string) (InnerObject, error) {
+	kind, ok := AllKinds()[in.Spec.Kind]
+	if !ok {
+		return nil, fmt.Errorf("unsupported chaos kind %s", in.Spec.Kind)
+	}
+
+	params, err := in.resolveParameters(values)
+	if err != nil {
+		return nil, err
+	}
+
+	var spec interface{}
+	if err := json.Unmarshal(in.Spec.Template.Raw, &spec); err != nil {
+		return nil, fmt.Errorf("template of %s is invalid: %v", in.Name, err)
+	}
+	spec, err = renderTemplate(spec, params)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := json.Marshal(map[string]interface{}{
+		"apiVersion": GroupVersion.String(),
+		"kind":       in.Spec.Kind,
+		"metadata": metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels: map[string]string{
+				ChaosTemplateLabelKey: in.Name,
+			},
+		},
+		"spec": spec,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	chaos, ok := kind.Chaos.DeepCopyObject().(InnerObject)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a chaos", in.Spec.Kind)
+	}
+	// unknown fields are rejected, so that a typo in the template won't be ignored silently
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(chaos); err != nil {
+		return nil, fmt.Errorf("template of %s is not a valid %s: %v", in.Name, in.Spec.Kind, err)
+	}
+
+	return chaos, nil
+}
+
+// resolveParameters returns the typed values of all the parameters
+func (in *ChaosTemplate) resolveParameters(values map[string]string) (map[string]interface{}, error) {
+	params := make(map[string]interface{}, len(in.Spec.Parameters))
+	for _, param := range in.Spec.Parameters {
+		value, ok := values[param.Name]
+		if !ok {
+			if param.Default == nil {
+				return nil, fmt.Errorf("parameter %s is required", param.Name)
+			}
+			value = *param.Default
+		}
+
+		typed, err := param.parse(value)
+		if err != nil {
+			return nil, err
+		}
+		params[param.Name] = typed
+	}
+
+	for name := range values {
+		if _, ok := params[name]; !ok {
+			return nil, fmt.Errorf("parameter %s is not defined in template %s", name, in.Name)
+		}
+	}
+
+	return params, nil
+}
+
+// parse checks the value of the parameter and converts it into its type
+func (in *TemplateParameter) parse(value string) (interface{}, error) {
+	if len(in.AllowedValues) > 0 {
+		allowed := false
+		for _, v := range in.AllowedValues {
+			if v == value {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return nil, fmt.Errorf("value %q of parameter %s is not one of %v", value, in.Name, in.AllowedValues)
+		}
+	}
+
+	switch in.Type.OrDefault() {
+	case StringParameterType:
+		return value, nil
+	case IntegerParameterType:
+		i, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("value %q of parameter %s is not an integer", value, in.Name)
+		}
+		return i, nil
+	case BooleanParameterType:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("value %q of parameter %s is not a boolean", value, in.Name)
+		}
+		return b, nil
+	case DurationParameterType:
+		if _, err := time.ParseDuration(value); err != nil {
+			return nil, fmt.Errorf("value %q of parameter %s is not a duration", value, in.Name)
+		}
+		return value, nil
+	default:
+		return nil, fmt.Errorf("type %s of parameter %s is not supported", in.Type, in.Name)
+	}
+}
+
+// renderTemplate replaces the references to the parameters in the strings of the template
+func renderTemplate(template interface{}, params map[string]interface{}) (interface{}, error) {
+	switch t := template.(type) {
+	case map[string]interface{}:
+		for k, v := range t {
+			rendered, err := renderTemplate(v, params)
+			if err != nil {
+				return nil, err
+			}
+			t[k] = rendered
+		}
+		return t, nil
+	case []interface{}:
+		for i, v := range t {
+			rendered, err := renderTemplate(v, params)
+			if err != nil {
+				return nil, err
+			}
+			t[i] = rendered
+		}
+		return t, nil
+	case string:
+		return renderString(t, params)
+	default:
+		return t, nil
+	}
+}
+
+// renderString returns the typed value if the string is exactly a reference,
+// otherwise the references are replaced by the values in text
+func renderString(s string, params map[string]interface{}) (interface{}, error) {
+	if match := parameterRefRegex.FindStringSubmatch(s); match != nil && match[0] == s {
+		value, ok := params[match[1]]
+		if !ok {
+			return nil, fmt.Errorf("parameter %s is not defined", match[1])
+		}
+		return value, nil
+	}
+
+	var err error
+	rendered := parameterRefRegex.ReplaceAllStringFunc(s, func(ref string) string {
+		name := parameterRefRegex.FindStringSubmatch(ref)[1]
+		value, ok := params[name]
+		if !ok {
+			err = fmt.Errorf("parameter %s is not defined", name)
+			return ref
+		}
+		return fmt.Sprint(value)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rendered, nil
+}
@@ -0,0 +1,110 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ChaosTemplateLabelKey is the label of the chaos instantiated from a ChaosTemplate,
+// whose value is the name of the template
+const ChaosTemplateLabelKey = "chaos-mesh.org/template"
+
+// +kubebuilder:object:root=true
+
+// ChaosTemplate holds a parameterized spec of a chaos, which is instantiated into a
+// concrete chaos experiment by giving the values of its parameters.
+type ChaosTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the kind, the parameters and the spec of the chaos
+	Spec ChaosTemplateSpec `json:"spec"`
+}
+
+// ChaosTemplateSpec defines the kind, the parameters and the spec of the chaos
+type ChaosTemplateSpec struct {
+	// Kind is the kind of the chaos instantiated from the template, e.g. NetworkChaos
+	Kind string `json:"kind"`
+
+	// Description describes the scenario of the template
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Parameters defines the parameters which can be referred in the template
+	// +optional
+	Parameters []TemplateParameter `json:"parameters,omitempty"`
+
+	// Template is the spec of the chaos, where "$(name)" refers to the value of the parameter.
+	// A string which is exactly "$(name)" is replaced by the typed value of the parameter,
+	// otherwise the reference is replaced by the value in text.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Template runtime.RawExtension `json:"template"`
+}
+
+// TemplateParameterType represents the type of a template parameter
+type TemplateParameterType string
+
+const (
+	// StringParameterType is the default type of a parameter
+	StringParameterType TemplateParameterType = "String"
+
+	// IntegerParameterType is rendered as a number
+	IntegerParameterType TemplateParameterType = "Integer"
+
+	// BooleanParameterType is rendered as true or false
+	BooleanParameterType TemplateParameterType = "Boolean"
+
+	// DurationParameterType is rendered as a string, which must be a valid duration like "30s"
+	DurationParameterType TemplateParameterType = "Duration"
+)
+
+// TemplateParameter defines a parameter of a ChaosTemplate
+type TemplateParameter struct {
+	// Name is the name of the parameter, which is referred as "$(name)" in the template
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z_][a-zA-Z0-9_]*$`
+	Name string `json:"name"`
+
+	// Type is the type of the parameter, String by default
+	// +kubebuilder:validation:Enum=String;Integer;Boolean;Duration
+	// +optional
+	Type TemplateParameterType `json:"type,omitempty"`
+
+	// Description describes the parameter
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Default is the value used if the parameter is not given on instantiation.
+	// The parameter is required if it has no default value.
+	// +optional
+	Default *string `json:"default,omitempty"`
+
+	// AllowedValues restricts the values of the parameter if it is not empty
+	// +optional
+	AllowedValues []string `json:"allowedValues,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ChaosTemplateList contains a list of ChaosTemplate
+type ChaosTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ChaosTemplate `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ChaosTemplate{}, &ChaosTemplateList{})
+}
@@ -0,0 +1,113 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ = Describe("ChaosTemplate", func() {
+	Context("Instantiate", func() {
+		defaultLatency := "200ms"
+		defaultZone := "zone-a"
+		newTemplate := func(kind string, template string, params ...TemplateParameter) *ChaosTemplate {
+			return &ChaosTemplate{
+				ObjectMeta: metav1.ObjectMeta{Name: "zone-latency", Namespace: "platform"},
+				Spec: ChaosTemplateSpec{
+					Kind:       kind,
+					Parameters: params,
+					Template:   runtime.RawExtension{Raw: []byte(template)},
+				},
+			}
+		}
+		latencyTemplate := newTemplate(KindNetworkChaos, `{
+			"action": "delay",
+			"mode": "all",
+			"selector": {"labelSelectors": {"topology.kubernetes.io/zone": "$(zone)"}},
+			"delay": {"latency": "$(latency)"},
+			"duration": "$(duration)"
+		}`,
+			TemplateParameter{Name: "zone", AllowedValues: []string{"zone-a", "zone-b"}, Default: &defaultZone},
+			TemplateParameter{Name: "latency", Type: DurationParameterType, Default: &defaultLatency},
+			TemplateParameter{Name: "duration", Type: DurationParameterType},
+		)
+
+		It("should render the parameters with defaults", func() {
+			chaos, err := latencyTemplate.Instantiate("latency", "app", map[string]string{"duration": "30s"})
+			Expect(err).ToNot(HaveOccurred())
+
+			networkChaos, ok := chaos.(*NetworkChaos)
+			Expect(ok).To(BeTrue())
+			Expect(networkChaos.Name).To(Equal("latency"))
+			Expect(networkChaos.Namespace).To(Equal("app"))
+			Expect(networkChaos.Labels).To(HaveKeyWithValue(ChaosTemplateLabelKey, "zone-latency"))
+			Expect(networkChaos.Spec.Action).To(Equal(DelayAction))
+			Expect(networkChaos.Spec.Selector.LabelSelectors).To(HaveKeyWithValue("topology.kubernetes.io/zone", "zone-a"))
+			Expect(networkChaos.Spec.Delay.Latency).To(Equal("200ms"))
+			Expect(*networkChaos.Spec.Duration).To(Equal("30s"))
+		})
+
+		It("should reject invalid parameters", func() {
+			_, err := latencyTemplate.Instantiate("latency", "app", nil)
+			Expect(err).To(MatchError("parameter duration is required"))
+
+			_, err = latencyTemplate.Instantiate("latency", "app", map[string]string{"duration": "30s", "zone": "zone-c"})
+			Expect(err).To(HaveOccurred())
+
+			_, err = latencyTemplate.Instantiate("latency", "app", map[string]string{"duration": "30"})
+			Expect(err).To(HaveOccurred())
+
+			_, err = latencyTemplate.Instantiate("latency", "app", map[string]string{"duration": "30s", "loss": "10"})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should render typed values", func() {
+			template := newTemplate(KindStressChaos, `{
+				"mode": "one",
+				"selector": {"namespaces": ["$(namespace)"]},
+				"stressors": {"memory": {"workers": "$(workers)", "size": "$(size)Mi"}}
+			}`,
+				TemplateParameter{Name: "namespace"},
+				TemplateParameter{Name: "workers", Type: IntegerParameterType},
+				TemplateParameter{Name: "size", Type: IntegerParameterType},
+			)
+
+			chaos, err := template.Instantiate("stress", "app", map[string]string{"namespace": "app", "workers": "2", "size": "256"})
+			Expect(err).ToNot(HaveOccurred())
+
+			stressChaos := chaos.(*StressChaos)
+			Expect(stressChaos.Spec.Selector.Namespaces).To(Equal([]string{"app"}))
+			Expect(stressChaos.Spec.Stressors.MemoryStressor.Workers).To(Equal(2))
+			Expect(stressChaos.Spec.Stressors.MemoryStressor.Size).To(Equal("256Mi"))
+
+			_, err = template.Instantiate("stress", "app", map[string]string{"namespace": "app", "workers": "two", "size": "256"})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject invalid templates", func() {
+			_, err := newTemplate("UnknownChaos", `{}`).Instantiate("foo", "app", nil)
+			Expect(err).To(HaveOccurred())
+
+			_, err = newTemplate(KindPodChaos, `{"action": "$(action)"}`).Instantiate("foo", "app", nil)
+			Expect(err).To(MatchError("parameter action is not defined"))
+
+			_, err = newTemplate(KindPodChaos, `{"actions": "pod-kill"}`).Instantiate("foo", "app", nil)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -24,6 +24,19 @@ import (
 const (
 	// PauseAnnotationKey defines the annotation used to pause a chaos
 	PauseAnnotationKey = "experiment.chaos-mesh.org/pause"
+
+	// DryRunAnnotationKey defines the annotation used to preview a chaos, the targets and the rules
+	// are recorded in the status instead of being injected
+	DryRunAnnotationKey = "experiment.chaos-mesh.org/dry-run"
+
+	// ProtectedAnnotationKey defines the annotation used to protect an object from being selected by any chaos
+	ProtectedAnnotationKey = "chaos-mesh.org/protected"
+
+	// NamespaceInjectAnnotationKey defines the annotation used by a namespace to opt in to chaos,
+	// it only takes effect when the namespace filter of the controller is enabled
+	NamespaceInjectAnnotationKey = "chaos-mesh.org/inject"
+	// NamespaceInjectEnabled is the value of NamespaceInjectAnnotationKey which enables chaos in the namespace
+	NamespaceInjectEnabled = "enabled"
 )
 
 // SelectorSpec defines the some selectors to select objects.
@@ -69,6 +82,12 @@ type SelectorSpec struct {
 	// +optional
 	LabelSelectors map[string]string `json:"labelSelectors,omitempty"`
 
+	// ExpressionSelectors is a list of set-based label selector requirements that can be used
+	// to select objects, the operators `In`, `NotIn`, `Exists` and `DoesNotExist` are supported.
+	// The requirements are ANDed with LabelSelectors.
+	// +optional
+	ExpressionSelectors LabelSelectorRequirements `json:"expressionSelectors,omitempty"`
+
 	// Map of string keys and values that can be used to select objects.
 	// A selector based on annotations.
 	// +optional
@@ -78,6 +97,138 @@ type SelectorSpec struct {
 	// supported value: Pending / Running / Succeeded / Failed / Unknown
 	// +optional
 	PodPhaseSelectors []string `json:"podPhaseSelectors,omitempty"`
+
+	// Workloads is a set of workloads whose pods are selected through owner references.
+	// Pods are listed from the namespaces of the workloads, so Namespaces is ignored
+	// when Workloads is set. Other selectors are still ANDed with it.
+	// +optional
+	Workloads []WorkloadSelector `json:"workloads,omitempty"`
+
+	// Components is a set of native components of Kubernetes whose pods are selected.
+	// The pods of the components are listed from the kube-system namespace, so Namespaces is ignored
+	// when Components is set. The components are combined with Workloads if both are set.
+	// +optional
+	Components []KubeComponent `json:"components,omitempty"`
+
+	// Exclude is a selector whose selected objects are removed from the selected objects.
+	// It is applied after the other selectors and can not be nested.
+	// +kubebuilder:validation:Type=object
+	// +optional
+	Exclude *SelectorSpec `json:"exclude,omitempty"`
+}
+
+// LabelSelectorRequirements is a list of label selector requirements
+type LabelSelectorRequirements []metav1.LabelSelectorRequirement
+
+// KubeSystemNamespace is the namespace of the native components of Kubernetes
+const KubeSystemNamespace = "kube-system"
+
+// KubeComponent represents a native component of Kubernetes
+// +kubebuilder:validation:Enum=kube-apiserver;etcd;kube-scheduler;kube-controller-manager;coredns
+type KubeComponent string
+
+const (
+	// KubeAPIServerComponent selects the static pods of kube-apiserver
+	KubeAPIServerComponent KubeComponent = "kube-apiserver"
+
+	// EtcdComponent selects the static pods of etcd
+	EtcdComponent KubeComponent = "etcd"
+
+	// KubeSchedulerComponent selects the static pods of kube-scheduler
+	KubeSchedulerComponent KubeComponent = "kube-scheduler"
+
+	// KubeControllerManagerComponent selects the static pods of kube-controller-manager
+	KubeControllerManagerComponent KubeComponent = "kube-controller-manager"
+
+	// CoreDNSComponent selects the pods of the coredns Deployment
+	CoreDNSComponent KubeComponent = "coredns"
+)
+
+// IsStaticPod returns whether the component runs as static pods on the control-plane nodes.
+// The mirror pods of a static component are named `<component>-<node name>`,
+// and the container running the component has the same name as the component.
+func (in KubeComponent) IsStaticPod() bool {
+	switch in {
+	case KubeAPIServerComponent, EtcdComponent, KubeSchedulerComponent, KubeControllerManagerComponent:
+		return true
+	default:
+		return false
+	}
+}
+
+// Workload returns the workload which owns the pods of the component,
+// it returns nil for the static components
+func (in KubeComponent) Workload() *WorkloadSelector {
+	switch in {
+	case CoreDNSComponent:
+		return &WorkloadSelector{
+			Kind:      DeploymentWorkload,
+			Namespace: KubeSystemNamespace,
+			Name:      string(CoreDNSComponent),
+		}
+	default:
+		return nil
+	}
+}
+
+// WorkloadKind represents the kind of a workload which owns pods
+type WorkloadKind string
+
+const (
+	// DeploymentWorkload selects the pods of a Deployment
+	DeploymentWorkload WorkloadKind = "Deployment"
+
+	// StatefulSetWorkload selects the pods of a StatefulSet
+	StatefulSetWorkload WorkloadKind = "StatefulSet"
+
+	// ReplicaSetWorkload selects the pods of a ReplicaSet
+	ReplicaSetWorkload WorkloadKind = "ReplicaSet"
+
+	// DaemonSetWorkload selects the pods of a DaemonSet
+	DaemonSetWorkload WorkloadKind = "DaemonSet"
+
+	// JobWorkload selects the pods of a Job
+	JobWorkload WorkloadKind = "Job"
+)
+
+// WorkloadSelector selects the pods owned by a workload
+type WorkloadSelector struct {
+	// Kind is the kind of the workload.
+	// Supported kinds: Deployment / StatefulSet / ReplicaSet / DaemonSet / Job
+	// +kubebuilder:validation:Enum=Deployment;StatefulSet;ReplicaSet;DaemonSet;Job
+	Kind WorkloadKind `json:"kind"`
+
+	// Namespace is the namespace of the workload,
+	// default to the namespace of the chaos object.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name is the name of the workload.
+	Name string `json:"name"`
+
+	// Ordinals limits the selected pods to a range of ordinals,
+	// it can only be used with StatefulSet.
+	// +optional
+	Ordinals *OrdinalRange `json:"ordinals,omitempty"`
+}
+
+// OrdinalRange is a closed range of StatefulSet pod ordinals
+type OrdinalRange struct {
+	// Start is the first ordinal in the range.
+	// +kubebuilder:validation:Minimum=0
+	Start int32 `json:"start"`
+
+	// End is the last ordinal in the range, the range is unbounded if it is not set.
+	// +optional
+	End *int32 `json:"end,omitempty"`
+}
+
+// Contains returns whether the ordinal is in the range
+func (in *OrdinalRange) Contains(ordinal int32) bool {
+	if ordinal < in.Start {
+		return false
+	}
+	return in.End == nil || ordinal <= *in.End
 }
 
 // SchedulerSpec defines information about schedule of the chaos experiment.
@@ -91,8 +242,151 @@ type SchedulerSpec struct {
 	//
 	// More rule info: https://godoc.org/github.com/robfig/cron
 	Cron string `json:"cron"`
+
+	// TimeZone is the IANA time zone of the cron, the allowed windows and the blackouts, e.g. Europe/Berlin.
+	// The local time zone of the controller manager is used if it is not set.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// AllowedWindows are the time windows in which the chaos is allowed to start.
+	// The chaos is allowed to start at any time if no window is defined.
+	// +optional
+	AllowedWindows []TimeWindow `json:"allowedWindows,omitempty"`
+
+	// Blackouts are the periods in which the chaos is never started, e.g. release freezes and holidays.
+	// +optional
+	Blackouts []BlackoutPeriod `json:"blackouts,omitempty"`
+
+	// BlackoutConfigMap is the name of a ConfigMap in the namespace of the chaos, which defines more blackouts.
+	// The key of each entry is the name of the blackout, and the value is a date like 2020-12-25,
+	// or a period like 2020-12-24/2020-12-27 whose start and end are dates or RFC3339 times.
+	// +optional
+	BlackoutConfigMap string `json:"blackoutConfigMap,omitempty"`
+
+	// Jitter is the max random offset added to each scheduled start, e.g. "10m".
+	// The offset of each round is drawn uniformly from [0, jitter).
+	// +optional
+	Jitter string `json:"jitter,omitempty"`
+
+	// Probability is the percent chance that the chaos is started on each scheduled time.
+	// The chaos is always started if it is not set.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Probability *int32 `json:"probability,omitempty"`
+
+	// RandomDuration is the range of the duration of each round, which overrides the duration of the chaos.
+	// +optional
+	RandomDuration *DurationRange `json:"randomDuration,omitempty"`
+}
+
+// DurationRange defines a range of durations, the bounds are included
+type DurationRange struct {
+	// Min is the min duration, e.g. "30s"
+	Min string `json:"min"`
+
+	// Max is the max duration, e.g. "5m"
+	Max string `json:"max"`
+}
+
+// Weekday is a day of the week
+// +kubebuilder:validation:Enum=Mon;Tue;Wed;Thu;Fri;Sat;Sun
+type Weekday string
+
+// TimeWindow defines a time window of some days of the week
+type TimeWindow struct {
+	// Days are the days of the week of the window, the window applies to every day if it is not set.
+	// +optional
+	Days []Weekday `json:"days,omitempty"`
+
+	// Start is the start time of the window in the format 15:04, e.g. 10:00
+	Start string `json:"start"`
+
+	// End is the end time of the window in the format 15:04, e.g. 16:00.
+	// It must be after the start time, and 24:00 means the end of the day.
+	End string `json:"end"`
+}
+
+// BlackoutPeriod defines a period in which chaos is never started
+type BlackoutPeriod struct {
+	// Name describes the blackout, e.g. release-freeze
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Start is the start of the blackout, a date like 2020-12-24 or a RFC3339 time
+	Start string `json:"start"`
+
+	// End is the end of the blackout, a date like 2020-12-27 or a RFC3339 time.
+	// The whole day is included if it is a date.
+	End string `json:"end"`
+}
+
+// FailurePolicyType is the way to handle the targets failed to be injected
+type FailurePolicyType string
+
+const (
+	// RollbackAllPolicy recovers all the injected targets once some targets fail to be injected,
+	// and the chaos is marked as failed
+	RollbackAllPolicy FailurePolicyType = "RollbackAll"
+
+	// ContinuePolicy keeps the injected targets and runs the chaos, unless no target is injected
+	ContinuePolicy FailurePolicyType = "Continue"
+
+	// RetryFailedPolicy retries the failed targets with backoff, and rolls back all the targets
+	// like RollbackAll if some targets still fail after the retries
+	RetryFailedPolicy FailurePolicyType = "RetryFailed"
+)
+
+// FailurePolicy defines how to handle the targets failed to be injected
+type FailurePolicy struct {
+	// Type is the way to handle the failed targets, RollbackAll by default.
+	// +kubebuilder:validation:Enum=RollbackAll;Continue;RetryFailed
+	// +optional
+	Type FailurePolicyType `json:"type,omitempty"`
+
+	// MaxRetries is the max number of the retries of a failed target with the RetryFailed policy, 3 by default.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxRetries *int32 `json:"maxRetries,omitempty"`
+
+	// Backoff is the interval before the first retry with the RetryFailed policy, which is doubled
+	// after each retry, 1s by default.
+	// +optional
+	Backoff string `json:"backoff,omitempty"`
+}
+
+// RetryPolicy defines how to retry the chaos failed to be applied. The failed recovery is always retried,
+// so that the targets are cleaned up eventually.
+type RetryPolicy struct {
+	// Limit is the max number of the retries after the chaos failed to be applied, the chaos is retried
+	// without limit if it is not set. The chaos fails permanently once the limit is exceeded, until its spec is changed.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Limit *int32 `json:"limit,omitempty"`
+
+	// Backoff is the interval before the first retry, which is doubled after each failure, 10s by default.
+	// +optional
+	Backoff string `json:"backoff,omitempty"`
+
+	// MaxBackoff is the max interval between the retries, 5m by default.
+	// +optional
+	MaxBackoff string `json:"maxBackoff,omitempty"`
 }
 
+// ConflictPolicyType is the way to handle the targets leased by other experiments of the same kind
+type ConflictPolicyType string
+
+const (
+	// RejectConflictPolicy fails the injection on the targets leased by other experiments
+	RejectConflictPolicy ConflictPolicyType = "Reject"
+
+	// QueueConflictPolicy injects nothing until all the targets are released by other experiments
+	QueueConflictPolicy ConflictPolicyType = "Queue"
+
+	// MergeConflictPolicy shares the targets with other experiments
+	MergeConflictPolicy ConflictPolicyType = "Merge"
+)
+
 // PodMode represents the mode to run pod chaos action.
 type PodMode string
 
@@ -109,8 +403,26 @@ const (
 	FixedPercentPodMode PodMode = "fixed-percent"
 	// RandomMaxPercentPodMode to specify a maximum % that can be inject chaos action.
 	RandomMaxPercentPodMode PodMode = "random-max-percent"
+	// OnePerZonePodMode represents that the system will do the chaos action on one pod selected randomly
+	// in each zone. The zone of a pod is read from the `topology.kubernetes.io/zone` label of its node.
+	OnePerZonePodMode PodMode = "one-per-zone"
+	// AllInOneZonePodMode represents that the system will do the chaos action on all pods
+	// in one zone selected randomly.
+	AllInOneZonePodMode PodMode = "all-in-one-zone"
+	// FixedSpreadNodesPodMode represents that the system will do the chaos action on a specific number of pods
+	// which are spread across nodes as evenly as possible.
+	FixedSpreadNodesPodMode PodMode = "fixed-spread-nodes"
 )
 
+// IsTopologyMode returns whether the mode selects pods according to the topology of nodes
+func (in PodMode) IsTopologyMode() bool {
+	switch in {
+	case OnePerZonePodMode, AllInOneZonePodMode, FixedSpreadNodesPodMode:
+		return true
+	}
+	return false
+}
+
 type VolumeMode string
 
 const (
@@ -147,6 +459,125 @@ type ChaosStatus struct {
 
 	// Experiment records the last experiment state.
 	Experiment ExperimentStatus `json:"experiment"`
+
+	// Retry records the consecutive failures to apply the chaos.
+	// +optional
+	Retry RetryStatus `json:"retry,omitempty"`
+
+	// Conditions are the latest observations of the chaos, the types are Selected, AllInjected, Recovered, Failed and Queued.
+	// +optional
+	Conditions []ChaosCondition `json:"conditions,omitempty"`
+
+	// DryRun records what the chaos would affect, it is only set in the dry run mode.
+	// +optional
+	DryRun *DryRunStatus `json:"dryRun,omitempty"`
+}
+
+// RetryStatus records the consecutive failures to apply the chaos
+type RetryStatus struct {
+	// Attempts is the number of the consecutive failed attempts to apply the chaos.
+	// +optional
+	Attempts int32 `json:"attempts,omitempty"`
+
+	// ObservedGeneration is the generation of the chaos which failed to be applied.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// LastFailureTime is the time of the last failed attempt.
+	// +optional
+	LastFailureTime *metav1.Time `json:"lastFailureTime,omitempty"`
+
+	// NextRetryTime is the earliest time of the next attempt, which is not set once the retry limit is exceeded.
+	// +optional
+	NextRetryTime *metav1.Time `json:"nextRetryTime,omitempty"`
+}
+
+// DryRunStatus records what the chaos would affect if it was applied
+type DryRunStatus struct {
+	// SpecHash is the hash of the spec previewed by the dry run.
+	SpecHash string `json:"specHash"`
+
+	// Time is when the dry run was done.
+	Time metav1.Time `json:"time"`
+
+	// Targets are the objects which would be injected.
+	// +optional
+	Targets []DryRunTarget `json:"targets,omitempty"`
+
+	// Error describes why the chaos can't be applied, e.g. it is invalid or forbidden by a ChaosPolicy.
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// DryRunTarget is an object which would be injected by the chaos
+type DryRunTarget struct {
+	// Kind is the kind of the target, one of Pod, Node, PersistentVolume and PersistentVolumeClaim.
+	Kind string `json:"kind"`
+
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	Name string `json:"name"`
+
+	// Network is the network configuration which would be flushed into the pod by NetworkChaos.
+	// +optional
+	Network *PodNetworkChaosSpec `json:"network,omitempty"`
+
+	// Error describes why the chaos can't be injected into the target.
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// ChaosConditionType is the type of a chaos condition
+type ChaosConditionType string
+
+const (
+	// ConditionSelected means the targets of the chaos are selected
+	ConditionSelected ChaosConditionType = "Selected"
+	// ConditionAllInjected means the chaos is injected into all the selected targets
+	ConditionAllInjected ChaosConditionType = "AllInjected"
+	// ConditionRecovered means all the targets of the chaos are recovered
+	ConditionRecovered ChaosConditionType = "Recovered"
+
+	// ConditionFailed means the chaos failed permanently, it is not applied again until its spec is changed
+	ConditionFailed ChaosConditionType = "Failed"
+
+	// ConditionQueued means the chaos waits for its targets to be released by other experiments
+	ConditionQueued ChaosConditionType = "Queued"
+)
+
+// ConditionStatus is the status of a condition
+type ConditionStatus string
+
+const (
+	ConditionTrue    ConditionStatus = "True"
+	ConditionFalse   ConditionStatus = "False"
+	ConditionUnknown ConditionStatus = "Unknown"
+)
+
+// ChaosCondition is an observation of the chaos, it has the same fields as metav1.Condition
+type ChaosCondition struct {
+	// Type is the type of the condition.
+	Type ChaosConditionType `json:"type"`
+
+	// Status is the status of the condition, one of True, False and Unknown.
+	Status ConditionStatus `json:"status"`
+
+	// ObservedGeneration is the generation of the chaos which the condition is set based upon.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// LastTransitionTime is the last time when the condition changed from one status to another.
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+
+	// Reason is a brief CamelCase reason for the last transition of the condition.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// Message is a human readable message about the last transition of the condition.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 func (in *ChaosStatus) GetNextStart() time.Time {
@@ -196,6 +627,26 @@ type ScheduleStatus struct {
 	// Next time when this action will be recovered
 	// +optional
 	NextRecover *metav1.Time `json:"nextRecover,omitempty"`
+
+	// ScheduledStart is the scheduled time of the next start, before the random offset is added.
+	// +optional
+	ScheduledStart *metav1.Time `json:"scheduledStart,omitempty"`
+
+	// StartOffset is the random offset between ScheduledStart and NextStart, drawn within the jitter.
+	// +optional
+	StartOffset string `json:"startOffset,omitempty"`
+
+	// Duration is the random duration of the current round, drawn within the random duration range.
+	// +optional
+	Duration string `json:"duration,omitempty"`
+
+	// Skipped is the number of the scheduled starts skipped according to the probability.
+	// +optional
+	Skipped int64 `json:"skipped,omitempty"`
+
+	// LastSkipped is the last time when a scheduled start was skipped according to the probability.
+	// +optional
+	LastSkipped *metav1.Time `json:"lastSkipped,omitempty"`
 }
 
 // ExperimentPhase is the current status of chaos experiment.
@@ -222,6 +673,9 @@ type ExperimentStatus struct {
 	Duration string `json:"duration,omitempty"`
 	// +optional
 	PodRecords []PodStatus `json:"podRecords,omitempty"`
+	// Seed is the seed used to select the targets in the last experiment.
+	// +optional
+	Seed *int64 `json:"seed,omitempty"`
 }
 
 var log = ctrl.Log.WithName("validate-webhook")
@@ -248,12 +702,55 @@ type InnerSchedulerObject interface {
 type InnerObject interface {
 	IsDeleted() bool
 	IsPaused() bool
+	IsDryRun() bool
 	GetChaos() *ChaosInstance
 	StatefulObject
 }
 
 // +kubebuilder:object:generate=false
 
+// FailurePolicyObject is the chaos Object which defines how to handle the targets failed to be injected
+type FailurePolicyObject interface {
+	InnerObject
+	GetFailurePolicy() *FailurePolicy
+}
+
+// +kubebuilder:object:generate=false
+
+// RetryPolicyObject is the chaos Object which defines how to retry it once it failed to be applied
+type RetryPolicyObject interface {
+	InnerObject
+	GetRetryPolicy() *RetryPolicy
+}
+
+// +kubebuilder:object:generate=false
+
+// ConflictPolicyObject is the chaos Object which leases its targets, and defines how to handle the
+// targets leased by other experiments of the same kind
+type ConflictPolicyObject interface {
+	InnerObject
+	GetConflictPolicy() ConflictPolicyType
+}
+
+// +kubebuilder:object:generate=false
+
+// SelectableObject is the chaos Object whose targets are selected by a SelectSpec
+type SelectableObject interface {
+	InnerObject
+	GetSelectSpec() SelectSpec
+}
+
+// +kubebuilder:object:generate=false
+
+// SelectSpec describes how the targets of chaos are selected
+type SelectSpec interface {
+	GetSelector() SelectorSpec
+	GetMode() PodMode
+	GetValue() string
+}
+
+// +kubebuilder:object:generate=false
+
 // StatefulObject defines a basic Object that can get the status
 type StatefulObject interface {
 	runtime.Object
@@ -25,7 +25,10 @@ import (
 
 const (
 	// ValidateSchedulerError defines the error message for ValidateScheduler
-	ValidateSchedulerError = "schedule and duration should be omitted or defined at the same time"
+	ValidateSchedulerError = "duration should be defined if schedule is defined"
+
+	// ValidateDurationError defines the error message for the duration of the chaos without a scheduler
+	ValidateDurationError = "duration should be positive"
 
 	// ValidatePodchaosSchedulerError defines the error message for ValidateScheduler of Podchaos
 	ValidatePodchaosSchedulerError = "schedule should be omitted"
@@ -49,20 +52,23 @@ func ValidateScheduler(schedulerObject InnerSchedulerObject, spec *field.Path) f
 
 	scheduler := schedulerObject.GetScheduler()
 
-	if duration != nil && scheduler != nil {
+	if scheduler != nil && (duration != nil || scheduler.RandomDuration != nil) {
 		errs := validateSchedulerParams(duration, durationField, scheduler, schedulerField)
 		if len(errs) != 0 {
 			allErrs = append(allErrs, errs...)
 		}
-	} else if (duration == nil && scheduler != nil) || (duration != nil && scheduler == nil) {
+	} else if duration == nil && scheduler != nil {
 		allErrs = append(allErrs, field.Invalid(schedulerField, scheduler, ValidateSchedulerError))
+	} else if duration != nil && *duration <= 0 {
+		// the chaos with a duration but no scheduler is applied only once, and recovered after the duration
+		allErrs = append(allErrs, field.Invalid(durationField, duration.String(), ValidateDurationError))
 	}
 	return allErrs
 }
 
 func validateSchedulerParams(duration *time.Duration, durationField *field.Path, spec *SchedulerSpec, schedulerField *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
-	if duration != nil && spec != nil {
+	if spec != nil {
 
 		cronField := schedulerField.Child("cron")
 		scheduler, err := ParseCron(spec.Cron, cronField)
@@ -70,16 +76,82 @@ func validateSchedulerParams(duration *time.Duration, durationField *field.Path,
 			allErrs = append(allErrs, err...)
 		}
 
+		// the random duration overrides the duration, and the random offset delays the recover too
+		longest := time.Duration(0)
+		if duration != nil {
+			longest = *duration
+		}
+		if spec.RandomDuration != nil {
+			if _, max, err := spec.RandomDuration.Parse(); err == nil {
+				longest = max
+			}
+		}
+		if jitter, err := spec.GetJitter(); err == nil {
+			longest += jitter
+		}
+
 		if scheduler != nil {
 			tmpTime := time.Time{}
 			nextTime := scheduler.Next(tmpTime)
 			interval := nextTime.Sub(tmpTime)
-			if *duration >= interval {
+			if longest >= interval {
 				allErrs = append(allErrs, field.Invalid(cronField, spec.Cron,
-					fmt.Sprintf("the scheduling interval:\"%s\" must be greater than the duration:%s", spec.Cron, *duration)))
+					fmt.Sprintf("the scheduling interval:\"%s\" must be greater than the duration:%s", spec.Cron, longest)))
 			}
 		}
+
+		allErrs = append(allErrs, validateCalendar(spec, schedulerField)...)
+		allErrs = append(allErrs, validateRandomizer(spec, schedulerField)...)
+	}
+	return allErrs
+}
+
+// validateRandomizer validates the jitter, the probability and the random duration of the scheduler
+func validateRandomizer(spec *SchedulerSpec, schedulerField *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if _, err := spec.GetJitter(); err != nil {
+		allErrs = append(allErrs, field.Invalid(schedulerField.Child("jitter"), spec.Jitter,
+			fmt.Sprintf("parse jitter error:%s", err)))
+	}
+
+	if spec.Probability != nil && (*spec.Probability < 0 || *spec.Probability > 100) {
+		allErrs = append(allErrs, field.Invalid(schedulerField.Child("probability"), *spec.Probability,
+			"probability should be between 0 and 100"))
+	}
+
+	if spec.RandomDuration != nil {
+		if _, _, err := spec.RandomDuration.Parse(); err != nil {
+			allErrs = append(allErrs, field.Invalid(schedulerField.Child("randomDuration"), *spec.RandomDuration, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
+// validateCalendar validates the time zone, the allowed windows and the blackouts of the scheduler
+func validateCalendar(spec *SchedulerSpec, schedulerField *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	loc, err := spec.Location()
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(schedulerField.Child("timeZone"), spec.TimeZone,
+			fmt.Sprintf("parse time zone error:%s", err)))
+		loc = time.Local
+	}
+
+	for i, window := range spec.AllowedWindows {
+		if _, _, err := window.Parse(); err != nil {
+			allErrs = append(allErrs, field.Invalid(schedulerField.Child("allowedWindows").Index(i), window, err.Error()))
+		}
 	}
+
+	for i, blackout := range spec.Blackouts {
+		if _, _, err := blackout.Parse(loc); err != nil {
+			allErrs = append(allErrs, field.Invalid(schedulerField.Child("blackouts").Index(i), blackout, err.Error()))
+		}
+	}
+
 	return allErrs
 }
 
@@ -99,7 +171,7 @@ func ValidatePodMode(value string, mode PodMode, valueField *field.Path) field.E
 	allErrs := field.ErrorList{}
 
 	switch mode {
-	case FixedPodMode:
+	case FixedPodMode, FixedSpreadNodesPodMode:
 		num, err := strconv.Atoi(value)
 		if err != nil {
 			allErrs = append(allErrs, field.Invalid(valueField, value,
@@ -108,7 +180,7 @@ func ValidatePodMode(value string, mode PodMode, valueField *field.Path) field.E
 
 		if num <= 0 {
 			allErrs = append(allErrs, field.Invalid(valueField, value,
-				fmt.Sprintf("value must be greater than 0 with mode:%s", FixedPodMode)))
+				fmt.Sprintf("value must be greater than 0 with mode:%s", mode)))
 		}
 
 	case RandomMaxPercentPodMode, FixedPercentPodMode:
@@ -126,3 +198,122 @@ func ValidatePodMode(value string, mode PodMode, valueField *field.Path) field.E
 	}
 	return allErrs
 }
+
+// ValidateSelector validates the selector
+func ValidateSelector(selector SelectorSpec, selectorField *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	workloadsField := selectorField.Child("workloads")
+	for i, workload := range selector.Workloads {
+		allErrs = append(allErrs, workload.validate(workloadsField.Index(i))...)
+	}
+
+	componentsField := selectorField.Child("components")
+	for i, component := range selector.Components {
+		if !component.IsStaticPod() && component.Workload() == nil {
+			allErrs = append(allErrs, field.Invalid(componentsField.Index(i), component,
+				fmt.Sprintf("unsupported component %s", component)))
+		}
+	}
+
+	if selector.Exclude != nil {
+		excludeField := selectorField.Child("exclude")
+		if selector.Exclude.Exclude != nil {
+			allErrs = append(allErrs, field.Forbidden(excludeField.Child("exclude"), "exclude can not be nested"))
+		}
+		allErrs = append(allErrs, ValidateSelector(*selector.Exclude, excludeField)...)
+	}
+
+	return allErrs
+}
+
+// ValidateFailurePolicy validates the failure policy
+func ValidateFailurePolicy(policy *FailurePolicy, policyField *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if policy == nil {
+		return allErrs
+	}
+
+	switch policy.GetType() {
+	case RollbackAllPolicy, ContinuePolicy, RetryFailedPolicy:
+	default:
+		allErrs = append(allErrs, field.Invalid(policyField.Child("type"), policy.Type,
+			fmt.Sprintf("unsupported failure policy %s", policy.Type)))
+	}
+
+	if policy.MaxRetries != nil && *policy.MaxRetries < 0 {
+		allErrs = append(allErrs, field.Invalid(policyField.Child("maxRetries"), *policy.MaxRetries,
+			"maxRetries should not be negative"))
+	}
+
+	if _, err := policy.GetBackoff(); err != nil {
+		allErrs = append(allErrs, field.Invalid(policyField.Child("backoff"), policy.Backoff,
+			fmt.Sprintf("parse backoff error:%s", err)))
+	}
+
+	return allErrs
+}
+
+// ValidateConflictPolicy validates the conflict policy
+func ValidateConflictPolicy(policy ConflictPolicyType, policyField *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	switch policy.OrDefault() {
+	case RejectConflictPolicy, QueueConflictPolicy, MergeConflictPolicy:
+	default:
+		allErrs = append(allErrs, field.Invalid(policyField, policy,
+			fmt.Sprintf("unsupported conflict policy %s", policy)))
+	}
+	return allErrs
+}
+
+// ValidateRetryPolicy validates the retry policy
+func ValidateRetryPolicy(policy *RetryPolicy, policyField *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if policy == nil {
+		return allErrs
+	}
+
+	if policy.Limit != nil && *policy.Limit < 0 {
+		allErrs = append(allErrs, field.Invalid(policyField.Child("limit"), *policy.Limit,
+			"limit should not be negative"))
+	}
+
+	if _, _, err := policy.GetBackoff(); err != nil {
+		allErrs = append(allErrs, field.Invalid(policyField.Child("backoff"), policy.Backoff, err.Error()))
+	}
+
+	return allErrs
+}
+
+func (in *WorkloadSelector) validate(workloadField *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch in.Kind {
+	case DeploymentWorkload, StatefulSetWorkload, ReplicaSetWorkload, DaemonSetWorkload, JobWorkload:
+	default:
+		allErrs = append(allErrs, field.Invalid(workloadField.Child("kind"), in.Kind,
+			fmt.Sprintf("unsupported workload kind %s", in.Kind)))
+	}
+
+	if in.Name == "" {
+		allErrs = append(allErrs, field.Required(workloadField.Child("name"), "name of the workload is required"))
+	}
+
+	if in.Ordinals != nil {
+		ordinalsField := workloadField.Child("ordinals")
+		if in.Kind != StatefulSetWorkload {
+			allErrs = append(allErrs, field.Invalid(ordinalsField, in.Ordinals,
+				fmt.Sprintf("ordinals can only be used with %s", StatefulSetWorkload)))
+		}
+		if in.Ordinals.Start < 0 {
+			allErrs = append(allErrs, field.Invalid(ordinalsField.Child("start"), in.Ordinals.Start,
+				"start must be greater than or equal to 0"))
+		}
+		if in.Ordinals.End != nil && *in.Ordinals.End < in.Ordinals.Start {
+			allErrs = append(allErrs, field.Invalid(ordinalsField.Child("end"), *in.Ordinals.End,
+				"end must be greater than or equal to start"))
+		}
+	}
+
+	return allErrs
+}
@@ -14,6 +14,10 @@
 package v1alpha1
 
 import (
+	"context"
+	"fmt"
+	"sort"
+
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 )
@@ -23,6 +27,86 @@ func (in *SelectorSpec) DefaultNamespace(namespace string) {
 	if len(in.Namespaces) == 0 {
 		in.Namespaces = []string{namespace}
 	}
+
+	in.defaultWorkloadNamespace(namespace)
+	// the exclude selector matches all namespaces if namespaces not set
+	if in.Exclude != nil {
+		in.Exclude.defaultWorkloadNamespace(namespace)
+	}
+}
+
+func (in *SelectorSpec) defaultWorkloadNamespace(namespace string) {
+	for i := range in.Workloads {
+		if in.Workloads[i].Namespace == "" {
+			in.Workloads[i].Namespace = namespace
+		}
+	}
+}
+
+// explicitNamespaces returns the namespaces from which the objects are selected,
+// the precedence of the selectors is the same as the selection of pods
+func (in *SelectorSpec) explicitNamespaces() []string {
+	if len(in.Pods) > 0 {
+		return mapKeys(in.Pods)
+	}
+	if len(in.PersistentVolumeClaims) > 0 {
+		return mapKeys(in.PersistentVolumeClaims)
+	}
+	if len(in.Workloads) > 0 || len(in.Components) > 0 {
+		namespaces := make(map[string][]string)
+		for _, workload := range in.Workloads {
+			namespaces[workload.Namespace] = nil
+		}
+		if len(in.Components) > 0 {
+			namespaces[KubeSystemNamespace] = nil
+		}
+		return mapKeys(namespaces)
+	}
+	return in.Namespaces
+}
+
+func mapKeys(m map[string][]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// +kubebuilder:object:generate=false
+
+// NamespaceChecker checks whether a namespace opts in to chaos
+type NamespaceChecker interface {
+	// IsInjectEnabled returns whether the namespace is annotated with `chaos-mesh.org/inject=enabled`
+	IsInjectEnabled(ctx context.Context, namespace string) bool
+}
+
+var namespaceChecker NamespaceChecker
+
+// RegisterNamespaceChecker registers checker into webhook, it is only registered when the namespace filter is enabled
+func RegisterNamespaceChecker(checker NamespaceChecker) {
+	namespaceChecker = checker
+}
+
+// validateNamespaceInjection rejects the chaos if none of the namespaces explicitly selected by it opts in to chaos,
+// because no target can be selected from them once the namespace filter is enabled.
+func validateNamespaceInjection(chaos SelectableObject) error {
+	if namespaceChecker == nil {
+		return nil
+	}
+
+	selector := chaos.GetSelectSpec().GetSelector()
+	namespaces := selector.explicitNamespaces()
+	if len(namespaces) == 0 {
+		return nil
+	}
+	for _, namespace := range namespaces {
+		if namespaceChecker.IsInjectEnabled(context.Background(), namespace) {
+			return nil
+		}
+	}
+	return fmt.Errorf("none of the namespaces %v is annotated with %s=%s", namespaces, NamespaceInjectAnnotationKey, NamespaceInjectEnabled)
 }
 
 // +kubebuilder:object:generate=false
@@ -14,9 +14,12 @@
 package v1alpha1
 
 import (
+	"context"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
 var _ = Describe("common_webhook", func() {
@@ -26,5 +29,184 @@ var _ = Describe("common_webhook", func() {
 			selector.DefaultNamespace(metav1.NamespaceDefault)
 			Expect(selector.Namespaces[0]).To(Equal(metav1.NamespaceDefault))
 		})
+
+		It("set default namespace of workloads", func() {
+			selector := &SelectorSpec{
+				Workloads: []WorkloadSelector{
+					{Kind: DeploymentWorkload, Name: "web"},
+					{Kind: StatefulSetWorkload, Namespace: "db", Name: "db"},
+				},
+			}
+			selector.DefaultNamespace(metav1.NamespaceDefault)
+			Expect(selector.Workloads[0].Namespace).To(Equal(metav1.NamespaceDefault))
+			Expect(selector.Workloads[1].Namespace).To(Equal("db"))
+		})
+	})
+
+	Context("ValidateSelector", func() {
+		It("Validate", func() {
+			start := int32(2)
+			end := int32(1)
+
+			type TestCase struct {
+				name     string
+				selector SelectorSpec
+				expect   string
+			}
+			tcs := []TestCase{
+				{
+					name: "simple ValidateSelector",
+					selector: SelectorSpec{
+						Workloads: []WorkloadSelector{
+							{Kind: StatefulSetWorkload, Name: "db", Ordinals: &OrdinalRange{Start: 0, End: &end}},
+						},
+					},
+					expect: "",
+				},
+				{
+					name: "validate the workload kind",
+					selector: SelectorSpec{
+						Workloads: []WorkloadSelector{{Kind: "CronJob", Name: "backup"}},
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the workload name",
+					selector: SelectorSpec{
+						Workloads: []WorkloadSelector{{Kind: DeploymentWorkload}},
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the ordinals with deployment",
+					selector: SelectorSpec{
+						Workloads: []WorkloadSelector{
+							{Kind: DeploymentWorkload, Name: "web", Ordinals: &OrdinalRange{Start: 0}},
+						},
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the ordinal range",
+					selector: SelectorSpec{
+						Workloads: []WorkloadSelector{
+							{Kind: StatefulSetWorkload, Name: "db", Ordinals: &OrdinalRange{Start: start, End: &end}},
+						},
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the workloads of exclude",
+					selector: SelectorSpec{
+						Exclude: &SelectorSpec{
+							Workloads: []WorkloadSelector{{Kind: DeploymentWorkload}},
+						},
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the nested exclude",
+					selector: SelectorSpec{
+						Exclude: &SelectorSpec{
+							Exclude: &SelectorSpec{},
+						},
+					},
+					expect: "error",
+				},
+			}
+
+			for _, tc := range tcs {
+				errs := ValidateSelector(tc.selector, field.NewPath("selector"))
+				if tc.expect == "error" {
+					Expect(errs).ToNot(BeEmpty(), tc.name)
+				} else {
+					Expect(errs).To(BeEmpty(), tc.name)
+				}
+			}
+		})
+	})
+
+	Context("ValidateNamespaceInjection", func() {
+		AfterEach(func() {
+			RegisterNamespaceChecker(nil)
+		})
+
+		It("Validate", func() {
+			RegisterNamespaceChecker(fakeNamespaceChecker{"enabled": true})
+
+			type TestCase struct {
+				name     string
+				selector SelectorSpec
+				expect   string
+			}
+			tcs := []TestCase{
+				{
+					name:     "namespace opts in to chaos",
+					selector: SelectorSpec{Namespaces: []string{"enabled"}},
+					expect:   "",
+				},
+				{
+					name:     "one of the namespaces opts in to chaos",
+					selector: SelectorSpec{Namespaces: []string{"disabled", "enabled"}},
+					expect:   "",
+				},
+				{
+					name:     "no namespace opts in to chaos",
+					selector: SelectorSpec{Namespaces: []string{"disabled"}},
+					expect:   "error",
+				},
+				{
+					name: "namespaces of pods take precedence",
+					selector: SelectorSpec{
+						Namespaces: []string{"enabled"},
+						Pods:       map[string][]string{"disabled": {"p1"}},
+					},
+					expect: "error",
+				},
+				{
+					name: "namespaces of workloads take precedence",
+					selector: SelectorSpec{
+						Namespaces: []string{"disabled"},
+						Workloads:  []WorkloadSelector{{Kind: DeploymentWorkload, Namespace: "enabled", Name: "web"}},
+					},
+					expect: "",
+				},
+				{
+					name:   "no explicit namespace",
+					expect: "",
+				},
+			}
+
+			for _, tc := range tcs {
+				chaos := &PodChaos{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: metav1.NamespaceDefault,
+						Name:      "foo",
+					},
+					Spec: PodChaosSpec{Selector: tc.selector},
+				}
+				err := validateNamespaceInjection(chaos)
+				if tc.expect == "error" {
+					Expect(err).To(HaveOccurred(), tc.name)
+				} else {
+					Expect(err).NotTo(HaveOccurred(), tc.name)
+				}
+			}
+		})
+
+		It("is skipped without checker", func() {
+			chaos := &PodChaos{
+				Spec: PodChaosSpec{
+					Selector: SelectorSpec{Namespaces: []string{"disabled"}},
+				},
+			}
+			Expect(validateNamespaceInjection(chaos)).To(Succeed())
+		})
 	})
 })
+
+type fakeNamespaceChecker map[string]bool
+
+func (c fakeNamespaceChecker) IsInjectEnabled(ctx context.Context, namespace string) bool {
+	return c[namespace]
+}
@@ -0,0 +1,42 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import "time"
+
+// DefaultConflictQueueInterval is the interval to check whether the targets of a queued chaos are released
+const DefaultConflictQueueInterval = 10 * time.Second
+
+// OrDefault returns the policy, or Merge if it is not set
+func (in ConflictPolicyType) OrDefault() ConflictPolicyType {
+	if in == "" {
+		return MergeConflictPolicy
+	}
+	return in
+}
+
+// GetConflictPolicy returns the conflict policy of the PodChaos
+func (in *PodChaos) GetConflictPolicy() ConflictPolicyType {
+	return in.Spec.ConflictPolicy.OrDefault()
+}
+
+// GetConflictPolicy returns the conflict policy of the StressChaos
+func (in *StressChaos) GetConflictPolicy() ConflictPolicyType {
+	return in.Spec.ConflictPolicy.OrDefault()
+}
+
+// GetConflictPolicy returns the conflict policy of the TimeChaos
+func (in *TimeChaos) GetConflictPolicy() ConflictPolicyType {
+	return in.Spec.ConflictPolicy.OrDefault()
+}
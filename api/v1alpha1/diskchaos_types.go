@@ -0,0 +1,131 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DiskChaosAction represents the chaos action about disk.
+type DiskChaosAction string
+
+const (
+	// DiskFillAction represents the chaos action of allocating a file of the given size
+	// to take up the free space of the volume.
+	DiskFillAction DiskChaosAction = "disk-fill"
+	// InodeExhaustionAction represents the chaos action of creating plenty of empty files
+	// to take up the free inodes of the volume.
+	InodeExhaustionAction DiskChaosAction = "inode-exhaustion"
+)
+
+// +kubebuilder:object:root=true
+// +chaos-mesh:base
+
+// DiskChaos is the Schema for the diskchaos API
+type DiskChaos struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the behavior of a disk chaos experiment
+	Spec DiskChaosSpec `json:"spec"`
+
+	// +optional
+	// Most recently observed status of the disk chaos experiment
+	Status DiskChaosStatus `json:"status"`
+}
+
+// DiskChaosSpec defines the desired state of DiskChaos
+type DiskChaosSpec struct {
+	// Action defines the specific disk chaos action.
+	// Supported action: disk-fill / inode-exhaustion
+	// +kubebuilder:validation:Enum=disk-fill;inode-exhaustion
+	Action DiskChaosAction `json:"action"`
+
+	// Mode defines the mode to run chaos action.
+	// Supported mode: one / all / fixed / fixed-percent / random-max-percent
+	// +kubebuilder:validation:Enum=one;all;fixed;fixed-percent;random-max-percent;one-per-zone;all-in-one-zone;fixed-spread-nodes
+	Mode PodMode `json:"mode"`
+
+	// Value is required when the mode is set to `FixedPodMode` / `FixedPercentPodMod` / `RandomMaxPercentPodMod`.
+	// If `FixedPodMode`, provide an integer of pods to do chaos action.
+	// If `FixedPercentPodMod`, provide a number from 0-100 to specify the percent of pods the server can do chaos action.
+	// If `RandomMaxPercentPodMod`,  provide a number from 0-100 to specify the max percent of pods to do chaos action
+	// +optional
+	Value string `json:"value"`
+
+	// Seed is used to select the targets in the modes `one` / `fixed` / `fixed-percent` / `random-max-percent`.
+	// The same seed always selects the same targets from the same candidates.
+	// A random seed will be used if it is not set, and the seed used is recorded in the status.
+	// +optional
+	Seed *int64 `json:"seed,omitempty"`
+
+	// Selector is used to select pods that are used to inject chaos action.
+	Selector SelectorSpec `json:"selector"`
+
+	// Path is the absolute path of a directory inside the target container, the files
+	// are allocated on the volume which contains this directory.
+	Path string `json:"path"`
+
+	// Size is the size of the file allocated by the `disk-fill` action, such as "1Gi" or "500M".
+	// It's required when the action is `disk-fill`.
+	// +optional
+	Size string `json:"size,omitempty"`
+
+	// Inodes is the number of empty files created by the `inode-exhaustion` action.
+	// It's required when the action is `inode-exhaustion`.
+	// +optional
+	Inodes int64 `json:"inodes,omitempty"`
+
+	// ContainerName indicates the target container to inject disk chaos in.
+	// If not set, the first container will be injected
+	// +optional
+	ContainerName *string `json:"containerName,omitempty"`
+
+	// Duration represents the duration of the chaos action
+	// +optional
+	Duration *string `json:"duration,omitempty"`
+
+	// Scheduler defines some schedule rules to control the running time of the chaos experiment about disk.
+	// +optional
+	Scheduler *SchedulerSpec `json:"scheduler,omitempty"`
+
+	// FailurePolicy defines how to handle the targets failed to be injected, all the injected targets
+	// are rolled back by default.
+	// +optional
+	FailurePolicy *FailurePolicy `json:"failurePolicy,omitempty"`
+
+	// RetryPolicy defines how to retry the chaos failed to be applied.
+	// +optional
+	RetryPolicy *RetryPolicy `json:"retryPolicy,omitempty"`
+}
+
+// GetSelector is a getter for Selector (for implementing SelectSpec)
+func (in *DiskChaosSpec) GetSelector() SelectorSpec {
+	return in.Selector
+}
+
+// GetMode is a getter for Mode (for implementing SelectSpec)
+func (in *DiskChaosSpec) GetMode() PodMode {
+	return in.Mode
+}
+
+// GetValue is a getter for Value (for implementing SelectSpec)
+func (in *DiskChaosSpec) GetValue() string {
+	return in.Value
+}
+
+// DiskChaosStatus defines the observed state of DiskChaos
+type DiskChaosStatus struct {
+	ChaosStatus `json:",inline"`
+}
@@ -0,0 +1,131 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// log is for logging in this package.
+var diskchaoslog = logf.Log.WithName("diskchaos-resource")
+
+// +kubebuilder:webhook:path=/mutate-chaos-mesh-org-v1alpha1-diskchaos,mutating=true,failurePolicy=fail,groups=chaos-mesh.org,resources=diskchaos,verbs=create;update,versions=v1alpha1,name=mdiskchaos.kb.io
+
+var _ webhook.Defaulter = &DiskChaos{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (in *DiskChaos) Default() {
+	diskchaoslog.Info("default", "name", in.Name)
+
+	in.Spec.Selector.DefaultNamespace(in.GetNamespace())
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-chaos-mesh-org-v1alpha1-diskchaos,mutating=false,failurePolicy=fail,groups=chaos-mesh.org,resources=diskchaos,versions=v1alpha1,name=vdiskchaos.kb.io
+
+var _ ChaosValidator = &DiskChaos{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (in *DiskChaos) ValidateCreate() error {
+	diskchaoslog.Info("validate create", "name", in.Name)
+	if err := in.Validate(); err != nil {
+		return err
+	}
+	if err := validateNamespaceInjection(in); err != nil {
+		return err
+	}
+	return validateChaosPolicy(in, true)
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (in *DiskChaos) ValidateUpdate(old runtime.Object) error {
+	diskchaoslog.Info("validate update", "name", in.Name)
+	if err := in.Validate(); err != nil {
+		return err
+	}
+	return validateChaosPolicy(in, false)
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (in *DiskChaos) ValidateDelete() error {
+	diskchaoslog.Info("validate delete", "name", in.Name)
+
+	// Nothing to do?
+	return nil
+}
+
+// Validate validates chaos object
+func (in *DiskChaos) Validate() error {
+	specField := field.NewPath("spec")
+	allErrs := in.ValidateScheduler(specField)
+	allErrs = append(allErrs, in.ValidatePodMode(specField)...)
+	allErrs = append(allErrs, ValidateSelector(in.Spec.Selector, specField.Child("selector"))...)
+	allErrs = append(allErrs, ValidateFailurePolicy(in.Spec.FailurePolicy, specField.Child("failurePolicy"))...)
+	allErrs = append(allErrs, ValidateRetryPolicy(in.Spec.RetryPolicy, specField.Child("retryPolicy"))...)
+	allErrs = append(allErrs, in.Spec.validateAction(specField)...)
+
+	if len(allErrs) > 0 {
+		return fmt.Errorf(allErrs.ToAggregate().Error())
+	}
+	return nil
+}
+
+// ValidateScheduler validates the scheduler and duration
+func (in *DiskChaos) ValidateScheduler(spec *field.Path) field.ErrorList {
+	return ValidateScheduler(in, spec)
+}
+
+// ValidatePodMode validates the value with podmode
+func (in *DiskChaos) ValidatePodMode(spec *field.Path) field.ErrorList {
+	return ValidatePodMode(in.Spec.Value, in.Spec.Mode, spec.Child("value"))
+}
+
+// validateAction validates the path and the parameters of the action
+func (in *DiskChaosSpec) validateAction(spec *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !filepath.IsAbs(in.Path) {
+		allErrs = append(allErrs, field.Invalid(spec.Child("path"), in.Path,
+			"the path should be an absolute path"))
+	}
+
+	switch in.Action {
+	case DiskFillAction:
+		sizeField := spec.Child("size")
+		size, err := resource.ParseQuantity(in.Size)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(sizeField, in.Size,
+				fmt.Sprintf("parse size field error:%s", err)))
+		} else if size.Sign() <= 0 {
+			allErrs = append(allErrs, field.Invalid(sizeField, in.Size,
+				"the size should be positive"))
+		}
+	case InodeExhaustionAction:
+		if in.Inodes <= 0 {
+			allErrs = append(allErrs, field.Invalid(spec.Child("inodes"), in.Inodes,
+				"the number of inodes should be positive"))
+		}
+	default:
+		allErrs = append(allErrs, field.Invalid(spec.Child("action"), in.Action,
+			fmt.Sprintf("unknown disk chaos action: %s", in.Action)))
+	}
+
+	return allErrs
+}
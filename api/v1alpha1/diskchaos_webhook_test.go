@@ -0,0 +1,172 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("diskchaos_webhook", func() {
+	Context("Defaulter", func() {
+		It("set default namespace selector", func() {
+			diskchaos := &DiskChaos{
+				ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault},
+			}
+			diskchaos.Default()
+			Expect(diskchaos.Spec.Selector.Namespaces[0]).To(Equal(metav1.NamespaceDefault))
+		})
+	})
+	Context("ChaosValidator of diskchaos", func() {
+		It("Validate", func() {
+
+			type TestCase struct {
+				name    string
+				chaos   DiskChaos
+				execute func(chaos *DiskChaos) error
+				expect  string
+			}
+			tcs := []TestCase{
+				{
+					name: "simple ValidateCreate with disk-fill",
+					chaos: DiskChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo1",
+						},
+						Spec: DiskChaosSpec{
+							Action: DiskFillAction,
+							Path:   "/var/lib/data",
+							Size:   "1Gi",
+						},
+					},
+					execute: func(chaos *DiskChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "",
+				},
+				{
+					name: "simple ValidateUpdate with inode-exhaustion",
+					chaos: DiskChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo2",
+						},
+						Spec: DiskChaosSpec{
+							Action: InodeExhaustionAction,
+							Path:   "/var/lib/data",
+							Inodes: 10000,
+						},
+					},
+					execute: func(chaos *DiskChaos) error {
+						return chaos.ValidateUpdate(chaos)
+					},
+					expect: "",
+				},
+				{
+					name: "simple ValidateDelete",
+					chaos: DiskChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo3",
+						},
+					},
+					execute: func(chaos *DiskChaos) error {
+						return chaos.ValidateDelete()
+					},
+					expect: "",
+				},
+				{
+					name: "relative path",
+					chaos: DiskChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo4",
+						},
+						Spec: DiskChaosSpec{
+							Action: DiskFillAction,
+							Path:   "var/lib/data",
+							Size:   "1Gi",
+						},
+					},
+					execute: func(chaos *DiskChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "disk-fill without size",
+					chaos: DiskChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo5",
+						},
+						Spec: DiskChaosSpec{
+							Action: DiskFillAction,
+							Path:   "/var/lib/data",
+						},
+					},
+					execute: func(chaos *DiskChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "inode-exhaustion without inodes",
+					chaos: DiskChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo6",
+						},
+						Spec: DiskChaosSpec{
+							Action: InodeExhaustionAction,
+							Path:   "/var/lib/data",
+						},
+					},
+					execute: func(chaos *DiskChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "unknown action",
+					chaos: DiskChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo7",
+						},
+						Spec: DiskChaosSpec{
+							Action: "disk-burn",
+							Path:   "/var/lib/data",
+						},
+					},
+					execute: func(chaos *DiskChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+			}
+
+			for _, tc := range tcs {
+				err := tc.execute(&tc.chaos)
+				if tc.expect == "error" {
+					Expect(err).To(HaveOccurred(), tc.name)
+				} else {
+					Expect(err).NotTo(HaveOccurred(), tc.name)
+				}
+			}
+		})
+	})
+})
@@ -76,7 +76,7 @@ type DNSChaosSpec struct {
 
 	// Mode defines the mode to run chaos action.
 	// Supported mode: one / all / fixed / fixed-percent / random-max-percent
-	// +kubebuilder:validation:Enum=one;all;fixed;fixed-percent;random-max-percent
+	// +kubebuilder:validation:Enum=one;all;fixed;fixed-percent;random-max-percent;one-per-zone;all-in-one-zone;fixed-spread-nodes
 	Mode PodMode `json:"mode"`
 
 	// Value is required when the mode is set to `FixedPodMode` / `FixedPercentPodMod` / `RandomMaxPercentPodMod`.
@@ -86,6 +86,12 @@ type DNSChaosSpec struct {
 	// +optional
 	Value string `json:"value"`
 
+	// Seed is used to select the targets in the modes `one` / `fixed` / `fixed-percent` / `random-max-percent`.
+	// The same seed always selects the same targets from the same candidates.
+	// A random seed will be used if it is not set, and the seed used is recorded in the status.
+	// +optional
+	Seed *int64 `json:"seed,omitempty"`
+
 	// Selector is used to select pods that are used to inject chaos action.
 	Selector SelectorSpec `json:"selector"`
 
@@ -95,6 +101,15 @@ type DNSChaosSpec struct {
 	// Scheduler defines some schedule rules to control the running time of the chaos experiment about network.
 	Scheduler *SchedulerSpec `json:"scheduler,omitempty"`
 
+	// FailurePolicy defines how to handle the targets failed to be injected, all the injected targets
+	// are rolled back by default.
+	// +optional
+	FailurePolicy *FailurePolicy `json:"failurePolicy,omitempty"`
+
+	// RetryPolicy defines how to retry the chaos failed to be applied.
+	// +optional
+	RetryPolicy *RetryPolicy `json:"retryPolicy,omitempty"`
+
 	// Action defines the scope which the DNS chaos works.
 	// Supported action: outer, inner, all
 	// Default action: outer
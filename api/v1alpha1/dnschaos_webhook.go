@@ -43,13 +43,22 @@ var _ ChaosValidator = &DNSChaos{}
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type
 func (in *DNSChaos) ValidateCreate() error {
 	dnschaoslog.Info("validate create", "name", in.Name)
-	return in.Validate()
+	if err := in.Validate(); err != nil {
+		return err
+	}
+	if err := validateNamespaceInjection(in); err != nil {
+		return err
+	}
+	return validateChaosPolicy(in, true)
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
 func (in *DNSChaos) ValidateUpdate(old runtime.Object) error {
 	dnschaoslog.Info("validate update", "name", in.Name)
-	return in.Validate()
+	if err := in.Validate(); err != nil {
+		return err
+	}
+	return validateChaosPolicy(in, false)
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
@@ -65,6 +74,9 @@ func (in *DNSChaos) Validate() error {
 	specField := field.NewPath("spec")
 	allErrs := in.ValidateScheduler(specField)
 	allErrs = append(allErrs, in.ValidatePodMode(specField)...)
+	allErrs = append(allErrs, ValidateSelector(in.Spec.Selector, specField.Child("selector"))...)
+	allErrs = append(allErrs, ValidateFailurePolicy(in.Spec.FailurePolicy, specField.Child("failurePolicy"))...)
+	allErrs = append(allErrs, ValidateRetryPolicy(in.Spec.RetryPolicy, specField.Child("retryPolicy"))...)
 
 	if len(allErrs) > 0 {
 		return fmt.Errorf(allErrs.ToAggregate().Error())
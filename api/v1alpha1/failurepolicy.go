@@ -0,0 +1,115 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultFailurePolicyMaxRetries is the default max number of the retries of a failed target
+	DefaultFailurePolicyMaxRetries int32 = 3
+
+	// DefaultFailurePolicyBackoff is the default interval before the first retry of a failed target
+	DefaultFailurePolicyBackoff = time.Second
+
+	// DefaultRetryBackoff is the default interval before the first retry of the chaos failed to be applied
+	DefaultRetryBackoff = 10 * time.Second
+
+	// DefaultRetryMaxBackoff is the default max interval between the retries of the chaos failed to be applied
+	DefaultRetryMaxBackoff = 5 * time.Minute
+)
+
+// GetType returns the type of the policy, or RollbackAll if it is not set
+func (in *FailurePolicy) GetType() FailurePolicyType {
+	if in == nil || in.Type == "" {
+		return RollbackAllPolicy
+	}
+	return in.Type
+}
+
+// GetMaxRetries returns the max number of the retries of a failed target, which is zero unless
+// the policy is RetryFailed
+func (in *FailurePolicy) GetMaxRetries() int32 {
+	if in.GetType() != RetryFailedPolicy {
+		return 0
+	}
+	if in.MaxRetries == nil {
+		return DefaultFailurePolicyMaxRetries
+	}
+	return *in.MaxRetries
+}
+
+// GetBackoff returns the interval before the first retry of a failed target
+func (in *FailurePolicy) GetBackoff() (time.Duration, error) {
+	if in == nil || in.Backoff == "" {
+		return DefaultFailurePolicyBackoff, nil
+	}
+	backoff, err := time.ParseDuration(in.Backoff)
+	if err != nil {
+		return 0, err
+	}
+	if backoff < 0 {
+		return 0, fmt.Errorf("backoff %s should not be negative", in.Backoff)
+	}
+	return backoff, nil
+}
+
+// GetLimit returns the max number of the retries, and whether the retries are limited.
+// The chaos is retried without limit if the limit is not set.
+func (in *RetryPolicy) GetLimit() (int32, bool) {
+	if in == nil || in.Limit == nil {
+		return 0, false
+	}
+	return *in.Limit, true
+}
+
+// GetBackoff returns the interval before the first retry and the max interval between the retries
+func (in *RetryPolicy) GetBackoff() (backoff, maxBackoff time.Duration, err error) {
+	backoff, maxBackoff = DefaultRetryBackoff, DefaultRetryMaxBackoff
+	if in == nil {
+		return backoff, maxBackoff, nil
+	}
+
+	if in.Backoff != "" {
+		if backoff, err = time.ParseDuration(in.Backoff); err != nil {
+			return 0, 0, err
+		}
+	}
+	if in.MaxBackoff != "" {
+		if maxBackoff, err = time.ParseDuration(in.MaxBackoff); err != nil {
+			return 0, 0, err
+		}
+	}
+	if backoff <= 0 || maxBackoff < backoff {
+		return 0, 0, fmt.Errorf("backoff %s should be positive and not greater than the max backoff %s", backoff, maxBackoff)
+	}
+	return backoff, maxBackoff, nil
+}
+
+// Delay returns the interval before the retry after the given number of failed attempts
+func (in *RetryPolicy) Delay(attempts int32) (time.Duration, error) {
+	delay, maxBackoff, err := in.GetBackoff()
+	if err != nil {
+		return 0, err
+	}
+	for i := int32(1); i < attempts && delay < maxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > maxBackoff {
+		delay = maxBackoff
+	}
+	return delay, nil
+}
@@ -39,7 +39,7 @@ type IoChaosSpec struct {
 
 	// Mode defines the mode to run chaos action.
 	// Supported mode: one / all / fixed / fixed-percent / random-max-percent
-	// +kubebuilder:validation:Enum=one;all;fixed;fixed-percent;random-max-percent
+	// +kubebuilder:validation:Enum=one;all;fixed;fixed-percent;random-max-percent;one-per-zone;all-in-one-zone;fixed-spread-nodes
 	Mode PodMode `json:"mode"`
 
 	// Value is required when the mode is set to `FixedPodMode` / `FixedPercentPodMod` / `RandomMaxPercentPodMod`.
@@ -49,6 +49,12 @@ type IoChaosSpec struct {
 	// +optional
 	Value string `json:"value"`
 
+	// Seed is used to select the targets in the modes `one` / `fixed` / `fixed-percent` / `random-max-percent`.
+	// The same seed always selects the same targets from the same candidates.
+	// A random seed will be used if it is not set, and the seed used is recorded in the status.
+	// +optional
+	Seed *int64 `json:"seed,omitempty"`
+
 	// Action defines the specific pod chaos action.
 	// Supported action: latency / fault / attrOverride
 	// +kubebuilder:validation:Enum=latency;fault;attrOverride
@@ -92,6 +98,15 @@ type IoChaosSpec struct {
 	// control the running time of the chaos experiment about pods.
 	Scheduler *SchedulerSpec `json:"scheduler,omitempty"`
 
+	// FailurePolicy defines how to handle the targets failed to be injected, all the injected targets
+	// are rolled back by default.
+	// +optional
+	FailurePolicy *FailurePolicy `json:"failurePolicy,omitempty"`
+
+	// RetryPolicy defines how to retry the chaos failed to be applied.
+	// +optional
+	RetryPolicy *RetryPolicy `json:"retryPolicy,omitempty"`
+
 	// Duration represents the duration of the chaos action.
 	// It is required when the action is `PodFailureAction`.
 	// A duration string is a possibly signed sequence of
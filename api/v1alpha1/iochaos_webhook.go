@@ -52,13 +52,22 @@ var _ ChaosValidator = &IoChaos{}
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type
 func (in *IoChaos) ValidateCreate() error {
 	iochaoslog.Info("validate create", "name", in.Name)
-	return in.Validate()
+	if err := in.Validate(); err != nil {
+		return err
+	}
+	if err := validateNamespaceInjection(in); err != nil {
+		return err
+	}
+	return validateChaosPolicy(in, true)
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
 func (in *IoChaos) ValidateUpdate(old runtime.Object) error {
 	iochaoslog.Info("validate update", "name", in.Name)
-	return in.Validate()
+	if err := in.Validate(); err != nil {
+		return err
+	}
+	return validateChaosPolicy(in, false)
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
@@ -74,6 +83,9 @@ func (in *IoChaos) Validate() error {
 	specField := field.NewPath("spec")
 	allErrs := in.ValidateScheduler(specField)
 	allErrs = append(allErrs, in.ValidatePodMode(specField)...)
+	allErrs = append(allErrs, ValidateSelector(in.Spec.Selector, specField.Child("selector"))...)
+	allErrs = append(allErrs, ValidateFailurePolicy(in.Spec.FailurePolicy, specField.Child("failurePolicy"))...)
+	allErrs = append(allErrs, ValidateRetryPolicy(in.Spec.RetryPolicy, specField.Child("retryPolicy"))...)
 	allErrs = append(allErrs, in.Spec.validateDelay(specField.Child("delay"))...)
 	allErrs = append(allErrs, in.Spec.validateErrno(specField.Child("errno"))...)
 	allErrs = append(allErrs, in.Spec.validatePercent(specField.Child("percent"))...)
@@ -113,7 +113,7 @@ var _ = Describe("iochaos_webhook", func() {
 					execute: func(chaos *IoChaos) error {
 						return chaos.ValidateCreate()
 					},
-					expect: "error",
+					expect: "",
 				},
 				{
 					name: "parse the duration and scheduler error",
@@ -166,6 +166,23 @@ var _ = Describe("iochaos_webhook", func() {
 					},
 					expect: "error",
 				},
+				{
+					name: "validate value with FixedSpreadNodesPodMode",
+					chaos: IoChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo8",
+						},
+						Spec: IoChaosSpec{
+							Value: "0",
+							Mode:  FixedSpreadNodesPodMode,
+						},
+					},
+					execute: func(chaos *IoChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
 				{
 					name: "validate value with RandomMaxPercentPodMode",
 					chaos: IoChaos{
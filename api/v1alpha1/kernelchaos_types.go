@@ -37,7 +37,7 @@ type KernelChaos struct {
 type KernelChaosSpec struct {
 	// Mode defines the mode to run chaos action.
 	// Supported mode: one / all / fixed / fixed-percent / random-max-percent
-	// +kubebuilder:validation:Enum=one;all;fixed;fixed-percent;random-max-percent
+	// +kubebuilder:validation:Enum=one;all;fixed;fixed-percent;random-max-percent;one-per-zone;all-in-one-zone;fixed-spread-nodes
 	Mode PodMode `json:"mode"`
 
 	// Value is required when the mode is set to `FixedPodMode` / `FixedPercentPodMod` / `RandomMaxPercentPodMod`.
@@ -47,6 +47,12 @@ type KernelChaosSpec struct {
 	// +optional
 	Value string `json:"value"`
 
+	// Seed is used to select the targets in the modes `one` / `fixed` / `fixed-percent` / `random-max-percent`.
+	// The same seed always selects the same targets from the same candidates.
+	// A random seed will be used if it is not set, and the seed used is recorded in the status.
+	// +optional
+	Seed *int64 `json:"seed,omitempty"`
+
 	// Selector is used to select pods that are used to inject chaos action.
 	Selector SelectorSpec `json:"selector"`
 
@@ -58,6 +64,15 @@ type KernelChaosSpec struct {
 
 	// Scheduler defines some schedule rules to control the running time of the chaos experiment about time.
 	Scheduler *SchedulerSpec `json:"scheduler,omitempty"`
+
+	// FailurePolicy defines how to handle the targets failed to be injected, all the injected targets
+	// are rolled back by default.
+	// +optional
+	FailurePolicy *FailurePolicy `json:"failurePolicy,omitempty"`
+
+	// RetryPolicy defines how to retry the chaos failed to be applied.
+	// +optional
+	RetryPolicy *RetryPolicy `json:"retryPolicy,omitempty"`
 }
 
 // GetSelector is a getter for Selector (for implementing SelectSpec)
@@ -111,7 +111,7 @@ var _ = Describe("kernelchaos_webhook", func() {
 					execute: func(chaos *KernelChaos) error {
 						return chaos.ValidateCreate()
 					},
-					expect: "error",
+					expect: "",
 				},
 			}
 
@@ -76,13 +76,22 @@ var _ ChaosValidator = &NetworkChaos{}
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type
 func (in *NetworkChaos) ValidateCreate() error {
 	networkchaoslog.Info("validate create", "name", in.Name)
-	return in.Validate()
+	if err := in.Validate(); err != nil {
+		return err
+	}
+	if err := validateNamespaceInjection(in); err != nil {
+		return err
+	}
+	return validateChaosPolicy(in, true)
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
 func (in *NetworkChaos) ValidateUpdate(old runtime.Object) error {
 	networkchaoslog.Info("validate update", "name", in.Name)
-	return in.Validate()
+	if err := in.Validate(); err != nil {
+		return err
+	}
+	return validateChaosPolicy(in, false)
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
@@ -98,6 +107,9 @@ func (in *NetworkChaos) Validate() error {
 	specField := field.NewPath("spec")
 	allErrs := in.ValidateScheduler(specField)
 	allErrs = append(allErrs, in.ValidatePodMode(specField)...)
+	allErrs = append(allErrs, ValidateSelector(in.Spec.Selector, specField.Child("selector"))...)
+	allErrs = append(allErrs, ValidateFailurePolicy(in.Spec.FailurePolicy, specField.Child("failurePolicy"))...)
+	allErrs = append(allErrs, ValidateRetryPolicy(in.Spec.RetryPolicy, specField.Child("retryPolicy"))...)
 	allErrs = append(allErrs, in.ValidateExternalTargets(specField)...)
 
 	if in.Spec.Delay != nil {
@@ -118,6 +130,7 @@ func (in *NetworkChaos) Validate() error {
 
 	if in.Spec.Target != nil {
 		allErrs = append(allErrs, in.Spec.Target.validateTarget(specField.Child("target"))...)
+		allErrs = append(allErrs, ValidateSelector(in.Spec.Target.TargetSelector, specField.Child("target", "selector"))...)
 	}
 
 	if len(allErrs) > 0 {
@@ -273,7 +286,8 @@ func (in *BandwidthSpec) validateBandwidth(bandwidth *field.Path) field.ErrorLis
 
 // validateTarget validates the target
 func (in *Target) validateTarget(target *field.Path) field.ErrorList {
-	modes := []PodMode{OnePodMode, AllPodMode, FixedPodMode, FixedPercentPodMode, RandomMaxPercentPodMode}
+	modes := []PodMode{OnePodMode, AllPodMode, FixedPodMode, FixedPercentPodMode, RandomMaxPercentPodMode,
+		OnePerZonePodMode, AllInOneZonePodMode, FixedSpreadNodesPodMode}
 
 	for _, mode := range modes {
 		if in.TargetMode == mode {
@@ -127,7 +127,7 @@ var _ = Describe("networkchaos_webhook", func() {
 					execute: func(chaos *NetworkChaos) error {
 						return chaos.ValidateCreate()
 					},
-					expect: "error",
+					expect: "",
 				},
 				{
 					name: "validate the delay",
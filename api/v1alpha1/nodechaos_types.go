@@ -0,0 +1,203 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NodeChaosAction represents the chaos action about nodes.
+type NodeChaosAction string
+
+const (
+	// NodeCordonAction represents the chaos action of marking nodes as unschedulable.
+	NodeCordonAction NodeChaosAction = "node-cordon"
+	// NodeDrainAction represents the chaos action of cordoning nodes and evicting the pods on them.
+	// The evicted pods are not restored when the chaos is recovered.
+	NodeDrainAction NodeChaosAction = "node-drain"
+	// NodeTaintAction represents the chaos action of adding a taint to nodes.
+	NodeTaintAction NodeChaosAction = "node-taint"
+	// KubeletStopAction represents the chaos action of stopping the kubelet service on nodes.
+	KubeletStopAction NodeChaosAction = "kubelet-stop"
+)
+
+const (
+	// DefaultNodeChaosTaintKey is the default key of the taint added by the node-taint action
+	DefaultNodeChaosTaintKey = "chaos-mesh.org/node-chaos"
+
+	// DefaultKubeletService is the default name of the kubelet service stopped by the kubelet-stop action
+	DefaultKubeletService = "kubelet"
+)
+
+// AllowedKubeletServices are the systemd units which can be stopped by the kubelet-stop action
+var AllowedKubeletServices = []string{DefaultKubeletService, DefaultKubeletService + ".service"}
+
+// unitNameRegex matches the valid names of systemd units
+var unitNameRegex = regexp.MustCompile(`^[a-zA-Z0-9:_.@-]+$`)
+
+// +kubebuilder:object:root=true
+// +chaos-mesh:base
+
+// NodeChaos is the Schema for the nodechaos API
+type NodeChaos struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the behavior of a node chaos experiment
+	Spec NodeChaosSpec `json:"spec"`
+
+	// +optional
+	// Most recently observed status of the node chaos experiment
+	Status NodeChaosStatus `json:"status"`
+}
+
+// NodeChaosSpec defines the desired state of NodeChaos
+type NodeChaosSpec struct {
+	// Action defines the specific node chaos action.
+	// Supported action: node-cordon / node-drain / node-taint / kubelet-stop
+	// +kubebuilder:validation:Enum=node-cordon;node-drain;node-taint;kubelet-stop
+	Action NodeChaosAction `json:"action"`
+
+	// Mode defines the mode to run chaos action.
+	// Supported mode: one / all / fixed / fixed-percent / random-max-percent
+	// +kubebuilder:validation:Enum=one;all;fixed;fixed-percent;random-max-percent
+	Mode PodMode `json:"mode"`
+
+	// Value is required when the mode is set to `FixedPodMode` / `FixedPercentPodMod` / `RandomMaxPercentPodMod`.
+	// If `FixedPodMode`, provide an integer of nodes to do chaos action.
+	// If `FixedPercentPodMod`, provide a number from 0-100 to specify the percent of nodes the server can do chaos action.
+	// If `RandomMaxPercentPodMod`,  provide a number from 0-100 to specify the max percent of nodes to do chaos action
+	// +optional
+	Value string `json:"value"`
+
+	// Seed is used to select the targets in the modes `one` / `fixed` / `fixed-percent` / `random-max-percent`.
+	// The same seed always selects the same targets from the same candidates.
+	// A random seed will be used if it is not set, and the seed used is recorded in the status.
+	// +optional
+	Seed *int64 `json:"seed,omitempty"`
+
+	// Selector is used to select nodes that are used to inject chaos action.
+	// Only `nodes`, `nodeSelectors`, `labelSelectors`, `expressionSelectors` and `annotationSelectors`
+	// are used to select nodes.
+	Selector SelectorSpec `json:"selector"`
+
+	// Taint is the taint added to the nodes by the `node-taint` action.
+	// +optional
+	Taint *NodeTaint `json:"taint,omitempty"`
+
+	// KubeletService is the name of the systemd service of kubelet, which is stopped by the `kubelet-stop` action.
+	// It must be one of `kubelet` and `kubelet.service`, default to `kubelet`.
+	// +optional
+	KubeletService string `json:"kubeletService,omitempty"`
+
+	// Duration represents the duration of the chaos action
+	// +optional
+	Duration *string `json:"duration,omitempty"`
+
+	// Scheduler defines some schedule rules to control the running time of the chaos experiment about nodes.
+	// +optional
+	Scheduler *SchedulerSpec `json:"scheduler,omitempty"`
+
+	// FailurePolicy defines how to handle the targets failed to be injected, all the injected targets
+	// are rolled back by default.
+	// +optional
+	FailurePolicy *FailurePolicy `json:"failurePolicy,omitempty"`
+
+	// RetryPolicy defines how to retry the chaos failed to be applied.
+	// +optional
+	RetryPolicy *RetryPolicy `json:"retryPolicy,omitempty"`
+}
+
+// NodeTaint is the taint added to the nodes
+type NodeTaint struct {
+	// Key is the key of the taint, default to `chaos-mesh.org/node-chaos`.
+	// +optional
+	Key string `json:"key,omitempty"`
+
+	// Value is the value of the taint.
+	// +optional
+	Value string `json:"value,omitempty"`
+
+	// Effect is the effect of the taint, default to `NoExecute`.
+	// +kubebuilder:validation:Enum=NoSchedule;PreferNoSchedule;NoExecute
+	// +optional
+	Effect corev1.TaintEffect `json:"effect,omitempty"`
+}
+
+// GetSelector is a getter for Selector (for implementing SelectSpec)
+func (in *NodeChaosSpec) GetSelector() SelectorSpec {
+	return in.Selector
+}
+
+// GetMode is a getter for Mode (for implementing SelectSpec)
+func (in *NodeChaosSpec) GetMode() PodMode {
+	return in.Mode
+}
+
+// GetValue is a getter for Value (for implementing SelectSpec)
+func (in *NodeChaosSpec) GetValue() string {
+	return in.Value
+}
+
+// GetTaint returns the taint added by the node-taint action with the default values filled
+func (in *NodeChaosSpec) GetTaint() corev1.Taint {
+	taint := corev1.Taint{
+		Key:    DefaultNodeChaosTaintKey,
+		Effect: corev1.TaintEffectNoExecute,
+	}
+
+	if in.Taint != nil {
+		if in.Taint.Key != "" {
+			taint.Key = in.Taint.Key
+		}
+		if in.Taint.Effect != "" {
+			taint.Effect = in.Taint.Effect
+		}
+		taint.Value = in.Taint.Value
+	}
+
+	return taint
+}
+
+// GetKubeletService returns the name of the kubelet service
+func (in *NodeChaosSpec) GetKubeletService() string {
+	if in.KubeletService == "" {
+		return DefaultKubeletService
+	}
+	return in.KubeletService
+}
+
+// ValidateKubeletService returns an error if the service can't be stopped by the kubelet-stop action,
+// it is checked by both the webhook and chaos-daemon
+func ValidateKubeletService(service string) error {
+	if strings.HasPrefix(service, "-") || !unitNameRegex.MatchString(service) {
+		return fmt.Errorf("%q is not a valid systemd unit name", service)
+	}
+	for _, allowed := range AllowedKubeletServices {
+		if service == allowed {
+			return nil
+		}
+	}
+	return fmt.Errorf("kubelet service %q is not one of %v", service, AllowedKubeletServices)
+}
+
+// NodeChaosStatus defines the observed state of NodeChaos
+type NodeChaosStatus struct {
+	ChaosStatus `json:",inline"`
+}
@@ -0,0 +1,175 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// log is for logging in this package.
+var nodechaoslog = logf.Log.WithName("nodechaos-resource")
+
+// ClusterScoped is whether chaos mesh controls the chaos in all namespaces,
+// NodeChaos is rejected if it is false because nodes are shared by all namespaces.
+// It is set by the controller manager.
+var ClusterScoped = true
+
+// +kubebuilder:webhook:path=/mutate-chaos-mesh-org-v1alpha1-nodechaos,mutating=true,failurePolicy=fail,groups=chaos-mesh.org,resources=nodechaos,verbs=create;update,versions=v1alpha1,name=mnodechaos.kb.io
+
+var _ webhook.Defaulter = &NodeChaos{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (in *NodeChaos) Default() {
+	nodechaoslog.Info("default", "name", in.Name)
+
+	if in.Spec.Action == NodeTaintAction {
+		taint := in.Spec.GetTaint()
+		in.Spec.Taint = &NodeTaint{
+			Key:    taint.Key,
+			Value:  taint.Value,
+			Effect: taint.Effect,
+		}
+	}
+
+	if in.Spec.Action == KubeletStopAction {
+		in.Spec.KubeletService = in.Spec.GetKubeletService()
+	}
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-chaos-mesh-org-v1alpha1-nodechaos,mutating=false,failurePolicy=fail,groups=chaos-mesh.org,resources=nodechaos,versions=v1alpha1,name=vnodechaos.kb.io
+
+var _ ChaosValidator = &NodeChaos{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (in *NodeChaos) ValidateCreate() error {
+	nodechaoslog.Info("validate create", "name", in.Name)
+	if err := in.Validate(); err != nil {
+		return err
+	}
+	return validateChaosPolicy(in, true)
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (in *NodeChaos) ValidateUpdate(old runtime.Object) error {
+	nodechaoslog.Info("validate update", "name", in.Name)
+	if err := in.Validate(); err != nil {
+		return err
+	}
+	return validateChaosPolicy(in, false)
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (in *NodeChaos) ValidateDelete() error {
+	nodechaoslog.Info("validate delete", "name", in.Name)
+
+	// Nothing to do?
+	return nil
+}
+
+// Validate validates chaos object
+func (in *NodeChaos) Validate() error {
+	if !ClusterScoped {
+		return fmt.Errorf("NodeChaos is only available when chaos mesh is cluster scoped")
+	}
+
+	specField := field.NewPath("spec")
+	allErrs := in.ValidateScheduler(specField)
+	allErrs = append(allErrs, in.ValidatePodMode(specField)...)
+	allErrs = append(allErrs, in.Spec.validateSelector(specField.Child("selector"))...)
+	allErrs = append(allErrs, ValidateFailurePolicy(in.Spec.FailurePolicy, specField.Child("failurePolicy"))...)
+	allErrs = append(allErrs, ValidateRetryPolicy(in.Spec.RetryPolicy, specField.Child("retryPolicy"))...)
+	allErrs = append(allErrs, in.Spec.validateAction(specField)...)
+
+	if len(allErrs) > 0 {
+		return fmt.Errorf(allErrs.ToAggregate().Error())
+	}
+	return nil
+}
+
+// ValidateScheduler validates the scheduler and duration
+func (in *NodeChaos) ValidateScheduler(spec *field.Path) field.ErrorList {
+	return ValidateScheduler(in, spec)
+}
+
+// ValidatePodMode validates the value with podmode
+func (in *NodeChaos) ValidatePodMode(spec *field.Path) field.ErrorList {
+	return ValidatePodMode(in.Spec.Value, in.Spec.Mode, spec.Child("value"))
+}
+
+// validateSelector validates the selector is able to select nodes
+func (in *NodeChaosSpec) validateSelector(selectorField *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	selector := in.Selector
+	if len(selector.Nodes) == 0 && len(selector.NodeSelectors) == 0 &&
+		len(selector.LabelSelectors) == 0 && len(selector.ExpressionSelectors) == 0 {
+		allErrs = append(allErrs, field.Required(selectorField,
+			"one of nodes, nodeSelectors, labelSelectors and expressionSelectors is required"))
+	}
+
+	if len(selector.ExpressionSelectors) > 0 {
+		if _, err := metav1.LabelSelectorAsSelector(&metav1.LabelSelector{
+			MatchExpressions: selector.ExpressionSelectors,
+		}); err != nil {
+			allErrs = append(allErrs, field.Invalid(selectorField.Child("expressionSelectors"),
+				selector.ExpressionSelectors, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
+// validateAction validates the parameters of the action
+func (in *NodeChaosSpec) validateAction(spec *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch in.Action {
+	case NodeCordonAction, NodeDrainAction:
+	case NodeTaintAction:
+		taintField := spec.Child("taint")
+		taint := in.GetTaint()
+		for _, msg := range validation.IsQualifiedName(taint.Key) {
+			allErrs = append(allErrs, field.Invalid(taintField.Child("key"), taint.Key, msg))
+		}
+		if taint.Value != "" {
+			for _, msg := range validation.IsValidLabelValue(taint.Value) {
+				allErrs = append(allErrs, field.Invalid(taintField.Child("value"), taint.Value, msg))
+			}
+		}
+		switch taint.Effect {
+		case corev1.TaintEffectNoSchedule, corev1.TaintEffectPreferNoSchedule, corev1.TaintEffectNoExecute:
+		default:
+			allErrs = append(allErrs, field.Invalid(taintField.Child("effect"), taint.Effect,
+				fmt.Sprintf("unknown taint effect: %s", taint.Effect)))
+		}
+	case KubeletStopAction:
+		service := in.GetKubeletService()
+		if err := ValidateKubeletService(service); err != nil {
+			allErrs = append(allErrs, field.Invalid(spec.Child("kubeletService"), service, err.Error()))
+		}
+	default:
+		allErrs = append(allErrs, field.Invalid(spec.Child("action"), in.Action,
+			fmt.Sprintf("unknown node chaos action: %s", in.Action)))
+	}
+
+	return allErrs
+}
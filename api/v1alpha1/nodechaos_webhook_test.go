@@ -0,0 +1,296 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("nodechaos_webhook", func() {
+	Context("Defaulter", func() {
+		It("set default taint", func() {
+			nodechaos := &NodeChaos{
+				Spec: NodeChaosSpec{
+					Action: NodeTaintAction,
+				},
+			}
+			nodechaos.Default()
+			Expect(nodechaos.Spec.Taint.Key).To(Equal(DefaultNodeChaosTaintKey))
+			Expect(nodechaos.Spec.Taint.Effect).To(Equal(corev1.TaintEffectNoExecute))
+		})
+
+		It("set default kubelet service", func() {
+			nodechaos := &NodeChaos{
+				Spec: NodeChaosSpec{
+					Action: KubeletStopAction,
+				},
+			}
+			nodechaos.Default()
+			Expect(nodechaos.Spec.KubeletService).To(Equal(DefaultKubeletService))
+		})
+	})
+	Context("ChaosValidator of nodechaos", func() {
+		It("Validate", func() {
+
+			type TestCase struct {
+				name    string
+				chaos   NodeChaos
+				execute func(chaos *NodeChaos) error
+				expect  string
+			}
+			tcs := []TestCase{
+				{
+					name: "simple ValidateCreate with node-cordon",
+					chaos: NodeChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo1",
+						},
+						Spec: NodeChaosSpec{
+							Action: NodeCordonAction,
+							Mode:   OnePodMode,
+							Selector: SelectorSpec{
+								Nodes: []string{"node1"},
+							},
+						},
+					},
+					execute: func(chaos *NodeChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "",
+				},
+				{
+					name: "simple ValidateUpdate with node-taint",
+					chaos: NodeChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo2",
+						},
+						Spec: NodeChaosSpec{
+							Action: NodeTaintAction,
+							Mode:   AllPodMode,
+							Selector: SelectorSpec{
+								NodeSelectors: map[string]string{"role": "tikv"},
+							},
+							Taint: &NodeTaint{
+								Key:    "example.com/chaos",
+								Value:  "true",
+								Effect: corev1.TaintEffectNoSchedule,
+							},
+						},
+					},
+					execute: func(chaos *NodeChaos) error {
+						return chaos.ValidateUpdate(chaos)
+					},
+					expect: "",
+				},
+				{
+					name: "simple ValidateDelete",
+					chaos: NodeChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo3",
+						},
+					},
+					execute: func(chaos *NodeChaos) error {
+						return chaos.ValidateDelete()
+					},
+					expect: "",
+				},
+				{
+					name: "without node selector",
+					chaos: NodeChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo4",
+						},
+						Spec: NodeChaosSpec{
+							Action: NodeDrainAction,
+							Mode:   OnePodMode,
+						},
+					},
+					execute: func(chaos *NodeChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "invalid taint key",
+					chaos: NodeChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo5",
+						},
+						Spec: NodeChaosSpec{
+							Action: NodeTaintAction,
+							Mode:   OnePodMode,
+							Selector: SelectorSpec{
+								Nodes: []string{"node1"},
+							},
+							Taint: &NodeTaint{
+								Key: "invalid key",
+							},
+						},
+					},
+					execute: func(chaos *NodeChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "invalid taint effect",
+					chaos: NodeChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo6",
+						},
+						Spec: NodeChaosSpec{
+							Action: NodeTaintAction,
+							Mode:   OnePodMode,
+							Selector: SelectorSpec{
+								Nodes: []string{"node1"},
+							},
+							Taint: &NodeTaint{
+								Effect: "NoRun",
+							},
+						},
+					},
+					execute: func(chaos *NodeChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "unknown action",
+					chaos: NodeChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo7",
+						},
+						Spec: NodeChaosSpec{
+							Action: "node-reboot",
+							Mode:   OnePodMode,
+							Selector: SelectorSpec{
+								Nodes: []string{"node1"},
+							},
+						},
+					},
+					execute: func(chaos *NodeChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "kubelet-stop with the kubelet unit",
+					chaos: NodeChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo8",
+						},
+						Spec: NodeChaosSpec{
+							Action: KubeletStopAction,
+							Mode:   OnePodMode,
+							Selector: SelectorSpec{
+								Nodes: []string{"node1"},
+							},
+							KubeletService: "kubelet.service",
+						},
+					},
+					execute: func(chaos *NodeChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "",
+				},
+				{
+					name: "kubelet-stop with other service",
+					chaos: NodeChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo9",
+						},
+						Spec: NodeChaosSpec{
+							Action: KubeletStopAction,
+							Mode:   OnePodMode,
+							Selector: SelectorSpec{
+								Nodes: []string{"node1"},
+							},
+							KubeletService: "containerd",
+						},
+					},
+					execute: func(chaos *NodeChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "kubelet-stop with systemctl option",
+					chaos: NodeChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo10",
+						},
+						Spec: NodeChaosSpec{
+							Action: KubeletStopAction,
+							Mode:   OnePodMode,
+							Selector: SelectorSpec{
+								Nodes: []string{"node1"},
+							},
+							KubeletService: "--force",
+						},
+					},
+					execute: func(chaos *NodeChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "not cluster scoped",
+					chaos: NodeChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo11",
+						},
+						Spec: NodeChaosSpec{
+							Action: NodeCordonAction,
+							Mode:   OnePodMode,
+							Selector: SelectorSpec{
+								Nodes: []string{"node1"},
+							},
+						},
+					},
+					execute: func(chaos *NodeChaos) error {
+						ClusterScoped = false
+						defer func() {
+							ClusterScoped = true
+						}()
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+			}
+
+			for _, tc := range tcs {
+				err := tc.execute(&tc.chaos)
+				if tc.expect == "error" {
+					Expect(err).To(HaveOccurred(), tc.name)
+				} else {
+					Expect(err).NotTo(HaveOccurred(), tc.name)
+				}
+			}
+		})
+	})
+})
@@ -44,6 +44,9 @@ const (
 	PodFailureAction PodChaosAction = "pod-failure"
 	// ContainerKillAction represents the chaos action of killing the container
 	ContainerKillAction PodChaosAction = "container-kill"
+	// ScaleToZeroAction represents the chaos action of scaling the Deployments and StatefulSets
+	// owning the pods to zero replicas. The replicas are restored on recover.
+	ScaleToZeroAction PodChaosAction = "scale-to-zero"
 )
 
 // PodChaosSpec defines the attributes that a user creates on a chaos experiment about pods.
@@ -55,15 +58,30 @@ type PodChaosSpec struct {
 	// control the running time of the chaos experiment about pods.
 	Scheduler *SchedulerSpec `json:"scheduler,omitempty"`
 
+	// FailurePolicy defines how to handle the targets failed to be injected, all the injected targets
+	// are rolled back by default.
+	// +optional
+	FailurePolicy *FailurePolicy `json:"failurePolicy,omitempty"`
+
+	// RetryPolicy defines how to retry the chaos failed to be applied.
+	// +optional
+	RetryPolicy *RetryPolicy `json:"retryPolicy,omitempty"`
+
+	// ConflictPolicy defines how to handle the targets leased by other experiments of the same kind,
+	// one of Reject, Queue and Merge, Merge by default.
+	// +kubebuilder:validation:Enum=Reject;Queue;Merge
+	// +optional
+	ConflictPolicy ConflictPolicyType `json:"conflictPolicy,omitempty"`
+
 	// Action defines the specific pod chaos action.
-	// Supported action: pod-kill / pod-failure / container-kill
+	// Supported action: pod-kill / pod-failure / container-kill / scale-to-zero
 	// Default action: pod-kill
-	// +kubebuilder:validation:Enum=pod-kill;pod-failure;container-kill
+	// +kubebuilder:validation:Enum=pod-kill;pod-failure;container-kill;scale-to-zero
 	Action PodChaosAction `json:"action"`
 
 	// Mode defines the mode to run chaos action.
 	// Supported mode: one / all / fixed / fixed-percent / random-max-percent
-	// +kubebuilder:validation:Enum=one;all;fixed;fixed-percent;random-max-percent
+	// +kubebuilder:validation:Enum=one;all;fixed;fixed-percent;random-max-percent;one-per-zone;all-in-one-zone;fixed-spread-nodes
 	Mode PodMode `json:"mode"`
 
 	// Value is required when the mode is set to `FixedPodMode` / `FixedPercentPodMod` / `RandomMaxPercentPodMod`.
@@ -73,8 +91,14 @@ type PodChaosSpec struct {
 	// +optional
 	Value string `json:"value"`
 
+	// Seed is used to select the targets in the modes `one` / `fixed` / `fixed-percent` / `random-max-percent`.
+	// The same seed always selects the same targets from the same candidates.
+	// A random seed will be used if it is not set, and the seed used is recorded in the status.
+	// +optional
+	Seed *int64 `json:"seed,omitempty"`
+
 	// Duration represents the duration of the chaos action.
-	// It is required when the action is `PodFailureAction`.
+	// It is required when the action is `PodFailureAction` or `ScaleToZeroAction`.
 	// A duration string is a possibly signed sequence of
 	// decimal numbers, each with optional fraction and a unit suffix,
 	// such as "300ms", "-1.5h" or "2h45m".
@@ -83,7 +107,8 @@ type PodChaosSpec struct {
 	Duration *string `json:"duration,omitempty"`
 
 	// ContainerName indicates the name of the container.
-	// Needed in container-kill.
+	// Needed in container-kill. If only one static component is selected by the selector,
+	// it defaults to the name of the component.
 	// +optional
 	ContainerName string `json:"containerName"`
 
@@ -123,4 +148,34 @@ type PodStatus struct {
 	// e.g. "delete this pod" or "pause this pod duration 5m"
 	// +optional
 	Message string `json:"message"`
+
+	// State is the state of the injection on the target, one of Injected, Failed, Queued and Recovered.
+	// +optional
+	State TargetState `json:"state,omitempty"`
+
+	// Error is the error of the last injection or recovery on the target.
+	// +optional
+	Error string `json:"error,omitempty"`
+
+	// InjectedAt is the time when the chaos was injected into the target.
+	// +optional
+	InjectedAt *metav1.Time `json:"injectedAt,omitempty"`
+
+	// RecoveredAt is the time when the target was recovered.
+	// +optional
+	RecoveredAt *metav1.Time `json:"recoveredAt,omitempty"`
 }
+
+// TargetState is the state of the injection on a target
+type TargetState string
+
+const (
+	// TargetInjected means the chaos is injected into the target
+	TargetInjected TargetState = "Injected"
+	// TargetFailed means the chaos failed to be injected into the target
+	TargetFailed TargetState = "Failed"
+	// TargetQueued means the target is leased by other experiments, and the chaos waits for it
+	TargetQueued TargetState = "Queued"
+	// TargetRecovered means the target is recovered
+	TargetRecovered TargetState = "Recovered"
+)
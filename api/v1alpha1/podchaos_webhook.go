@@ -34,6 +34,12 @@ func (in *PodChaos) Default() {
 	podchaoslog.Info("default", "name", in.Name)
 
 	in.Spec.Selector.DefaultNamespace(in.GetNamespace())
+
+	// the container running a static component has the same name as the component
+	if in.Spec.Action == ContainerKillAction && in.Spec.ContainerName == "" &&
+		len(in.Spec.Selector.Components) == 1 && in.Spec.Selector.Components[0].IsStaticPod() {
+		in.Spec.ContainerName = string(in.Spec.Selector.Components[0])
+	}
 }
 
 // +kubebuilder:webhook:verbs=create;update,path=/validate-chaos-mesh-org-v1alpha1-podchaos,mutating=false,failurePolicy=fail,groups=chaos-mesh.org,resources=podchaos,versions=v1alpha1,name=vpodchaos.kb.io
@@ -43,13 +49,22 @@ var _ ChaosValidator = &PodChaos{}
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type
 func (in *PodChaos) ValidateCreate() error {
 	podchaoslog.Info("validate create", "name", in.Name)
-	return in.Validate()
+	if err := in.Validate(); err != nil {
+		return err
+	}
+	if err := validateNamespaceInjection(in); err != nil {
+		return err
+	}
+	return validateChaosPolicy(in, true)
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
 func (in *PodChaos) ValidateUpdate(old runtime.Object) error {
 	podchaoslog.Info("validate update", "name", in.Name)
-	return in.Validate()
+	if err := in.Validate(); err != nil {
+		return err
+	}
+	return validateChaosPolicy(in, false)
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
@@ -65,7 +80,12 @@ func (in *PodChaos) Validate() error {
 	specField := field.NewPath("spec")
 	allErrs := in.ValidateScheduler(specField)
 	allErrs = append(allErrs, in.ValidatePodMode(specField)...)
+	allErrs = append(allErrs, ValidateSelector(in.Spec.Selector, specField.Child("selector"))...)
+	allErrs = append(allErrs, ValidateFailurePolicy(in.Spec.FailurePolicy, specField.Child("failurePolicy"))...)
+	allErrs = append(allErrs, ValidateRetryPolicy(in.Spec.RetryPolicy, specField.Child("retryPolicy"))...)
+	allErrs = append(allErrs, ValidateConflictPolicy(in.Spec.ConflictPolicy, specField.Child("conflictPolicy"))...)
 	allErrs = append(allErrs, in.Spec.validateContainerName(specField.Child("containerName"))...)
+	allErrs = append(allErrs, in.Spec.validateComponents(specField.Child("selector", "components"))...)
 
 	if len(allErrs) > 0 {
 		return fmt.Errorf(allErrs.ToAggregate().Error())
@@ -79,7 +99,7 @@ func (in *PodChaos) ValidateScheduler(spec *field.Path) field.ErrorList {
 	schedulerField := spec.Child("scheduler")
 
 	switch in.Spec.Action {
-	case PodFailureAction:
+	case PodFailureAction, ScaleToZeroAction:
 		allErrs = append(allErrs, ValidateScheduler(in, spec)...)
 		break
 	case PodKillAction:
@@ -89,6 +109,8 @@ func (in *PodChaos) ValidateScheduler(spec *field.Path) field.ErrorList {
 		} else {
 			_, err := ParseCron(in.Spec.Scheduler.Cron, schedulerField.Child("cron"))
 			allErrs = append(allErrs, err...)
+			allErrs = append(allErrs, validateCalendar(in.Spec.Scheduler, schedulerField)...)
+			allErrs = append(allErrs, validateRandomizer(in.Spec.Scheduler, schedulerField)...)
 		}
 		break
 	case ContainerKillAction:
@@ -98,6 +120,8 @@ func (in *PodChaos) ValidateScheduler(spec *field.Path) field.ErrorList {
 		} else {
 			_, err := ParseCron(in.Spec.Scheduler.Cron, schedulerField.Child("cron"))
 			allErrs = append(allErrs, err...)
+			allErrs = append(allErrs, validateCalendar(in.Spec.Scheduler, schedulerField)...)
+			allErrs = append(allErrs, validateRandomizer(in.Spec.Scheduler, schedulerField)...)
 		}
 		break
 	default:
@@ -127,3 +151,25 @@ func (in *PodChaosSpec) validateContainerName(containerField *field.Path) field.
 	}
 	return allErrs
 }
+
+// validateComponents validates the action is able to be applied on the selected components
+func (in *PodChaosSpec) validateComponents(componentsField *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for i, component := range in.Selector.Components {
+		if !component.IsStaticPod() {
+			continue
+		}
+
+		switch in.Action {
+		case PodKillAction, PodFailureAction:
+			// the kubelet doesn't restart a static pod when its mirror pod is deleted or updated
+			err := fmt.Errorf("%s action can not be applied on static component %s, use %s instead",
+				in.Action, component, ContainerKillAction)
+			allErrs = append(allErrs, field.Invalid(componentsField.Index(i), component, err.Error()))
+		case ScaleToZeroAction:
+			err := fmt.Errorf("%s action can not be applied on static component %s", in.Action, component)
+			allErrs = append(allErrs, field.Invalid(componentsField.Index(i), component, err.Error()))
+		}
+	}
+	return allErrs
+}
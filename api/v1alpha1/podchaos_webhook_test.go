@@ -28,6 +28,20 @@ var _ = Describe("podchaos_webhook", func() {
 			podchaos.Default()
 			Expect(podchaos.Spec.Selector.Namespaces[0]).To(Equal(metav1.NamespaceDefault))
 		})
+
+		It("set default container name of static component", func() {
+			podchaos := &PodChaos{
+				ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault},
+				Spec: PodChaosSpec{
+					Action: ContainerKillAction,
+					Selector: SelectorSpec{
+						Components: []KubeComponent{EtcdComponent},
+					},
+				},
+			}
+			podchaos.Default()
+			Expect(podchaos.Spec.ContainerName).To(Equal(string(EtcdComponent)))
+		})
 	})
 	Context("ChaosValidator of podchaos", func() {
 		It("Validate", func() {
@@ -39,6 +53,7 @@ var _ = Describe("podchaos_webhook", func() {
 				expect  string
 			}
 			duration := "400s"
+			negativeDuration := "-400s"
 			tcs := []TestCase{
 				{
 					name: "simple ValidateCreate for ContainerKillAction",
@@ -119,6 +134,23 @@ var _ = Describe("podchaos_webhook", func() {
 					execute: func(chaos *PodChaos) error {
 						return chaos.ValidateCreate()
 					},
+					expect: "",
+				},
+				{
+					name: "negative Duration without Scheduler",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo5",
+						},
+						Spec: PodChaosSpec{
+							Action:   PodFailureAction,
+							Duration: &negativeDuration,
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						return chaos.ValidateCreate()
+					},
 					expect: "error",
 				},
 				{
@@ -150,6 +182,307 @@ var _ = Describe("podchaos_webhook", func() {
 					},
 					expect: "error",
 				},
+				{
+					name: "kill the pods of static component",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo8",
+						},
+						Spec: PodChaosSpec{
+							Action:    PodKillAction,
+							Scheduler: &SchedulerSpec{Cron: "@every 10m"},
+							Selector: SelectorSpec{
+								Components: []KubeComponent{KubeAPIServerComponent},
+							},
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "scale coredns to zero",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo9",
+						},
+						Spec: PodChaosSpec{
+							Action:    ScaleToZeroAction,
+							Scheduler: &SchedulerSpec{Cron: "@every 10m"},
+							Duration:  &duration,
+							Selector: SelectorSpec{
+								Components: []KubeComponent{CoreDNSComponent},
+							},
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "",
+				},
+				{
+					name: "unknown component",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo10",
+						},
+						Spec: PodChaosSpec{
+							Action:        ContainerKillAction,
+							ContainerName: "kubelet",
+							Scheduler:     &SchedulerSpec{Cron: "@every 10m"},
+							Selector: SelectorSpec{
+								Components: []KubeComponent{"kubelet"},
+							},
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "kill the pods in business hours",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo11",
+						},
+						Spec: PodChaosSpec{
+							Action: PodKillAction,
+							Scheduler: &SchedulerSpec{
+								Cron:     "@every 10m",
+								TimeZone: "Europe/Berlin",
+								AllowedWindows: []TimeWindow{
+									{Days: []Weekday{"Mon", "Fri"}, Start: "10:00", End: "16:00"},
+								},
+								Blackouts: []BlackoutPeriod{{Name: "christmas", Start: "2020-12-24", End: "2020-12-26"}},
+							},
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "",
+				},
+				{
+					name: "invalid allowed window",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo12",
+						},
+						Spec: PodChaosSpec{
+							Action: PodKillAction,
+							Scheduler: &SchedulerSpec{
+								Cron:           "@every 10m",
+								AllowedWindows: []TimeWindow{{Start: "16:00", End: "10:00"}},
+							},
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "unknown time zone",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo13",
+						},
+						Spec: PodChaosSpec{
+							Action:    PodKillAction,
+							Scheduler: &SchedulerSpec{Cron: "@every 10m", TimeZone: "Mars/Olympus"},
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "random duration without the duration",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo14",
+						},
+						Spec: PodChaosSpec{
+							Action: PodFailureAction,
+							Scheduler: &SchedulerSpec{
+								Cron:           "@every 10m",
+								Jitter:         "2m",
+								RandomDuration: &DurationRange{Min: "1m", Max: "5m"},
+							},
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "",
+				},
+				{
+					name: "jitter and duration longer than the interval",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo15",
+						},
+						Spec: PodChaosSpec{
+							Action:    PodFailureAction,
+							Duration:  &duration,
+							Scheduler: &SchedulerSpec{Cron: "@every 10m", Jitter: "5m"},
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "invalid random duration",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo16",
+						},
+						Spec: PodChaosSpec{
+							Action: PodFailureAction,
+							Scheduler: &SchedulerSpec{
+								Cron:           "@every 10m",
+								RandomDuration: &DurationRange{Min: "5m", Max: "1m"},
+							},
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "retry failed policy",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo17",
+						},
+						Spec: PodChaosSpec{
+							Action:        PodFailureAction,
+							FailurePolicy: &FailurePolicy{Type: RetryFailedPolicy, Backoff: "5s"},
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "",
+				},
+				{
+					name: "unsupported failure policy",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo18",
+						},
+						Spec: PodChaosSpec{
+							Action:        PodFailureAction,
+							FailurePolicy: &FailurePolicy{Type: "Ignore"},
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "invalid failure policy backoff",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo19",
+						},
+						Spec: PodChaosSpec{
+							Action:        PodFailureAction,
+							FailurePolicy: &FailurePolicy{Type: RetryFailedPolicy, Backoff: "-1s"},
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "retry policy",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo20",
+						},
+						Spec: PodChaosSpec{
+							Action:      PodFailureAction,
+							RetryPolicy: &RetryPolicy{Backoff: "30s", MaxBackoff: "10m"},
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "",
+				},
+				{
+					name: "invalid retry policy backoff",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo21",
+						},
+						Spec: PodChaosSpec{
+							Action:      PodFailureAction,
+							RetryPolicy: &RetryPolicy{Backoff: "10m", MaxBackoff: "5m"},
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "conflict policy",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo22",
+						},
+						Spec: PodChaosSpec{
+							Action:         PodFailureAction,
+							ConflictPolicy: QueueConflictPolicy,
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "",
+				},
+				{
+					name: "unsupported conflict policy",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo23",
+						},
+						Spec: PodChaosSpec{
+							Action:         PodFailureAction,
+							ConflictPolicy: "Preempt",
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
 			}
 
 			for _, tc := range tcs {
@@ -0,0 +1,51 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"fmt"
+	"time"
+)
+
+// GetJitter returns the max random offset of each scheduled start, or zero if it is not set
+func (in *SchedulerSpec) GetJitter() (time.Duration, error) {
+	if in.Jitter == "" {
+		return 0, nil
+	}
+	jitter, err := time.ParseDuration(in.Jitter)
+	if err != nil {
+		return 0, err
+	}
+	if jitter < 0 {
+		return 0, fmt.Errorf("jitter %s should not be negative", in.Jitter)
+	}
+	return jitter, nil
+}
+
+// Parse returns the min and the max duration of the range
+func (in *DurationRange) Parse() (min, max time.Duration, err error) {
+	if min, err = time.ParseDuration(in.Min); err != nil {
+		return 0, 0, err
+	}
+	if max, err = time.ParseDuration(in.Max); err != nil {
+		return 0, 0, err
+	}
+	if min <= 0 {
+		return 0, 0, fmt.Errorf("the min duration %s should be positive", in.Min)
+	}
+	if max < min {
+		return 0, 0, fmt.Errorf("the max duration %s should not be less than the min duration %s", in.Max, in.Min)
+	}
+	return min, max, nil
+}
@@ -15,7 +15,10 @@ package v1alpha1
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -50,6 +53,12 @@ type StressChaosSpec struct {
 	// +optional
 	Value string `json:"value"`
 
+	// Seed is used to select the targets in the modes `one` / `fixed` / `fixed-percent` / `random-max-percent`.
+	// The same seed always selects the same targets from the same candidates.
+	// A random seed will be used if it is not set, and the seed used is recorded in the status.
+	// +optional
+	Seed *int64 `json:"seed,omitempty"`
+
 	// Selector is used to select pods that are used to inject chaos action.
 	Selector SelectorSpec `json:"selector"`
 
@@ -79,6 +88,21 @@ type StressChaosSpec struct {
 	// Scheduler defines some schedule rules to control the running time of the chaos experiment about time.
 	// +optional
 	Scheduler *SchedulerSpec `json:"scheduler,omitempty"`
+
+	// FailurePolicy defines how to handle the targets failed to be injected, all the injected targets
+	// are rolled back by default.
+	// +optional
+	FailurePolicy *FailurePolicy `json:"failurePolicy,omitempty"`
+
+	// RetryPolicy defines how to retry the chaos failed to be applied.
+	// +optional
+	RetryPolicy *RetryPolicy `json:"retryPolicy,omitempty"`
+
+	// ConflictPolicy defines how to handle the targets leased by other experiments of the same kind,
+	// one of Reject, Queue and Merge, Merge by default.
+	// +kubebuilder:validation:Enum=Reject;Queue;Merge
+	// +optional
+	ConflictPolicy ConflictPolicyType `json:"conflictPolicy,omitempty"`
 }
 
 // GetSelector is a getter for Selector (for implementing SelectSpec)
@@ -112,6 +136,12 @@ type StressInstance struct {
 	// StartTime specifies when the instance starts
 	// +optional
 	StartTime *metav1.Time `json:"startTime"`
+	// MemoryUID is the identifier of the process holding memory in `hold` mode
+	// +optional
+	MemoryUID string `json:"memoryUID,omitempty"`
+	// MemoryStartTime specifies when the process holding memory starts
+	// +optional
+	MemoryStartTime *metav1.Time `json:"memoryStartTime,omitempty"`
 }
 
 // Stressors defines plenty of stressors supported to stress system components out.
@@ -123,13 +153,37 @@ type Stressors struct {
 	// CPUStressor stresses CPU out
 	// +optional
 	CPUStressor *CPUStressor `json:"cpu,omitempty"`
+	// IOStressor stresses I/O out by committing buffer cache to disk
+	// +optional
+	IOStressor *IOStressor `json:"io,omitempty"`
+	// HDDStressor stresses disks out by writing and removing temporary files
+	// +optional
+	HDDStressor *HDDStressor `json:"hdd,omitempty"`
+	// VMStressor stresses virtual memory out by writing and reading mapped memory
+	// +optional
+	VMStressor *VMStressor `json:"vm,omitempty"`
+	// SockStressor stresses network sockets out by sending and receiving data
+	// +optional
+	SockStressor *SockStressor `json:"sock,omitempty"`
 }
 
-// Normalize the stressors to comply with stress-ng
-func (in *Stressors) Normalize() (string, error) {
+// Normalize the stressors to comply with stress-ng. The memoryLimit is the memory limit
+// of the target container, which is used to resolve the size of the MemoryStressor in
+// percentage. It's nil if the container has no memory limit.
+func (in *Stressors) Normalize(memoryLimit *resource.Quantity) (string, error) {
 	stressors := ""
-	if in.MemoryStressor != nil {
-		stressors += fmt.Sprintf(" --bigheap %d", in.MemoryStressor.Workers)
+	// the memory in `hold` mode is consumed by chaos-daemon rather than stress-ng
+	if in.MemoryStressor != nil && !in.MemoryStressor.IsHoldMode() {
+		if len(in.MemoryStressor.Size) == 0 {
+			stressors += fmt.Sprintf(" --bigheap %d", in.MemoryStressor.Workers)
+		} else {
+			size, err := in.MemoryStressor.Bytes(memoryLimit)
+			if err != nil {
+				return "", err
+			}
+			stressors += fmt.Sprintf(" --vm %d --vm-keep --vm-bytes %d",
+				in.MemoryStressor.Workers, perWorker(size, in.MemoryStressor.Workers))
+		}
 
 		if in.MemoryStressor.Options != nil {
 			for _, v := range in.MemoryStressor.Options {
@@ -150,6 +204,61 @@ func (in *Stressors) Normalize() (string, error) {
 			}
 		}
 	}
+	if in.IOStressor != nil {
+		stressors += fmt.Sprintf(" --io %d", in.IOStressor.Workers)
+
+		for _, v := range in.IOStressor.Options {
+			stressors += fmt.Sprintf(" %v ", v)
+		}
+	}
+	if in.HDDStressor != nil {
+		stressors += fmt.Sprintf(" --hdd %d", in.HDDStressor.Workers)
+		if len(in.HDDStressor.Size) != 0 {
+			size, err := parseBytes(in.HDDStressor.Size)
+			if err != nil {
+				return "", err
+			}
+			stressors += fmt.Sprintf(" --hdd-bytes %d", perWorker(size, in.HDDStressor.Workers))
+		}
+		if len(in.HDDStressor.WriteSize) != 0 {
+			size, err := parseBytes(in.HDDStressor.WriteSize)
+			if err != nil {
+				return "", err
+			}
+			stressors += fmt.Sprintf(" --hdd-write-size %d", size)
+		}
+
+		for _, v := range in.HDDStressor.Options {
+			stressors += fmt.Sprintf(" %v ", v)
+		}
+	}
+	if in.VMStressor != nil {
+		stressors += fmt.Sprintf(" --vm %d", in.VMStressor.Workers)
+		if len(in.VMStressor.Size) != 0 {
+			size, err := parseBytes(in.VMStressor.Size)
+			if err != nil {
+				return "", err
+			}
+			stressors += fmt.Sprintf(" --vm-bytes %d", perWorker(size, in.VMStressor.Workers))
+		}
+
+		for _, v := range in.VMStressor.Options {
+			stressors += fmt.Sprintf(" %v ", v)
+		}
+	}
+	if in.SockStressor != nil {
+		stressors += fmt.Sprintf(" --sock %d", in.SockStressor.Workers)
+		if len(in.SockStressor.Domain) != 0 {
+			stressors += fmt.Sprintf(" --sock-domain %s", in.SockStressor.Domain)
+		}
+		if in.SockStressor.Port != nil {
+			stressors += fmt.Sprintf(" --sock-port %d", *in.SockStressor.Port)
+		}
+
+		for _, v := range in.SockStressor.Options {
+			stressors += fmt.Sprintf(" %v ", v)
+		}
+	}
 	return stressors, nil
 }
 
@@ -159,15 +268,79 @@ type Stressor struct {
 	Workers int `json:"workers"`
 }
 
+// MemoryStressMode represents how the memory is consumed
+type MemoryStressMode string
+
+const (
+	// StressngMemoryStressMode represents consuming the memory by workers of stress-ng
+	StressngMemoryStressMode MemoryStressMode = "stress-ng"
+	// HoldMemoryStressMode represents allocating and holding exactly the size of memory
+	// by a single process
+	HoldMemoryStressMode MemoryStressMode = "hold"
+)
+
 // MemoryStressor defines how to stress memory out
 type MemoryStressor struct {
 	Stressor `json:",inline"`
 
+	// Mode specifies how the memory is consumed, can be set to `stress-ng` / `hold`.
+	// If `stress-ng`, workers of stress-ng consume the memory.
+	// If `hold`, a single process allocates and holds exactly the Size of memory, and it's
+	// preferred by the OOM killer to the processes of the target container. The Workers and
+	// Options are ignored in this mode.
+	// Default mode: stress-ng
+	// +kubebuilder:validation:Enum=stress-ng;hold
+	// +optional
+	Mode MemoryStressMode `json:"mode,omitempty"`
+
+	// Size specifies the total memory consumed by all workers, in bytes (such as "256Mi")
+	// or in percentage of the memory limit of the target container (such as "50%").
+	// The memory is kept until the stressors are canceled. If it's not set, the workers
+	// consume memory until they are killed by the OOM killer. It's required in `hold` mode.
+	// +optional
+	Size string `json:"size,omitempty"`
+
+	// RampRate specifies how much memory is allocated per second in `hold` mode, such as "64Mi".
+	// If it's not set, all the memory is allocated at once.
+	// +optional
+	RampRate string `json:"rampRate,omitempty"`
+
 	// extend stress-ng options
 	// +optional
 	Options []string `json:"options,omitempty"`
 }
 
+// IsHoldMode returns whether the memory is held by a single process instead of stress-ng
+func (in *MemoryStressor) IsHoldMode() bool {
+	return in.Mode == HoldMemoryStressMode
+}
+
+// RampRateBytes returns the bytes allocated per second in `hold` mode, 0 means no ramp
+func (in *MemoryStressor) RampRateBytes() (int64, error) {
+	if len(in.RampRate) == 0 {
+		return 0, nil
+	}
+	return parseBytes(in.RampRate)
+}
+
+// Bytes returns the size of memory consumed by all workers, the size in percentage
+// is resolved with the memory limit of the target container.
+func (in *MemoryStressor) Bytes(memoryLimit *resource.Quantity) (int64, error) {
+	if !strings.HasSuffix(in.Size, "%") {
+		return parseBytes(in.Size)
+	}
+
+	percent, err := parsePercent(in.Size)
+	if err != nil {
+		return 0, err
+	}
+	if memoryLimit == nil || memoryLimit.IsZero() {
+		return 0, fmt.Errorf("the size %s can't be resolved without a memory limit", in.Size)
+	}
+
+	return memoryLimit.Value() * int64(percent) / 100, nil
+}
+
 // CPUStressor defines how to stress CPU out
 type CPUStressor struct {
 	Stressor `json:",inline"`
@@ -180,3 +353,85 @@ type CPUStressor struct {
 	// +optional
 	Options []string `json:"options,omitempty"`
 }
+
+// IOStressor defines how to stress I/O out
+type IOStressor struct {
+	Stressor `json:",inline"`
+
+	// extend stress-ng options
+	// +optional
+	Options []string `json:"options,omitempty"`
+}
+
+// HDDStressor defines how to stress disks out. The I/O is accounted to the target
+// container, while the temporary files are written in the working directory of chaos-daemon.
+type HDDStressor struct {
+	Stressor `json:",inline"`
+
+	// Size specifies the total bytes written by all workers, such as "1Gi".
+	// +optional
+	Size string `json:"size,omitempty"`
+
+	// WriteSize specifies the size of each write, from 1 byte to 4MiB, such as "64Ki".
+	// +optional
+	WriteSize string `json:"writeSize,omitempty"`
+
+	// extend stress-ng options
+	// +optional
+	Options []string `json:"options,omitempty"`
+}
+
+// VMStressor defines how to stress virtual memory out
+type VMStressor struct {
+	Stressor `json:",inline"`
+
+	// Size specifies the total bytes mapped by all workers, such as "256Mi".
+	// +optional
+	Size string `json:"size,omitempty"`
+
+	// extend stress-ng options
+	// +optional
+	Options []string `json:"options,omitempty"`
+}
+
+// SockStressor defines how to stress network sockets out in the network namespace
+// of the target container
+type SockStressor struct {
+	Stressor `json:",inline"`
+
+	// Domain specifies the domain of sockets, can be set to `ipv4` / `ipv6` / `unix`.
+	// +kubebuilder:validation:Enum=ipv4;ipv6;unix
+	// +optional
+	Domain string `json:"domain,omitempty"`
+
+	// Port specifies the port that the workers start listening on, each worker listens
+	// on a different port starting from it.
+	// +optional
+	Port *int `json:"port,omitempty"`
+
+	// extend stress-ng options
+	// +optional
+	Options []string `json:"options,omitempty"`
+}
+
+// parseBytes parses a size in bytes, such as "256Mi"
+func parseBytes(size string) (int64, error) {
+	quantity, err := resource.ParseQuantity(size)
+	if err != nil {
+		return 0, err
+	}
+	return quantity.Value(), nil
+}
+
+// parsePercent parses a size in percentage, such as "50%"
+func parsePercent(size string) (int, error) {
+	return strconv.Atoi(strings.TrimSuffix(size, "%"))
+}
+
+// perWorker splits the size to each worker
+func perWorker(size int64, workers int) int64 {
+	if workers <= 1 {
+		return size
+	}
+	return size / int64(workers)
+}
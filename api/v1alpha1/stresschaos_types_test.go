@@ -18,6 +18,7 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
@@ -60,3 +61,58 @@ var _ = Describe("StressChaos", func() {
 	})
 
 })
+
+var _ = Describe("Stressors", func() {
+	Context("Normalize", func() {
+		It("should normalize typed stressors", func() {
+			port := 5000
+			stressors := &Stressors{
+				IOStressor: &IOStressor{Stressor: Stressor{Workers: 1}},
+				HDDStressor: &HDDStressor{
+					Stressor:  Stressor{Workers: 2},
+					Size:      "1Gi",
+					WriteSize: "64Ki",
+				},
+				VMStressor: &VMStressor{
+					Stressor: Stressor{Workers: 2},
+					Size:     "256Mi",
+				},
+				SockStressor: &SockStressor{
+					Stressor: Stressor{Workers: 1},
+					Domain:   "ipv6",
+					Port:     &port,
+				},
+			}
+			Expect(stressors.Normalize(nil)).To(Equal(" --io 1" +
+				" --hdd 2 --hdd-bytes 536870912 --hdd-write-size 65536" +
+				" --vm 2 --vm-bytes 134217728" +
+				" --sock 1 --sock-domain ipv6 --sock-port 5000"))
+		})
+
+		It("should resolve the memory size in percentage", func() {
+			limit := resource.MustParse("1Gi")
+			stressors := &Stressors{
+				MemoryStressor: &MemoryStressor{
+					Stressor: Stressor{Workers: 2},
+					Size:     "50%",
+				},
+			}
+			Expect(stressors.Normalize(&limit)).To(Equal(" --vm 2 --vm-keep --vm-bytes 268435456"))
+
+			_, err := stressors.Normalize(nil)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should leave the memory in hold mode to chaos-daemon", func() {
+			stressors := &Stressors{
+				MemoryStressor: &MemoryStressor{
+					Mode: HoldMemoryStressMode,
+					Size: "256Mi",
+				},
+				CPUStressor: &CPUStressor{Stressor: Stressor{Workers: 1}},
+			}
+			Expect(stressors.Normalize(nil)).To(Equal(" --cpu 1"))
+			Expect(stressors.MemoryStressor.Bytes(nil)).To(Equal(int64(256 << 20)))
+		})
+	})
+})
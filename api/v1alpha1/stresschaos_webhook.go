@@ -15,6 +15,7 @@ package v1alpha1
 
 import (
 	"fmt"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -29,6 +30,13 @@ type Validateable interface {
 	Validate(parent *field.Path) field.ErrorList
 }
 
+const (
+	// the limits required by stress-ng
+	maxHDDWriteSize = 4 << 20
+	minSockPort     = 1024
+	maxSockPort     = 65535
+)
+
 // log is for logging in this package.
 var (
 	stressChaosLog = ctrl.Log.WithName("stresschaos-resource")
@@ -51,13 +59,22 @@ var _ ChaosValidator = &StressChaos{}
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type
 func (in *StressChaos) ValidateCreate() error {
 	stressChaosLog.Info("validate create", "name", in.Name)
-	return in.Validate()
+	if err := in.Validate(); err != nil {
+		return err
+	}
+	if err := validateNamespaceInjection(in); err != nil {
+		return err
+	}
+	return validateChaosPolicy(in, true)
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
 func (in *StressChaos) ValidateUpdate(old runtime.Object) error {
 	stressChaosLog.Info("validate update", "name", in.Name)
-	return in.Validate()
+	if err := in.Validate(); err != nil {
+		return err
+	}
+	return validateChaosPolicy(in, false)
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
@@ -73,6 +90,10 @@ func (in *StressChaos) Validate() error {
 	root := field.NewPath("stresschaos")
 	errs := in.Spec.Validate(root)
 	errs = append(errs, in.ValidatePodMode(root)...)
+	errs = append(errs, ValidateSelector(in.Spec.Selector, root.Child("spec", "selector"))...)
+	errs = append(errs, ValidateFailurePolicy(in.Spec.FailurePolicy, root.Child("spec", "failurePolicy"))...)
+	errs = append(errs, ValidateRetryPolicy(in.Spec.RetryPolicy, root.Child("spec", "retryPolicy"))...)
+	errs = append(errs, ValidateConflictPolicy(in.Spec.ConflictPolicy, root.Child("spec", "conflictPolicy"))...)
 	errs = append(errs, in.ValidateScheduler(root.Child("spec"))...)
 	if len(errs) > 0 {
 		return fmt.Errorf(errs.ToAggregate().Error())
@@ -115,9 +136,30 @@ func (in *Stressors) Validate(parent *field.Path) field.ErrorList {
 		errs = append(errs, in.CPUStressor.Validate(current)...)
 		once = true
 	}
+	if in.IOStressor != nil {
+		errs = append(errs, in.IOStressor.Validate(current)...)
+		once = true
+	}
+	if in.HDDStressor != nil {
+		errs = append(errs, in.HDDStressor.Validate(current)...)
+		once = true
+	}
+	if in.VMStressor != nil {
+		errs = append(errs, in.VMStressor.Validate(current)...)
+		once = true
+	}
+	if in.SockStressor != nil {
+		errs = append(errs, in.SockStressor.Validate(current)...)
+		once = true
+	}
 	if !once {
 		errs = append(errs, field.Invalid(current, in, "missing stressors"))
 	}
+	// both of them are normalized to the vm stressor of stress-ng
+	if in.MemoryStressor != nil && !in.MemoryStressor.IsHoldMode() &&
+		len(in.MemoryStressor.Size) != 0 && in.VMStressor != nil {
+		errs = append(errs, field.Invalid(current, in, "memory stressor with size conflicts with vm stressor"))
+	}
 	return errs
 }
 
@@ -133,8 +175,34 @@ func (in *Stressor) Validate(parent *field.Path) field.ErrorList {
 // Validate validates whether the MemoryStressor is well defined
 func (in *MemoryStressor) Validate(parent *field.Path) field.ErrorList {
 	errs := field.ErrorList{}
-	current := parent.Child("vm")
-	errs = append(errs, in.Stressor.Validate(current)...)
+	current := parent.Child("memory")
+	switch in.Mode {
+	case "", StressngMemoryStressMode:
+		errs = append(errs, in.Stressor.Validate(current)...)
+		if len(in.RampRate) != 0 {
+			errs = append(errs, field.Invalid(current.Child("rampRate"), in.RampRate,
+				"rampRate is only supported in hold mode"))
+		}
+	case HoldMemoryStressMode:
+		if len(in.Size) == 0 {
+			errs = append(errs, field.Invalid(current.Child("size"), in.Size, "size is required in hold mode"))
+		}
+		if len(in.RampRate) != 0 {
+			errs = append(errs, validateBytes(current.Child("rampRate"), in.RampRate, 1, 0)...)
+		}
+	default:
+		errs = append(errs, field.Invalid(current.Child("mode"), in.Mode, "unknown memory stress mode"))
+	}
+	if len(in.Size) != 0 {
+		if strings.HasSuffix(in.Size, "%") {
+			percent, err := parsePercent(in.Size)
+			if err != nil || percent <= 0 || percent > 100 {
+				errs = append(errs, field.Invalid(current.Child("size"), in.Size, "illegal proportion"))
+			}
+		} else {
+			errs = append(errs, validateBytes(current.Child("size"), in.Size, 1, 0)...)
+		}
+	}
 	return errs
 }
 
@@ -148,3 +216,64 @@ func (in *CPUStressor) Validate(parent *field.Path) field.ErrorList {
 	}
 	return errs
 }
+
+// Validate validates whether the IOStressor is well defined
+func (in *IOStressor) Validate(parent *field.Path) field.ErrorList {
+	current := parent.Child("io")
+	return in.Stressor.Validate(current)
+}
+
+// Validate validates whether the HDDStressor is well defined
+func (in *HDDStressor) Validate(parent *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+	current := parent.Child("hdd")
+	errs = append(errs, in.Stressor.Validate(current)...)
+	if len(in.Size) != 0 {
+		errs = append(errs, validateBytes(current.Child("size"), in.Size, 1, 0)...)
+	}
+	if len(in.WriteSize) != 0 {
+		errs = append(errs, validateBytes(current.Child("writeSize"), in.WriteSize, 1, maxHDDWriteSize)...)
+	}
+	return errs
+}
+
+// Validate validates whether the VMStressor is well defined
+func (in *VMStressor) Validate(parent *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+	current := parent.Child("vm")
+	errs = append(errs, in.Stressor.Validate(current)...)
+	if len(in.Size) != 0 {
+		errs = append(errs, validateBytes(current.Child("size"), in.Size, 1, 0)...)
+	}
+	return errs
+}
+
+// Validate validates whether the SockStressor is well defined
+func (in *SockStressor) Validate(parent *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+	current := parent.Child("sock")
+	errs = append(errs, in.Stressor.Validate(current)...)
+	switch in.Domain {
+	case "", "ipv4", "ipv6", "unix":
+	default:
+		errs = append(errs, field.Invalid(current.Child("domain"), in.Domain, "unknown socket domain"))
+	}
+	// each worker listens on a different port starting from the given one
+	if in.Port != nil && (*in.Port < minSockPort || *in.Port+in.Workers-1 > maxSockPort) {
+		errs = append(errs, field.Invalid(current.Child("port"), *in.Port,
+			fmt.Sprintf("ports should be in range [%d, %d]", minSockPort, maxSockPort)))
+	}
+	return errs
+}
+
+// validateBytes validates whether the size is in range [min, max], the max is unlimited if it's 0
+func validateBytes(path *field.Path, size string, min int64, max int64) field.ErrorList {
+	errs := field.ErrorList{}
+	bytes, err := parseBytes(size)
+	if err != nil {
+		errs = append(errs, field.Invalid(path, size, fmt.Sprintf("parse size error:%s", err)))
+	} else if bytes < min || (max > 0 && bytes > max) {
+		errs = append(errs, field.Invalid(path, size, "size out of range"))
+	}
+	return errs
+}
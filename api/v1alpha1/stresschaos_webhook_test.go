@@ -128,7 +128,7 @@ var _ = Describe("stresschaos_webhook", func() {
 					execute: func(chaos *StressChaos) error {
 						return chaos.ValidateCreate()
 					},
-					expect: "error",
+					expect: "",
 				},
 				{
 					name: "missing stressors",
@@ -161,6 +161,8 @@ var _ = Describe("stresschaos_webhook", func() {
 				stressor Validateable
 				errs     int
 			}
+			port := 5000
+			illegalPort := 65535
 			tcs := []TestCase{
 				{
 					name:     "missing workers",
@@ -181,11 +183,123 @@ var _ = Describe("stresschaos_webhook", func() {
 					},
 					errs: 0,
 				},
+				{
+					name: "MemoryStressor with size",
+					stressor: &MemoryStressor{
+						Stressor: Stressor{Workers: 1},
+						Size:     "256Mi",
+					},
+					errs: 0,
+				},
+				{
+					name: "MemoryStressor with percentage",
+					stressor: &MemoryStressor{
+						Stressor: Stressor{Workers: 1},
+						Size:     "90%",
+					},
+					errs: 0,
+				},
+				{
+					name: "MemoryStressor with illegal percentage",
+					stressor: &MemoryStressor{
+						Stressor: Stressor{Workers: 1},
+						Size:     "120%",
+					},
+					errs: 1,
+				},
+				{
+					name: "MemoryStressor in hold mode",
+					stressor: &MemoryStressor{
+						Mode:     HoldMemoryStressMode,
+						Size:     "90%",
+						RampRate: "64Mi",
+					},
+					errs: 0,
+				},
+				{
+					name: "MemoryStressor in hold mode without size",
+					stressor: &MemoryStressor{
+						Mode: HoldMemoryStressMode,
+					},
+					errs: 1,
+				},
+				{
+					name: "MemoryStressor with rampRate in stress-ng mode",
+					stressor: &MemoryStressor{
+						Stressor: Stressor{Workers: 1},
+						RampRate: "64Mi",
+					},
+					errs: 1,
+				},
+				{
+					name: "default IOStressor",
+					stressor: &IOStressor{
+						Stressor: Stressor{Workers: 1},
+					},
+					errs: 0,
+				},
+				{
+					name: "HDDStressor with sizes",
+					stressor: &HDDStressor{
+						Stressor:  Stressor{Workers: 1},
+						Size:      "1Gi",
+						WriteSize: "64Ki",
+					},
+					errs: 0,
+				},
+				{
+					name: "HDDStressor with illegal sizes",
+					stressor: &HDDStressor{
+						Stressor:  Stressor{Workers: 1},
+						Size:      "1Gx",
+						WriteSize: "8Mi",
+					},
+					errs: 2,
+				},
+				{
+					name: "VMStressor with illegal size",
+					stressor: &VMStressor{
+						Stressor: Stressor{Workers: 1},
+						Size:     "-1Mi",
+					},
+					errs: 1,
+				},
+				{
+					name: "SockStressor with domain and port",
+					stressor: &SockStressor{
+						Stressor: Stressor{Workers: 2},
+						Domain:   "ipv4",
+						Port:     &port,
+					},
+					errs: 0,
+				},
+				{
+					name: "SockStressor with illegal domain and port",
+					stressor: &SockStressor{
+						Stressor: Stressor{Workers: 2},
+						Domain:   "ipx",
+						Port:     &illegalPort,
+					},
+					errs: 2,
+				},
 			}
 			parent := field.NewPath("parent")
 			for _, tc := range tcs {
-				Expect(tc.stressor.Validate(parent)).To(HaveLen(tc.errs))
+				Expect(tc.stressor.Validate(parent)).To(HaveLen(tc.errs), tc.name)
+			}
+		})
+
+		It("Validate conflicted Stressors", func() {
+			stressors := &Stressors{
+				MemoryStressor: &MemoryStressor{
+					Stressor: Stressor{Workers: 1},
+					Size:     "256Mi",
+				},
+				VMStressor: &VMStressor{
+					Stressor: Stressor{Workers: 1},
+				},
 			}
+			Expect(stressors.Validate(field.NewPath("parent"))).To(HaveLen(1))
 		})
 
 	})
@@ -37,7 +37,7 @@ type TimeChaos struct {
 type TimeChaosSpec struct {
 	// Mode defines the mode to run chaos action.
 	// Supported mode: one / all / fixed / fixed-percent / random-max-percent
-	// +kubebuilder:validation:Enum=one;all;fixed;fixed-percent;random-max-percent
+	// +kubebuilder:validation:Enum=one;all;fixed;fixed-percent;random-max-percent;one-per-zone;all-in-one-zone;fixed-spread-nodes
 	Mode PodMode `json:"mode"`
 
 	// Value is required when the mode is set to `FixedPodMode` / `FixedPercentPodMod` / `RandomMaxPercentPodMod`.
@@ -47,6 +47,12 @@ type TimeChaosSpec struct {
 	// +optional
 	Value string `json:"value"`
 
+	// Seed is used to select the targets in the modes `one` / `fixed` / `fixed-percent` / `random-max-percent`.
+	// The same seed always selects the same targets from the same candidates.
+	// A random seed will be used if it is not set, and the seed used is recorded in the status.
+	// +optional
+	Seed *int64 `json:"seed,omitempty"`
+
 	// Selector is used to select pods that are used to inject chaos action.
 	Selector SelectorSpec `json:"selector"`
 
@@ -59,6 +65,7 @@ type TimeChaosSpec struct {
 	// "CLOCK_MONOTONIC_RAW","CLOCK_REALTIME_COARSE","CLOCK_MONOTONIC_COARSE","CLOCK_BOOTTIME","CLOCK_REALTIME_ALARM",
 	// "CLOCK_BOOTTIME_ALARM"]
 	// Default value is ["CLOCK_REALTIME"]
+	// `gettimeofday` and `time` are affected only when "CLOCK_REALTIME" is included.
 	ClockIds []string `json:"clockIds,omitempty"`
 
 	// ContainerName indicates the name of affected container.
@@ -71,6 +78,22 @@ type TimeChaosSpec struct {
 
 	// Scheduler defines some schedule rules to control the running time of the chaos experiment about time.
 	Scheduler *SchedulerSpec `json:"scheduler,omitempty"`
+
+	// FailurePolicy defines how to handle the targets failed to be injected, all the injected targets
+	// are rolled back by default.
+	// +optional
+	FailurePolicy *FailurePolicy `json:"failurePolicy,omitempty"`
+
+	// RetryPolicy defines how to retry the chaos failed to be applied.
+	// +optional
+	RetryPolicy *RetryPolicy `json:"retryPolicy,omitempty"`
+
+	// ConflictPolicy defines how to handle the targets leased by other experiments of the same kind,
+	// one of Reject, Queue and Merge, Merge by default. With Merge, the time of the pod is shifted by
+	// the latest experiment leasing it, the offsets of the remaining ones are applied again once an experiment is recovered.
+	// +kubebuilder:validation:Enum=Reject;Queue;Merge
+	// +optional
+	ConflictPolicy ConflictPolicyType `json:"conflictPolicy,omitempty"`
 }
 
 // SetDefaultValue will set default value for empty fields
@@ -117,7 +117,7 @@ var _ = Describe("timechaos_webhook", func() {
 					execute: func(chaos *TimeChaos) error {
 						return chaos.ValidateCreate()
 					},
-					expect: "error",
+					expect: "",
 				},
 				{
 					name: "validate the timeOffset",
@@ -21,6 +21,146 @@ import (
 )
 
 
+const KindDiskChaos = "DiskChaos"
+
+// IsDeleted returns whether this resource has been deleted
+func (in *DiskChaos) IsDeleted() bool {
+	return !in.DeletionTimestamp.IsZero()
+}
+
+// IsPaused returns whether this resource has been paused
+func (in *DiskChaos) IsPaused() bool {
+	if in.Annotations == nil || in.Annotations[PauseAnnotationKey] != "true" {
+		return false
+	}
+	return true
+}
+
+// IsDryRun returns whether this resource is only previewed without being injected
+func (in *DiskChaos) IsDryRun() bool {
+	if in.Annotations == nil || in.Annotations[DryRunAnnotationKey] != "true" {
+		return false
+	}
+	return true
+}
+
+// GetDuration would return the duration for chaos
+func (in *DiskChaos) GetDuration() (*time.Duration, error) {
+	if in.Spec.Duration == nil {
+		return nil, nil
+	}
+	duration, err := time.ParseDuration(*in.Spec.Duration)
+	if err != nil {
+		return nil, err
+	}
+	return &duration, nil
+}
+
+func (in *DiskChaos) GetNextStart() time.Time {
+	if in.Status.Scheduler.NextStart == nil {
+		return time.Time{}
+	}
+	return in.Status.Scheduler.NextStart.Time
+}
+
+func (in *DiskChaos) SetNextStart(t time.Time) {
+	if t.IsZero() {
+		in.Status.Scheduler.NextStart = nil
+		return
+	}
+
+	if in.Status.Scheduler.NextStart == nil {
+		in.Status.Scheduler.NextStart = &metav1.Time{}
+	}
+	in.Status.Scheduler.NextStart.Time = t
+}
+
+func (in *DiskChaos) GetNextRecover() time.Time {
+	if in.Status.Scheduler.NextRecover == nil {
+		return time.Time{}
+	}
+	return in.Status.Scheduler.NextRecover.Time
+}
+
+func (in *DiskChaos) SetNextRecover(t time.Time) {
+	if t.IsZero() {
+		in.Status.Scheduler.NextRecover = nil
+		return
+	}
+
+	if in.Status.Scheduler.NextRecover == nil {
+		in.Status.Scheduler.NextRecover = &metav1.Time{}
+	}
+	in.Status.Scheduler.NextRecover.Time = t
+}
+
+// GetScheduler would return the scheduler for chaos
+func (in *DiskChaos) GetScheduler() *SchedulerSpec {
+	return in.Spec.Scheduler
+}
+
+// GetFailurePolicy returns the way to handle the targets failed to be injected
+func (in *DiskChaos) GetFailurePolicy() *FailurePolicy {
+	return in.Spec.FailurePolicy
+}
+
+// GetRetryPolicy returns the way to retry the chaos failed to be applied
+func (in *DiskChaos) GetRetryPolicy() *RetryPolicy {
+	return in.Spec.RetryPolicy
+}
+
+// GetChaos would return the a record for chaos
+func (in *DiskChaos) GetChaos() *ChaosInstance {
+	instance := &ChaosInstance{
+		Name:      in.Name,
+		Namespace: in.Namespace,
+		Kind:      KindDiskChaos,
+		StartTime: in.CreationTimestamp.Time,
+		Action:    "",
+		UID:       string(in.UID),
+	}
+
+	action := reflect.ValueOf(in).Elem().FieldByName("Spec").FieldByName("Action")
+	if action.IsValid() {
+		instance.Action = action.String()
+	}
+	if in.Spec.Duration != nil {
+		instance.Duration = *in.Spec.Duration
+	}
+	if in.DeletionTimestamp != nil {
+		instance.EndTime = in.DeletionTimestamp.Time
+	}
+	return instance
+}
+
+// GetStatus returns the status
+func (in *DiskChaos) GetStatus() *ChaosStatus {
+	return &in.Status.ChaosStatus
+}
+
+// GetSelectSpec returns the spec used to select the targets
+func (in *DiskChaos) GetSelectSpec() SelectSpec {
+	return &in.Spec
+}
+
+// +kubebuilder:object:root=true
+
+// DiskChaosList contains a list of DiskChaos
+type DiskChaosList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DiskChaos `json:"items"`
+}
+
+// ListChaos returns a list of chaos
+func (in *DiskChaosList) ListChaos() []*ChaosInstance {
+	res := make([]*ChaosInstance, 0, len(in.Items))
+	for _, item := range in.Items {
+		res = append(res, item.GetChaos())
+	}
+	return res
+}
+
 const KindDNSChaos = "DNSChaos"
 
 // IsDeleted returns whether this resource has been deleted
@@ -36,6 +176,14 @@ func (in *DNSChaos) IsPaused() bool {
 	return true
 }
 
+// IsDryRun returns whether this resource is only previewed without being injected
+func (in *DNSChaos) IsDryRun() bool {
+	if in.Annotations == nil || in.Annotations[DryRunAnnotationKey] != "true" {
+		return false
+	}
+	return true
+}
+
 // GetDuration would return the duration for chaos
 func (in *DNSChaos) GetDuration() (*time.Duration, error) {
 	if in.Spec.Duration == nil {
@@ -91,6 +239,16 @@ func (in *DNSChaos) GetScheduler() *SchedulerSpec {
 	return in.Spec.Scheduler
 }
 
+// GetFailurePolicy returns the way to handle the targets failed to be injected
+func (in *DNSChaos) GetFailurePolicy() *FailurePolicy {
+	return in.Spec.FailurePolicy
+}
+
+// GetRetryPolicy returns the way to retry the chaos failed to be applied
+func (in *DNSChaos) GetRetryPolicy() *RetryPolicy {
+	return in.Spec.RetryPolicy
+}
+
 // GetChaos would return the a record for chaos
 func (in *DNSChaos) GetChaos() *ChaosInstance {
 	instance := &ChaosInstance{
@@ -120,6 +278,11 @@ func (in *DNSChaos) GetStatus() *ChaosStatus {
 	return &in.Status.ChaosStatus
 }
 
+// GetSelectSpec returns the spec used to select the targets
+func (in *DNSChaos) GetSelectSpec() SelectSpec {
+	return &in.Spec
+}
+
 // +kubebuilder:object:root=true
 
 // DNSChaosList contains a list of DNSChaos
@@ -153,6 +316,14 @@ func (in *HTTPChaos) IsPaused() bool {
 	return true
 }
 
+// IsDryRun returns whether this resource is only previewed without being injected
+func (in *HTTPChaos) IsDryRun() bool {
+	if in.Annotations == nil || in.Annotations[DryRunAnnotationKey] != "true" {
+		return false
+	}
+	return true
+}
+
 // GetDuration would return the duration for chaos
 func (in *HTTPChaos) GetDuration() (*time.Duration, error) {
 	if in.Spec.Duration == nil {
@@ -208,6 +379,16 @@ func (in *HTTPChaos) GetScheduler() *SchedulerSpec {
 	return in.Spec.Scheduler
 }
 
+// GetFailurePolicy returns the way to handle the targets failed to be injected
+func (in *HTTPChaos) GetFailurePolicy() *FailurePolicy {
+	return in.Spec.FailurePolicy
+}
+
+// GetRetryPolicy returns the way to retry the chaos failed to be applied
+func (in *HTTPChaos) GetRetryPolicy() *RetryPolicy {
+	return in.Spec.RetryPolicy
+}
+
 // GetChaos would return the a record for chaos
 func (in *HTTPChaos) GetChaos() *ChaosInstance {
 	instance := &ChaosInstance{
@@ -237,6 +418,11 @@ func (in *HTTPChaos) GetStatus() *ChaosStatus {
 	return &in.Status.ChaosStatus
 }
 
+// GetSelectSpec returns the spec used to select the targets
+func (in *HTTPChaos) GetSelectSpec() SelectSpec {
+	return &in.Spec
+}
+
 // +kubebuilder:object:root=true
 
 // HTTPChaosList contains a list of HTTPChaos
@@ -270,6 +456,14 @@ func (in *IoChaos) IsPaused() bool {
 	return true
 }
 
+// IsDryRun returns whether this resource is only previewed without being injected
+func (in *IoChaos) IsDryRun() bool {
+	if in.Annotations == nil || in.Annotations[DryRunAnnotationKey] != "true" {
+		return false
+	}
+	return true
+}
+
 // GetDuration would return the duration for chaos
 func (in *IoChaos) GetDuration() (*time.Duration, error) {
 	if in.Spec.Duration == nil {
@@ -325,6 +519,16 @@ func (in *IoChaos) GetScheduler() *SchedulerSpec {
 	return in.Spec.Scheduler
 }
 
+// GetFailurePolicy returns the way to handle the targets failed to be injected
+func (in *IoChaos) GetFailurePolicy() *FailurePolicy {
+	return in.Spec.FailurePolicy
+}
+
+// GetRetryPolicy returns the way to retry the chaos failed to be applied
+func (in *IoChaos) GetRetryPolicy() *RetryPolicy {
+	return in.Spec.RetryPolicy
+}
+
 // GetChaos would return the a record for chaos
 func (in *IoChaos) GetChaos() *ChaosInstance {
 	instance := &ChaosInstance{
@@ -354,6 +558,11 @@ func (in *IoChaos) GetStatus() *ChaosStatus {
 	return &in.Status.ChaosStatus
 }
 
+// GetSelectSpec returns the spec used to select the targets
+func (in *IoChaos) GetSelectSpec() SelectSpec {
+	return &in.Spec
+}
+
 // +kubebuilder:object:root=true
 
 // IoChaosList contains a list of IoChaos
@@ -387,6 +596,14 @@ func (in *KernelChaos) IsPaused() bool {
 	return true
 }
 
+// IsDryRun returns whether this resource is only previewed without being injected
+func (in *KernelChaos) IsDryRun() bool {
+	if in.Annotations == nil || in.Annotations[DryRunAnnotationKey] != "true" {
+		return false
+	}
+	return true
+}
+
 // GetDuration would return the duration for chaos
 func (in *KernelChaos) GetDuration() (*time.Duration, error) {
 	if in.Spec.Duration == nil {
@@ -442,6 +659,16 @@ func (in *KernelChaos) GetScheduler() *SchedulerSpec {
 	return in.Spec.Scheduler
 }
 
+// GetFailurePolicy returns the way to handle the targets failed to be injected
+func (in *KernelChaos) GetFailurePolicy() *FailurePolicy {
+	return in.Spec.FailurePolicy
+}
+
+// GetRetryPolicy returns the way to retry the chaos failed to be applied
+func (in *KernelChaos) GetRetryPolicy() *RetryPolicy {
+	return in.Spec.RetryPolicy
+}
+
 // GetChaos would return the a record for chaos
 func (in *KernelChaos) GetChaos() *ChaosInstance {
 	instance := &ChaosInstance{
@@ -471,6 +698,11 @@ func (in *KernelChaos) GetStatus() *ChaosStatus {
 	return &in.Status.ChaosStatus
 }
 
+// GetSelectSpec returns the spec used to select the targets
+func (in *KernelChaos) GetSelectSpec() SelectSpec {
+	return &in.Spec
+}
+
 // +kubebuilder:object:root=true
 
 // KernelChaosList contains a list of KernelChaos
@@ -504,6 +736,14 @@ func (in *NetworkChaos) IsPaused() bool {
 	return true
 }
 
+// IsDryRun returns whether this resource is only previewed without being injected
+func (in *NetworkChaos) IsDryRun() bool {
+	if in.Annotations == nil || in.Annotations[DryRunAnnotationKey] != "true" {
+		return false
+	}
+	return true
+}
+
 // GetDuration would return the duration for chaos
 func (in *NetworkChaos) GetDuration() (*time.Duration, error) {
 	if in.Spec.Duration == nil {
@@ -559,6 +799,16 @@ func (in *NetworkChaos) GetScheduler() *SchedulerSpec {
 	return in.Spec.Scheduler
 }
 
+// GetFailurePolicy returns the way to handle the targets failed to be injected
+func (in *NetworkChaos) GetFailurePolicy() *FailurePolicy {
+	return in.Spec.FailurePolicy
+}
+
+// GetRetryPolicy returns the way to retry the chaos failed to be applied
+func (in *NetworkChaos) GetRetryPolicy() *RetryPolicy {
+	return in.Spec.RetryPolicy
+}
+
 // GetChaos would return the a record for chaos
 func (in *NetworkChaos) GetChaos() *ChaosInstance {
 	instance := &ChaosInstance{
@@ -588,6 +838,11 @@ func (in *NetworkChaos) GetStatus() *ChaosStatus {
 	return &in.Status.ChaosStatus
 }
 
+// GetSelectSpec returns the spec used to select the targets
+func (in *NetworkChaos) GetSelectSpec() SelectSpec {
+	return &in.Spec
+}
+
 // +kubebuilder:object:root=true
 
 // NetworkChaosList contains a list of NetworkChaos
@@ -606,6 +861,146 @@ func (in *NetworkChaosList) ListChaos() []*ChaosInstance {
 	return res
 }
 
+const KindNodeChaos = "NodeChaos"
+
+// IsDeleted returns whether this resource has been deleted
+func (in *NodeChaos) IsDeleted() bool {
+	return !in.DeletionTimestamp.IsZero()
+}
+
+// IsPaused returns whether this resource has been paused
+func (in *NodeChaos) IsPaused() bool {
+	if in.Annotations == nil || in.Annotations[PauseAnnotationKey] != "true" {
+		return false
+	}
+	return true
+}
+
+// IsDryRun returns whether this resource is only previewed without being injected
+func (in *NodeChaos) IsDryRun() bool {
+	if in.Annotations == nil || in.Annotations[DryRunAnnotationKey] != "true" {
+		return false
+	}
+	return true
+}
+
+// GetDuration would return the duration for chaos
+func (in *NodeChaos) GetDuration() (*time.Duration, error) {
+	if in.Spec.Duration == nil {
+		return nil, nil
+	}
+	duration, err := time.ParseDuration(*in.Spec.Duration)
+	if err != nil {
+		return nil, err
+	}
+	return &duration, nil
+}
+
+func (in *NodeChaos) GetNextStart() time.Time {
+	if in.Status.Scheduler.NextStart == nil {
+		return time.Time{}
+	}
+	return in.Status.Scheduler.NextStart.Time
+}
+
+func (in *NodeChaos) SetNextStart(t time.Time) {
+	if t.IsZero() {
+		in.Status.Scheduler.NextStart = nil
+		return
+	}
+
+	if in.Status.Scheduler.NextStart == nil {
+		in.Status.Scheduler.NextStart = &metav1.Time{}
+	}
+	in.Status.Scheduler.NextStart.Time = t
+}
+
+func (in *NodeChaos) GetNextRecover() time.Time {
+	if in.Status.Scheduler.NextRecover == nil {
+		return time.Time{}
+	}
+	return in.Status.Scheduler.NextRecover.Time
+}
+
+func (in *NodeChaos) SetNextRecover(t time.Time) {
+	if t.IsZero() {
+		in.Status.Scheduler.NextRecover = nil
+		return
+	}
+
+	if in.Status.Scheduler.NextRecover == nil {
+		in.Status.Scheduler.NextRecover = &metav1.Time{}
+	}
+	in.Status.Scheduler.NextRecover.Time = t
+}
+
+// GetScheduler would return the scheduler for chaos
+func (in *NodeChaos) GetScheduler() *SchedulerSpec {
+	return in.Spec.Scheduler
+}
+
+// GetFailurePolicy returns the way to handle the targets failed to be injected
+func (in *NodeChaos) GetFailurePolicy() *FailurePolicy {
+	return in.Spec.FailurePolicy
+}
+
+// GetRetryPolicy returns the way to retry the chaos failed to be applied
+func (in *NodeChaos) GetRetryPolicy() *RetryPolicy {
+	return in.Spec.RetryPolicy
+}
+
+// GetChaos would return the a record for chaos
+func (in *NodeChaos) GetChaos() *ChaosInstance {
+	instance := &ChaosInstance{
+		Name:      in.Name,
+		Namespace: in.Namespace,
+		Kind:      KindNodeChaos,
+		StartTime: in.CreationTimestamp.Time,
+		Action:    "",
+		UID:       string(in.UID),
+	}
+
+	action := reflect.ValueOf(in).Elem().FieldByName("Spec").FieldByName("Action")
+	if action.IsValid() {
+		instance.Action = action.String()
+	}
+	if in.Spec.Duration != nil {
+		instance.Duration = *in.Spec.Duration
+	}
+	if in.DeletionTimestamp != nil {
+		instance.EndTime = in.DeletionTimestamp.Time
+	}
+	return instance
+}
+
+// GetStatus returns the status
+func (in *NodeChaos) GetStatus() *ChaosStatus {
+	return &in.Status.ChaosStatus
+}
+
+// GetSelectSpec returns the spec used to select the targets
+func (in *NodeChaos) GetSelectSpec() SelectSpec {
+	return &in.Spec
+}
+
+// +kubebuilder:object:root=true
+
+// NodeChaosList contains a list of NodeChaos
+type NodeChaosList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NodeChaos `json:"items"`
+}
+
+// ListChaos returns a list of chaos
+func (in *NodeChaosList) ListChaos() []*ChaosInstance {
+	res := make([]*ChaosInstance, 0, len(in.Items))
+	for _, item := range in.Items {
+		res = append(res, item.GetChaos())
+	}
+	return res
+}
+
 const KindPersistentVolumeChaos = "PersistentVolumeChaos"
 
 // IsDeleted returns whether this resource has been deleted
@@ -621,6 +1016,14 @@ func (in *PersistentVolumeChaos) IsPaused() bool {
 	return true
 }
 
+// IsDryRun returns whether this resource is only previewed without being injected
+func (in *PersistentVolumeChaos) IsDryRun() bool {
+	if in.Annotations == nil || in.Annotations[DryRunAnnotationKey] != "true" {
+		return false
+	}
+	return true
+}
+
 // GetDuration would return the duration for chaos
 func (in *PersistentVolumeChaos) GetDuration() (*time.Duration, error) {
 	if in.Spec.Duration == nil {
@@ -676,6 +1079,16 @@ func (in *PersistentVolumeChaos) GetScheduler() *SchedulerSpec {
 	return in.Spec.Scheduler
 }
 
+// GetFailurePolicy returns the way to handle the targets failed to be injected
+func (in *PersistentVolumeChaos) GetFailurePolicy() *FailurePolicy {
+	return in.Spec.FailurePolicy
+}
+
+// GetRetryPolicy returns the way to retry the chaos failed to be applied
+func (in *PersistentVolumeChaos) GetRetryPolicy() *RetryPolicy {
+	return in.Spec.RetryPolicy
+}
+
 // GetChaos would return the a record for chaos
 func (in *PersistentVolumeChaos) GetChaos() *ChaosInstance {
 	instance := &ChaosInstance{
@@ -705,6 +1118,11 @@ func (in *PersistentVolumeChaos) GetStatus() *ChaosStatus {
 	return &in.Status.ChaosStatus
 }
 
+// GetSelectSpec returns the spec used to select the targets
+func (in *PersistentVolumeChaos) GetSelectSpec() SelectSpec {
+	return &in.Spec
+}
+
 // +kubebuilder:object:root=true
 
 // PersistentVolumeChaosList contains a list of PersistentVolumeChaos
@@ -738,6 +1156,14 @@ func (in *PersistentVolumeClaimChaos) IsPaused() bool {
 	return true
 }
 
+// IsDryRun returns whether this resource is only previewed without being injected
+func (in *PersistentVolumeClaimChaos) IsDryRun() bool {
+	if in.Annotations == nil || in.Annotations[DryRunAnnotationKey] != "true" {
+		return false
+	}
+	return true
+}
+
 // GetDuration would return the duration for chaos
 func (in *PersistentVolumeClaimChaos) GetDuration() (*time.Duration, error) {
 	if in.Spec.Duration == nil {
@@ -793,6 +1219,16 @@ func (in *PersistentVolumeClaimChaos) GetScheduler() *SchedulerSpec {
 	return in.Spec.Scheduler
 }
 
+// GetFailurePolicy returns the way to handle the targets failed to be injected
+func (in *PersistentVolumeClaimChaos) GetFailurePolicy() *FailurePolicy {
+	return in.Spec.FailurePolicy
+}
+
+// GetRetryPolicy returns the way to retry the chaos failed to be applied
+func (in *PersistentVolumeClaimChaos) GetRetryPolicy() *RetryPolicy {
+	return in.Spec.RetryPolicy
+}
+
 // GetChaos would return the a record for chaos
 func (in *PersistentVolumeClaimChaos) GetChaos() *ChaosInstance {
 	instance := &ChaosInstance{
@@ -822,6 +1258,11 @@ func (in *PersistentVolumeClaimChaos) GetStatus() *ChaosStatus {
 	return &in.Status.ChaosStatus
 }
 
+// GetSelectSpec returns the spec used to select the targets
+func (in *PersistentVolumeClaimChaos) GetSelectSpec() SelectSpec {
+	return &in.Spec
+}
+
 // +kubebuilder:object:root=true
 
 // PersistentVolumeClaimChaosList contains a list of PersistentVolumeClaimChaos
@@ -855,6 +1296,14 @@ func (in *PodChaos) IsPaused() bool {
 	return true
 }
 
+// IsDryRun returns whether this resource is only previewed without being injected
+func (in *PodChaos) IsDryRun() bool {
+	if in.Annotations == nil || in.Annotations[DryRunAnnotationKey] != "true" {
+		return false
+	}
+	return true
+}
+
 // GetDuration would return the duration for chaos
 func (in *PodChaos) GetDuration() (*time.Duration, error) {
 	if in.Spec.Duration == nil {
@@ -910,6 +1359,16 @@ func (in *PodChaos) GetScheduler() *SchedulerSpec {
 	return in.Spec.Scheduler
 }
 
+// GetFailurePolicy returns the way to handle the targets failed to be injected
+func (in *PodChaos) GetFailurePolicy() *FailurePolicy {
+	return in.Spec.FailurePolicy
+}
+
+// GetRetryPolicy returns the way to retry the chaos failed to be applied
+func (in *PodChaos) GetRetryPolicy() *RetryPolicy {
+	return in.Spec.RetryPolicy
+}
+
 // GetChaos would return the a record for chaos
 func (in *PodChaos) GetChaos() *ChaosInstance {
 	instance := &ChaosInstance{
@@ -939,6 +1398,11 @@ func (in *PodChaos) GetStatus() *ChaosStatus {
 	return &in.Status.ChaosStatus
 }
 
+// GetSelectSpec returns the spec used to select the targets
+func (in *PodChaos) GetSelectSpec() SelectSpec {
+	return &in.Spec
+}
+
 // +kubebuilder:object:root=true
 
 // PodChaosList contains a list of PodChaos
@@ -972,6 +1436,14 @@ func (in *StressChaos) IsPaused() bool {
 	return true
 }
 
+// IsDryRun returns whether this resource is only previewed without being injected
+func (in *StressChaos) IsDryRun() bool {
+	if in.Annotations == nil || in.Annotations[DryRunAnnotationKey] != "true" {
+		return false
+	}
+	return true
+}
+
 // GetDuration would return the duration for chaos
 func (in *StressChaos) GetDuration() (*time.Duration, error) {
 	if in.Spec.Duration == nil {
@@ -1027,6 +1499,16 @@ func (in *StressChaos) GetScheduler() *SchedulerSpec {
 	return in.Spec.Scheduler
 }
 
+// GetFailurePolicy returns the way to handle the targets failed to be injected
+func (in *StressChaos) GetFailurePolicy() *FailurePolicy {
+	return in.Spec.FailurePolicy
+}
+
+// GetRetryPolicy returns the way to retry the chaos failed to be applied
+func (in *StressChaos) GetRetryPolicy() *RetryPolicy {
+	return in.Spec.RetryPolicy
+}
+
 // GetChaos would return the a record for chaos
 func (in *StressChaos) GetChaos() *ChaosInstance {
 	instance := &ChaosInstance{
@@ -1056,6 +1538,11 @@ func (in *StressChaos) GetStatus() *ChaosStatus {
 	return &in.Status.ChaosStatus
 }
 
+// GetSelectSpec returns the spec used to select the targets
+func (in *StressChaos) GetSelectSpec() SelectSpec {
+	return &in.Spec
+}
+
 // +kubebuilder:object:root=true
 
 // StressChaosList contains a list of StressChaos
@@ -1089,6 +1576,14 @@ func (in *TimeChaos) IsPaused() bool {
 	return true
 }
 
+// IsDryRun returns whether this resource is only previewed without being injected
+func (in *TimeChaos) IsDryRun() bool {
+	if in.Annotations == nil || in.Annotations[DryRunAnnotationKey] != "true" {
+		return false
+	}
+	return true
+}
+
 // GetDuration would return the duration for chaos
 func (in *TimeChaos) GetDuration() (*time.Duration, error) {
 	if in.Spec.Duration == nil {
@@ -1144,6 +1639,16 @@ func (in *TimeChaos) GetScheduler() *SchedulerSpec {
 	return in.Spec.Scheduler
 }
 
+// GetFailurePolicy returns the way to handle the targets failed to be injected
+func (in *TimeChaos) GetFailurePolicy() *FailurePolicy {
+	return in.Spec.FailurePolicy
+}
+
+// GetRetryPolicy returns the way to retry the chaos failed to be applied
+func (in *TimeChaos) GetRetryPolicy() *RetryPolicy {
+	return in.Spec.RetryPolicy
+}
+
 // GetChaos would return the a record for chaos
 func (in *TimeChaos) GetChaos() *ChaosInstance {
 	instance := &ChaosInstance{
@@ -1173,6 +1678,11 @@ func (in *TimeChaos) GetStatus() *ChaosStatus {
 	return &in.Status.ChaosStatus
 }
 
+// GetSelectSpec returns the spec used to select the targets
+func (in *TimeChaos) GetSelectSpec() SelectSpec {
+	return &in.Spec
+}
+
 // +kubebuilder:object:root=true
 
 // TimeChaosList contains a list of TimeChaos
@@ -1193,6 +1703,12 @@ func (in *TimeChaosList) ListChaos() []*ChaosInstance {
 
 func init() {
 
+	SchemeBuilder.Register(&DiskChaos{}, &DiskChaosList{})
+	all.register(KindDiskChaos, &ChaosKind{
+		Chaos:     &DiskChaos{},
+		ChaosList: &DiskChaosList{},
+	})
+
 	SchemeBuilder.Register(&DNSChaos{}, &DNSChaosList{})
 	all.register(KindDNSChaos, &ChaosKind{
 		Chaos:     &DNSChaos{},
@@ -1223,6 +1739,12 @@ func init() {
 		ChaosList: &NetworkChaosList{},
 	})
 
+	SchemeBuilder.Register(&NodeChaos{}, &NodeChaosList{})
+	all.register(KindNodeChaos, &ChaosKind{
+		Chaos:     &NodeChaos{},
+		ChaosList: &NodeChaosList{},
+	})
+
 	SchemeBuilder.Register(&PersistentVolumeChaos{}, &PersistentVolumeChaosList{})
 	all.register(KindPersistentVolumeChaos, &ChaosKind{
 		Chaos:     &PersistentVolumeChaos{},
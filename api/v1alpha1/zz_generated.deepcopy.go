@@ -121,6 +121,21 @@ func (in *BandwidthSpec) DeepCopy() *BandwidthSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlackoutPeriod) DeepCopyInto(out *BlackoutPeriod) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlackoutPeriod.
+func (in *BlackoutPeriod) DeepCopy() *BlackoutPeriod {
+	if in == nil {
+		return nil
+	}
+	out := new(BlackoutPeriod)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CPUStressor) DeepCopyInto(out *CPUStressor) {
 	*out = *in
@@ -147,11 +162,208 @@ func (in *CPUStressor) DeepCopy() *CPUStressor {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChaosCondition) DeepCopyInto(out *ChaosCondition) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosCondition.
+func (in *ChaosCondition) DeepCopy() *ChaosCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(ChaosCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChaosKillSwitch) DeepCopyInto(out *ChaosKillSwitch) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosKillSwitch.
+func (in *ChaosKillSwitch) DeepCopy() *ChaosKillSwitch {
+	if in == nil {
+		return nil
+	}
+	out := new(ChaosKillSwitch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChaosKillSwitch) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChaosKillSwitchList) DeepCopyInto(out *ChaosKillSwitchList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ChaosKillSwitch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosKillSwitchList.
+func (in *ChaosKillSwitchList) DeepCopy() *ChaosKillSwitchList {
+	if in == nil {
+		return nil
+	}
+	out := new(ChaosKillSwitchList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChaosKillSwitchList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChaosKillSwitchSpec) DeepCopyInto(out *ChaosKillSwitchSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosKillSwitchSpec.
+func (in *ChaosKillSwitchSpec) DeepCopy() *ChaosKillSwitchSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ChaosKillSwitchSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChaosPolicy) DeepCopyInto(out *ChaosPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosPolicy.
+func (in *ChaosPolicy) DeepCopy() *ChaosPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ChaosPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChaosPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChaosPolicyList) DeepCopyInto(out *ChaosPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ChaosPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosPolicyList.
+func (in *ChaosPolicyList) DeepCopy() *ChaosPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ChaosPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChaosPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChaosPolicySpec) DeepCopyInto(out *ChaosPolicySpec) {
+	*out = *in
+	if in.MaxPods != nil {
+		in, out := &in.MaxPods, &out.MaxPods
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxPercent != nil {
+		in, out := &in.MaxPercent, &out.MaxPercent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxConcurrentExperiments != nil {
+		in, out := &in.MaxConcurrentExperiments, &out.MaxConcurrentExperiments
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]NamespacePolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosPolicySpec.
+func (in *ChaosPolicySpec) DeepCopy() *ChaosPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ChaosPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChaosStatus) DeepCopyInto(out *ChaosStatus) {
 	*out = *in
 	in.Scheduler.DeepCopyInto(&out.Scheduler)
 	in.Experiment.DeepCopyInto(&out.Experiment)
+	in.Retry.DeepCopyInto(&out.Retry)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]ChaosCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = new(DryRunStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosStatus.
@@ -164,6 +376,87 @@ func (in *ChaosStatus) DeepCopy() *ChaosStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChaosTemplate) DeepCopyInto(out *ChaosTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosTemplate.
+func (in *ChaosTemplate) DeepCopy() *ChaosTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ChaosTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChaosTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChaosTemplateList) DeepCopyInto(out *ChaosTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ChaosTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosTemplateList.
+func (in *ChaosTemplateList) DeepCopy() *ChaosTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(ChaosTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChaosTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChaosTemplateSpec) DeepCopyInto(out *ChaosTemplateSpec) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]TemplateParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.Template.DeepCopyInto(&out.Template)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosTemplateSpec.
+func (in *ChaosTemplateSpec) DeepCopy() *ChaosTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ChaosTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CorruptSpec) DeepCopyInto(out *CorruptSpec) {
 	*out = *in
@@ -241,6 +534,11 @@ func (in *DNSChaosList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DNSChaosSpec) DeepCopyInto(out *DNSChaosSpec) {
 	*out = *in
+	if in.Seed != nil {
+		in, out := &in.Seed, &out.Seed
+		*out = new(int64)
+		**out = **in
+	}
 	in.Selector.DeepCopyInto(&out.Selector)
 	if in.Duration != nil {
 		in, out := &in.Duration, &out.Duration
@@ -250,52 +548,226 @@ func (in *DNSChaosSpec) DeepCopyInto(out *DNSChaosSpec) {
 	if in.Scheduler != nil {
 		in, out := &in.Scheduler, &out.Scheduler
 		*out = new(SchedulerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FailurePolicy != nil {
+		in, out := &in.FailurePolicy, &out.FailurePolicy
+		*out = new(FailurePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(RetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSChaosSpec.
+func (in *DNSChaosSpec) DeepCopy() *DNSChaosSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSChaosSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSChaosStatus) DeepCopyInto(out *DNSChaosStatus) {
+	*out = *in
+	in.ChaosStatus.DeepCopyInto(&out.ChaosStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSChaosStatus.
+func (in *DNSChaosStatus) DeepCopy() *DNSChaosStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSChaosStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DelaySpec) DeepCopyInto(out *DelaySpec) {
+	*out = *in
+	if in.Reorder != nil {
+		in, out := &in.Reorder, &out.Reorder
+		*out = new(ReorderSpec)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSChaosSpec.
-func (in *DNSChaosSpec) DeepCopy() *DNSChaosSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DelaySpec.
+func (in *DelaySpec) DeepCopy() *DelaySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DelaySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskChaos) DeepCopyInto(out *DiskChaos) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskChaos.
+func (in *DiskChaos) DeepCopy() *DiskChaos {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskChaos)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DiskChaos) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskChaosList) DeepCopyInto(out *DiskChaosList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DiskChaos, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskChaosList.
+func (in *DiskChaosList) DeepCopy() *DiskChaosList {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskChaosList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DiskChaosList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskChaosSpec) DeepCopyInto(out *DiskChaosSpec) {
+	*out = *in
+	if in.Seed != nil {
+		in, out := &in.Seed, &out.Seed
+		*out = new(int64)
+		**out = **in
+	}
+	in.Selector.DeepCopyInto(&out.Selector)
+	if in.ContainerName != nil {
+		in, out := &in.ContainerName, &out.ContainerName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(string)
+		**out = **in
+	}
+	if in.Scheduler != nil {
+		in, out := &in.Scheduler, &out.Scheduler
+		*out = new(SchedulerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FailurePolicy != nil {
+		in, out := &in.FailurePolicy, &out.FailurePolicy
+		*out = new(FailurePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(RetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskChaosSpec.
+func (in *DiskChaosSpec) DeepCopy() *DiskChaosSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(DNSChaosSpec)
+	out := new(DiskChaosSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DNSChaosStatus) DeepCopyInto(out *DNSChaosStatus) {
+func (in *DiskChaosStatus) DeepCopyInto(out *DiskChaosStatus) {
 	*out = *in
 	in.ChaosStatus.DeepCopyInto(&out.ChaosStatus)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSChaosStatus.
-func (in *DNSChaosStatus) DeepCopy() *DNSChaosStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskChaosStatus.
+func (in *DiskChaosStatus) DeepCopy() *DiskChaosStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(DNSChaosStatus)
+	out := new(DiskChaosStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DelaySpec) DeepCopyInto(out *DelaySpec) {
+func (in *DryRunStatus) DeepCopyInto(out *DryRunStatus) {
 	*out = *in
-	if in.Reorder != nil {
-		in, out := &in.Reorder, &out.Reorder
-		*out = new(ReorderSpec)
-		**out = **in
+	in.Time.DeepCopyInto(&out.Time)
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]DryRunTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DelaySpec.
-func (in *DelaySpec) DeepCopy() *DelaySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DryRunStatus.
+func (in *DryRunStatus) DeepCopy() *DryRunStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(DelaySpec)
+	out := new(DryRunStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DryRunTarget) DeepCopyInto(out *DryRunTarget) {
+	*out = *in
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(PodNetworkChaosSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DryRunTarget.
+func (in *DryRunTarget) DeepCopy() *DryRunTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(DryRunTarget)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -315,6 +787,21 @@ func (in *DuplicateSpec) DeepCopy() *DuplicateSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DurationRange) DeepCopyInto(out *DurationRange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DurationRange.
+func (in *DurationRange) DeepCopy() *DurationRange {
+	if in == nil {
+		return nil
+	}
+	out := new(DurationRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExperimentStatus) DeepCopyInto(out *ExperimentStatus) {
 	*out = *in
@@ -329,7 +816,14 @@ func (in *ExperimentStatus) DeepCopyInto(out *ExperimentStatus) {
 	if in.PodRecords != nil {
 		in, out := &in.PodRecords, &out.PodRecords
 		*out = make([]PodStatus, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Seed != nil {
+		in, out := &in.Seed, &out.Seed
+		*out = new(int64)
+		**out = **in
 	}
 }
 
@@ -368,6 +862,26 @@ func (in *FailKernRequest) DeepCopy() *FailKernRequest {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailurePolicy) DeepCopyInto(out *FailurePolicy) {
+	*out = *in
+	if in.MaxRetries != nil {
+		in, out := &in.MaxRetries, &out.MaxRetries
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailurePolicy.
+func (in *FailurePolicy) DeepCopy() *FailurePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(FailurePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Filter) DeepCopyInto(out *Filter) {
 	*out = *in
@@ -403,6 +917,27 @@ func (in *Frame) DeepCopy() *Frame {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HDDStressor) DeepCopyInto(out *HDDStressor) {
+	*out = *in
+	out.Stressor = in.Stressor
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HDDStressor.
+func (in *HDDStressor) DeepCopy() *HDDStressor {
+	if in == nil {
+		return nil
+	}
+	out := new(HDDStressor)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPChaos) DeepCopyInto(out *HTTPChaos) {
 	*out = *in
@@ -469,6 +1004,21 @@ func (in *HTTPChaosSpec) DeepCopyInto(out *HTTPChaosSpec) {
 	if in.Scheduler != nil {
 		in, out := &in.Scheduler, &out.Scheduler
 		*out = new(SchedulerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FailurePolicy != nil {
+		in, out := &in.FailurePolicy, &out.FailurePolicy
+		*out = new(FailurePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(RetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Seed != nil {
+		in, out := &in.Seed, &out.Seed
+		*out = new(int64)
 		**out = **in
 	}
 	if in.Duration != nil {
@@ -511,6 +1061,27 @@ func (in *HTTPChaosStatus) DeepCopy() *HTTPChaosStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IOStressor) DeepCopyInto(out *IOStressor) {
+	*out = *in
+	out.Stressor = in.Stressor
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IOStressor.
+func (in *IOStressor) DeepCopy() *IOStressor {
+	if in == nil {
+		return nil
+	}
+	out := new(IOStressor)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IoChaos) DeepCopyInto(out *IoChaos) {
 	*out = *in
@@ -600,6 +1171,11 @@ func (in *IoChaosList) DeepCopyObject() runtime.Object {
 func (in *IoChaosSpec) DeepCopyInto(out *IoChaosSpec) {
 	*out = *in
 	in.Selector.DeepCopyInto(&out.Selector)
+	if in.Seed != nil {
+		in, out := &in.Seed, &out.Seed
+		*out = new(int64)
+		**out = **in
+	}
 	if in.Attr != nil {
 		in, out := &in.Attr, &out.Attr
 		*out = new(AttrOverrideSpec)
@@ -613,7 +1189,17 @@ func (in *IoChaosSpec) DeepCopyInto(out *IoChaosSpec) {
 	if in.Scheduler != nil {
 		in, out := &in.Scheduler, &out.Scheduler
 		*out = new(SchedulerSpec)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FailurePolicy != nil {
+		in, out := &in.FailurePolicy, &out.FailurePolicy
+		*out = new(FailurePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(RetryPolicy)
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Duration != nil {
 		in, out := &in.Duration, &out.Duration
@@ -725,6 +1311,11 @@ func (in *KernelChaosList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KernelChaosSpec) DeepCopyInto(out *KernelChaosSpec) {
 	*out = *in
+	if in.Seed != nil {
+		in, out := &in.Seed, &out.Seed
+		*out = new(int64)
+		**out = **in
+	}
 	in.Selector.DeepCopyInto(&out.Selector)
 	in.FailKernRequest.DeepCopyInto(&out.FailKernRequest)
 	if in.Duration != nil {
@@ -735,7 +1326,17 @@ func (in *KernelChaosSpec) DeepCopyInto(out *KernelChaosSpec) {
 	if in.Scheduler != nil {
 		in, out := &in.Scheduler, &out.Scheduler
 		*out = new(SchedulerSpec)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FailurePolicy != nil {
+		in, out := &in.FailurePolicy, &out.FailurePolicy
+		*out = new(FailurePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(RetryPolicy)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -765,6 +1366,27 @@ func (in *KernelChaosStatus) DeepCopy() *KernelChaosStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in LabelSelectorRequirements) DeepCopyInto(out *LabelSelectorRequirements) {
+	{
+		in := &in
+		*out = make(LabelSelectorRequirements, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LabelSelectorRequirements.
+func (in LabelSelectorRequirements) DeepCopy() LabelSelectorRequirements {
+	if in == nil {
+		return nil
+	}
+	out := new(LabelSelectorRequirements)
+	in.DeepCopyInto(out)
+	return *out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LossSpec) DeepCopyInto(out *LossSpec) {
 	*out = *in
@@ -846,18 +1468,165 @@ func (in *MemoryStressor) DeepCopyInto(out *MemoryStressor) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryStressor.
-func (in *MemoryStressor) DeepCopy() *MemoryStressor {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryStressor.
+func (in *MemoryStressor) DeepCopy() *MemoryStressor {
+	if in == nil {
+		return nil
+	}
+	out := new(MemoryStressor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespacePolicy) DeepCopyInto(out *NamespacePolicy) {
+	*out = *in
+	if in.AllowedKinds != nil {
+		in, out := &in.AllowedKinds, &out.AllowedKinds
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacePolicy.
+func (in *NamespacePolicy) DeepCopy() *NamespacePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespacePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkChaos) DeepCopyInto(out *NetworkChaos) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkChaos.
+func (in *NetworkChaos) DeepCopy() *NetworkChaos {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkChaos)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkChaos) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkChaosList) DeepCopyInto(out *NetworkChaosList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NetworkChaos, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkChaosList.
+func (in *NetworkChaosList) DeepCopy() *NetworkChaosList {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkChaosList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkChaosList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkChaosSpec) DeepCopyInto(out *NetworkChaosSpec) {
+	*out = *in
+	if in.Seed != nil {
+		in, out := &in.Seed, &out.Seed
+		*out = new(int64)
+		**out = **in
+	}
+	in.Selector.DeepCopyInto(&out.Selector)
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(string)
+		**out = **in
+	}
+	if in.Scheduler != nil {
+		in, out := &in.Scheduler, &out.Scheduler
+		*out = new(SchedulerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FailurePolicy != nil {
+		in, out := &in.FailurePolicy, &out.FailurePolicy
+		*out = new(FailurePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(RetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	in.TcParameter.DeepCopyInto(&out.TcParameter)
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		*out = new(Target)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExternalTargets != nil {
+		in, out := &in.ExternalTargets, &out.ExternalTargets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkChaosSpec.
+func (in *NetworkChaosSpec) DeepCopy() *NetworkChaosSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkChaosSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkChaosStatus) DeepCopyInto(out *NetworkChaosStatus) {
+	*out = *in
+	in.ChaosStatus.DeepCopyInto(&out.ChaosStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkChaosStatus.
+func (in *NetworkChaosStatus) DeepCopy() *NetworkChaosStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(MemoryStressor)
+	out := new(NetworkChaosStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkChaos) DeepCopyInto(out *NetworkChaos) {
+func (in *NodeChaos) DeepCopyInto(out *NodeChaos) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -865,18 +1634,18 @@ func (in *NetworkChaos) DeepCopyInto(out *NetworkChaos) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkChaos.
-func (in *NetworkChaos) DeepCopy() *NetworkChaos {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeChaos.
+func (in *NodeChaos) DeepCopy() *NodeChaos {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkChaos)
+	out := new(NodeChaos)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NetworkChaos) DeepCopyObject() runtime.Object {
+func (in *NodeChaos) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -884,31 +1653,31 @@ func (in *NetworkChaos) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkChaosList) DeepCopyInto(out *NetworkChaosList) {
+func (in *NodeChaosList) DeepCopyInto(out *NodeChaosList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]NetworkChaos, len(*in))
+		*out = make([]NodeChaos, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkChaosList.
-func (in *NetworkChaosList) DeepCopy() *NetworkChaosList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeChaosList.
+func (in *NodeChaosList) DeepCopy() *NodeChaosList {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkChaosList)
+	out := new(NodeChaosList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NetworkChaosList) DeepCopyObject() runtime.Object {
+func (in *NodeChaosList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -916,9 +1685,19 @@ func (in *NetworkChaosList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkChaosSpec) DeepCopyInto(out *NetworkChaosSpec) {
+func (in *NodeChaosSpec) DeepCopyInto(out *NodeChaosSpec) {
 	*out = *in
+	if in.Seed != nil {
+		in, out := &in.Seed, &out.Seed
+		*out = new(int64)
+		**out = **in
+	}
 	in.Selector.DeepCopyInto(&out.Selector)
+	if in.Taint != nil {
+		in, out := &in.Taint, &out.Taint
+		*out = new(NodeTaint)
+		**out = **in
+	}
 	if in.Duration != nil {
 		in, out := &in.Duration, &out.Duration
 		*out = new(string)
@@ -927,43 +1706,77 @@ func (in *NetworkChaosSpec) DeepCopyInto(out *NetworkChaosSpec) {
 	if in.Scheduler != nil {
 		in, out := &in.Scheduler, &out.Scheduler
 		*out = new(SchedulerSpec)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
-	in.TcParameter.DeepCopyInto(&out.TcParameter)
-	if in.Target != nil {
-		in, out := &in.Target, &out.Target
-		*out = new(Target)
+	if in.FailurePolicy != nil {
+		in, out := &in.FailurePolicy, &out.FailurePolicy
+		*out = new(FailurePolicy)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.ExternalTargets != nil {
-		in, out := &in.ExternalTargets, &out.ExternalTargets
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(RetryPolicy)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkChaosSpec.
-func (in *NetworkChaosSpec) DeepCopy() *NetworkChaosSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeChaosSpec.
+func (in *NodeChaosSpec) DeepCopy() *NodeChaosSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkChaosSpec)
+	out := new(NodeChaosSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkChaosStatus) DeepCopyInto(out *NetworkChaosStatus) {
+func (in *NodeChaosStatus) DeepCopyInto(out *NodeChaosStatus) {
 	*out = *in
 	in.ChaosStatus.DeepCopyInto(&out.ChaosStatus)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkChaosStatus.
-func (in *NetworkChaosStatus) DeepCopy() *NetworkChaosStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeChaosStatus.
+func (in *NodeChaosStatus) DeepCopy() *NodeChaosStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkChaosStatus)
+	out := new(NodeChaosStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeTaint) DeepCopyInto(out *NodeTaint) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeTaint.
+func (in *NodeTaint) DeepCopy() *NodeTaint {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeTaint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrdinalRange) DeepCopyInto(out *OrdinalRange) {
+	*out = *in
+	if in.End != nil {
+		in, out := &in.End, &out.End
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrdinalRange.
+func (in *OrdinalRange) DeepCopy() *OrdinalRange {
+	if in == nil {
+		return nil
+	}
+	out := new(OrdinalRange)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1036,10 +1849,25 @@ func (in *PersistentVolumeChaosSpec) DeepCopyInto(out *PersistentVolumeChaosSpec
 		*out = new(string)
 		**out = **in
 	}
+	if in.Seed != nil {
+		in, out := &in.Seed, &out.Seed
+		*out = new(int64)
+		**out = **in
+	}
 	if in.Scheduler != nil {
 		in, out := &in.Scheduler, &out.Scheduler
 		*out = new(SchedulerSpec)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FailurePolicy != nil {
+		in, out := &in.FailurePolicy, &out.FailurePolicy
+		*out = new(FailurePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(RetryPolicy)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -1135,13 +1963,28 @@ func (in *PersistentVolumeClaimChaosSpec) DeepCopyInto(out *PersistentVolumeClai
 	if in.Scheduler != nil {
 		in, out := &in.Scheduler, &out.Scheduler
 		*out = new(SchedulerSpec)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FailurePolicy != nil {
+		in, out := &in.FailurePolicy, &out.FailurePolicy
+		*out = new(FailurePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(RetryPolicy)
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Duration != nil {
 		in, out := &in.Duration, &out.Duration
 		*out = new(string)
 		**out = **in
 	}
+	if in.Seed != nil {
+		in, out := &in.Seed, &out.Seed
+		*out = new(int64)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentVolumeClaimChaosSpec.
@@ -1236,6 +2079,21 @@ func (in *PodChaosSpec) DeepCopyInto(out *PodChaosSpec) {
 	if in.Scheduler != nil {
 		in, out := &in.Scheduler, &out.Scheduler
 		*out = new(SchedulerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FailurePolicy != nil {
+		in, out := &in.FailurePolicy, &out.FailurePolicy
+		*out = new(FailurePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(RetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Seed != nil {
+		in, out := &in.Seed, &out.Seed
+		*out = new(int64)
 		**out = **in
 	}
 	if in.Duration != nil {
@@ -1465,6 +2323,14 @@ func (in *PodNetworkChaosStatus) DeepCopy() *PodNetworkChaosStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodStatus) DeepCopyInto(out *PodStatus) {
 	*out = *in
+	if in.InjectedAt != nil {
+		in, out := &in.InjectedAt, &out.InjectedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.RecoveredAt != nil {
+		in, out := &in.RecoveredAt, &out.RecoveredAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodStatus.
@@ -1565,6 +2431,49 @@ func (in *ReorderSpec) DeepCopy() *ReorderSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryPolicy) DeepCopyInto(out *RetryPolicy) {
+	*out = *in
+	if in.Limit != nil {
+		in, out := &in.Limit, &out.Limit
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryPolicy.
+func (in *RetryPolicy) DeepCopy() *RetryPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryStatus) DeepCopyInto(out *RetryStatus) {
+	*out = *in
+	if in.LastFailureTime != nil {
+		in, out := &in.LastFailureTime, &out.LastFailureTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextRetryTime != nil {
+		in, out := &in.NextRetryTime, &out.NextRetryTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryStatus.
+func (in *RetryStatus) DeepCopy() *RetryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScheduleStatus) DeepCopyInto(out *ScheduleStatus) {
 	*out = *in
@@ -1576,6 +2485,14 @@ func (in *ScheduleStatus) DeepCopyInto(out *ScheduleStatus) {
 		in, out := &in.NextRecover, &out.NextRecover
 		*out = (*in).DeepCopy()
 	}
+	if in.ScheduledStart != nil {
+		in, out := &in.ScheduledStart, &out.ScheduledStart
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSkipped != nil {
+		in, out := &in.LastSkipped, &out.LastSkipped
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleStatus.
@@ -1591,6 +2508,28 @@ func (in *ScheduleStatus) DeepCopy() *ScheduleStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SchedulerSpec) DeepCopyInto(out *SchedulerSpec) {
 	*out = *in
+	if in.AllowedWindows != nil {
+		in, out := &in.AllowedWindows, &out.AllowedWindows
+		*out = make([]TimeWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Blackouts != nil {
+		in, out := &in.Blackouts, &out.Blackouts
+		*out = make([]BlackoutPeriod, len(*in))
+		copy(*out, *in)
+	}
+	if in.Probability != nil {
+		in, out := &in.Probability, &out.Probability
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RandomDuration != nil {
+		in, out := &in.RandomDuration, &out.RandomDuration
+		*out = new(DurationRange)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulerSpec.
@@ -1672,6 +2611,13 @@ func (in *SelectorSpec) DeepCopyInto(out *SelectorSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.ExpressionSelectors != nil {
+		in, out := &in.ExpressionSelectors, &out.ExpressionSelectors
+		*out = make(LabelSelectorRequirements, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.AnnotationSelectors != nil {
 		in, out := &in.AnnotationSelectors, &out.AnnotationSelectors
 		*out = make(map[string]string, len(*in))
@@ -1684,6 +2630,23 @@ func (in *SelectorSpec) DeepCopyInto(out *SelectorSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Workloads != nil {
+		in, out := &in.Workloads, &out.Workloads
+		*out = make([]WorkloadSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make([]KubeComponent, len(*in))
+		copy(*out, *in)
+	}
+	if in.Exclude != nil {
+		in, out := &in.Exclude, &out.Exclude
+		*out = new(SelectorSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SelectorSpec.
@@ -1696,6 +2659,32 @@ func (in *SelectorSpec) DeepCopy() *SelectorSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SockStressor) DeepCopyInto(out *SockStressor) {
+	*out = *in
+	out.Stressor = in.Stressor
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int)
+		**out = **in
+	}
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SockStressor.
+func (in *SockStressor) DeepCopy() *SockStressor {
+	if in == nil {
+		return nil
+	}
+	out := new(SockStressor)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StressChaos) DeepCopyInto(out *StressChaos) {
 	*out = *in
@@ -1758,6 +2747,11 @@ func (in *StressChaosList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StressChaosSpec) DeepCopyInto(out *StressChaosSpec) {
 	*out = *in
+	if in.Seed != nil {
+		in, out := &in.Seed, &out.Seed
+		*out = new(int64)
+		**out = **in
+	}
 	in.Selector.DeepCopyInto(&out.Selector)
 	if in.Stressors != nil {
 		in, out := &in.Stressors, &out.Stressors
@@ -1777,7 +2771,17 @@ func (in *StressChaosSpec) DeepCopyInto(out *StressChaosSpec) {
 	if in.Scheduler != nil {
 		in, out := &in.Scheduler, &out.Scheduler
 		*out = new(SchedulerSpec)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FailurePolicy != nil {
+		in, out := &in.FailurePolicy, &out.FailurePolicy
+		*out = new(FailurePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(RetryPolicy)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -1821,6 +2825,10 @@ func (in *StressInstance) DeepCopyInto(out *StressInstance) {
 		in, out := &in.StartTime, &out.StartTime
 		*out = (*in).DeepCopy()
 	}
+	if in.MemoryStartTime != nil {
+		in, out := &in.MemoryStartTime, &out.MemoryStartTime
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StressInstance.
@@ -1861,6 +2869,26 @@ func (in *Stressors) DeepCopyInto(out *Stressors) {
 		*out = new(CPUStressor)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.IOStressor != nil {
+		in, out := &in.IOStressor, &out.IOStressor
+		*out = new(IOStressor)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HDDStressor != nil {
+		in, out := &in.HDDStressor, &out.HDDStressor
+		*out = new(HDDStressor)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VMStressor != nil {
+		in, out := &in.VMStressor, &out.VMStressor
+		*out = new(VMStressor)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SockStressor != nil {
+		in, out := &in.SockStressor, &out.SockStressor
+		*out = new(SockStressor)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Stressors.
@@ -1929,6 +2957,31 @@ func (in *TcParameter) DeepCopy() *TcParameter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TemplateParameter) DeepCopyInto(out *TemplateParameter) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.AllowedValues != nil {
+		in, out := &in.AllowedValues, &out.AllowedValues
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TemplateParameter.
+func (in *TemplateParameter) DeepCopy() *TemplateParameter {
+	if in == nil {
+		return nil
+	}
+	out := new(TemplateParameter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TimeChaos) DeepCopyInto(out *TimeChaos) {
 	*out = *in
@@ -1991,6 +3044,11 @@ func (in *TimeChaosList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TimeChaosSpec) DeepCopyInto(out *TimeChaosSpec) {
 	*out = *in
+	if in.Seed != nil {
+		in, out := &in.Seed, &out.Seed
+		*out = new(int64)
+		**out = **in
+	}
 	in.Selector.DeepCopyInto(&out.Selector)
 	if in.ClockIds != nil {
 		in, out := &in.ClockIds, &out.ClockIds
@@ -2010,7 +3068,17 @@ func (in *TimeChaosSpec) DeepCopyInto(out *TimeChaosSpec) {
 	if in.Scheduler != nil {
 		in, out := &in.Scheduler, &out.Scheduler
 		*out = new(SchedulerSpec)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FailurePolicy != nil {
+		in, out := &in.FailurePolicy, &out.FailurePolicy
+		*out = new(FailurePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(RetryPolicy)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -2040,6 +3108,26 @@ func (in *TimeChaosStatus) DeepCopy() *TimeChaosStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimeWindow) DeepCopyInto(out *TimeWindow) {
+	*out = *in
+	if in.Days != nil {
+		in, out := &in.Days, &out.Days
+		*out = make([]Weekday, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeWindow.
+func (in *TimeWindow) DeepCopy() *TimeWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(TimeWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Timespec) DeepCopyInto(out *Timespec) {
 	*out = *in
@@ -2054,3 +3142,44 @@ func (in *Timespec) DeepCopy() *Timespec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMStressor) DeepCopyInto(out *VMStressor) {
+	*out = *in
+	out.Stressor = in.Stressor
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMStressor.
+func (in *VMStressor) DeepCopy() *VMStressor {
+	if in == nil {
+		return nil
+	}
+	out := new(VMStressor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadSelector) DeepCopyInto(out *WorkloadSelector) {
+	*out = *in
+	if in.Ordinals != nil {
+		in, out := &in.Ordinals, &out.Ordinals
+		*out = new(OrdinalRange)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadSelector.
+func (in *WorkloadSelector) DeepCopy() *WorkloadSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadSelector)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -34,6 +34,14 @@ func (in *{{.Type}}) IsPaused() bool {
 	return true
 }
 
+// IsDryRun returns whether this resource is only previewed without being injected
+func (in *{{.Type}}) IsDryRun() bool {
+	if in.Annotations == nil || in.Annotations[DryRunAnnotationKey] != "true" {
+		return false
+	}
+	return true
+}
+
 // GetDuration would return the duration for chaos
 func (in *{{.Type}}) GetDuration() (*time.Duration, error) {
 	if in.Spec.Duration == nil {
@@ -89,6 +97,16 @@ func (in *{{.Type}}) GetScheduler() *SchedulerSpec {
 	return in.Spec.Scheduler
 }
 
+// GetFailurePolicy returns the way to handle the targets failed to be injected
+func (in *{{.Type}}) GetFailurePolicy() *FailurePolicy {
+	return in.Spec.FailurePolicy
+}
+
+// GetRetryPolicy returns the way to retry the chaos failed to be applied
+func (in *{{.Type}}) GetRetryPolicy() *RetryPolicy {
+	return in.Spec.RetryPolicy
+}
+
 // GetChaos would return the a record for chaos
 func (in *{{.Type}}) GetChaos() *ChaosInstance {
 	instance := &ChaosInstance{
@@ -118,6 +136,11 @@ func (in *{{.Type}}) GetStatus() *ChaosStatus {
 	return &in.Status.ChaosStatus
 }
 
+// GetSelectSpec returns the spec used to select the targets
+func (in *{{.Type}}) GetSelectSpec() SelectSpec {
+	return &in.Spec
+}
+
 // +kubebuilder:object:root=true
 
 // {{.Type}}List contains a list of {{.Type}}
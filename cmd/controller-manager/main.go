@@ -23,7 +23,9 @@ import (
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
 	chaosmeshv1alpha1 "github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
 	apiWebhook "github.com/chaos-mesh/chaos-mesh/api/webhook"
+	"github.com/chaos-mesh/chaos-mesh/controllers/chaospolicy"
 	"github.com/chaos-mesh/chaos-mesh/controllers/common"
+	"github.com/chaos-mesh/chaos-mesh/controllers/lease"
 	"github.com/chaos-mesh/chaos-mesh/controllers/metrics"
 	"github.com/chaos-mesh/chaos-mesh/controllers/podiochaos"
 	"github.com/chaos-mesh/chaos-mesh/controllers/podnetworkchaos"
@@ -33,17 +35,20 @@ import (
 	"github.com/chaos-mesh/chaos-mesh/pkg/webhook/config"
 	"github.com/chaos-mesh/chaos-mesh/pkg/webhook/config/watcher"
 
+	_ "github.com/chaos-mesh/chaos-mesh/controllers/diskchaos"
 	_ "github.com/chaos-mesh/chaos-mesh/controllers/dnschaos"
 	_ "github.com/chaos-mesh/chaos-mesh/controllers/httpchaos"
 	_ "github.com/chaos-mesh/chaos-mesh/controllers/iochaos"
 	_ "github.com/chaos-mesh/chaos-mesh/controllers/kernelchaos"
 	_ "github.com/chaos-mesh/chaos-mesh/controllers/networkchaos/partition"
 	_ "github.com/chaos-mesh/chaos-mesh/controllers/networkchaos/trafficcontrol"
+	_ "github.com/chaos-mesh/chaos-mesh/controllers/nodechaos"
 	_ "github.com/chaos-mesh/chaos-mesh/controllers/persistentvolumechaos"
 	_ "github.com/chaos-mesh/chaos-mesh/controllers/persistentvolumeclaimchaos"
 	_ "github.com/chaos-mesh/chaos-mesh/controllers/podchaos/containerkill"
 	_ "github.com/chaos-mesh/chaos-mesh/controllers/podchaos/podfailure"
 	_ "github.com/chaos-mesh/chaos-mesh/controllers/podchaos/podkill"
+	_ "github.com/chaos-mesh/chaos-mesh/controllers/podchaos/scaletozero"
 	_ "github.com/chaos-mesh/chaos-mesh/controllers/stresschaos"
 	_ "github.com/chaos-mesh/chaos-mesh/controllers/timechaos"
 
@@ -93,6 +98,9 @@ func main() {
 	// set RPCTimeout config
 	utils.RPCTimeout = common.ControllerCfg.RPCTimeout
 
+	// NodeChaos is only admitted in the cluster scoped mode
+	v1alpha1.ClusterScoped = common.ControllerCfg.ClusterScoped
+
 	ctrl.SetLogger(zap.Logger(true))
 
 	options := ctrl.Options{
@@ -122,6 +130,20 @@ func main() {
 		os.Exit(1)
 	}
 
+	// The validating webhooks of all chaos check the ChaosPolicies before admitting the chaos
+	v1alpha1.RegisterChaosPolicyChecker(&chaospolicy.Checker{
+		Client: mgr.GetClient(),
+		Reader: mgr.GetAPIReader(),
+		Log:    ctrl.Log.WithName("checker").WithName("ChaosPolicy"),
+	})
+
+	// The chaos selecting none of the namespaces that opt in to chaos is rejected when the namespace filter is enabled
+	if common.ControllerCfg.EnableFilterNamespace {
+		v1alpha1.RegisterNamespaceChecker(&utils.NamespaceChecker{
+			Client: mgr.GetClient(),
+		})
+	}
+
 	// We only setup webhook for podiochaos, and the logic of applying chaos are in the mutation
 	// webhook, because we need to get the running result synchronously in io chaos reconciler
 	v1alpha1.RegisterPodIoHandler(&podiochaos.Handler{
@@ -145,6 +167,16 @@ func main() {
 		os.Exit(1)
 	}
 
+	// The leases on injections made by chaos-daemon are renewed while the controller manager is running,
+	// so that chaos-daemon recovers the injections by itself once the controller manager is down
+	if err = mgr.Add(&lease.Keeper{
+		Client: mgr.GetClient(),
+		Log:    ctrl.Log.WithName("lease-keeper"),
+	}); err != nil {
+		setupLog.Error(err, "unable to add lease keeper")
+		os.Exit(1)
+	}
+
 	// Init metrics collector
 	metricsCollector := metrics.NewChaosCollector(mgr.GetCache(), controllermetrics.Registry)
 
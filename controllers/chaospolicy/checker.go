@@ -0,0 +1,347 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaospolicy
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/go-logr/logr"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/utils"
+)
+
+// Checker checks chaos experiments against the ChaosPolicies
+type Checker struct {
+	client.Client
+	client.Reader
+	Log logr.Logger
+}
+
+var _ v1alpha1.ChaosPolicyChecker = &Checker{}
+
+// Check returns an error describing the first ChaosPolicy violated by the chaos
+func (c *Checker) Check(ctx context.Context, chaos v1alpha1.InnerObject, checkRunning bool) error {
+	var policies v1alpha1.ChaosPolicyList
+	if err := c.Reader.List(ctx, &policies); err != nil {
+		return fmt.Errorf("failed to list chaos policies: %v", err)
+	}
+	if len(policies.Items) == 0 {
+		return nil
+	}
+
+	instance := chaos.GetChaos()
+	var spec v1alpha1.SelectSpec
+	if selectable, ok := chaos.(v1alpha1.SelectableObject); ok {
+		spec = selectable.GetSelectSpec()
+	}
+
+	candidates := -1
+	var evictable []int
+	if spec != nil {
+		if isNodeDrain(chaos) {
+			evictable = c.countEvictable(ctx, spec.GetSelector())
+		} else if !isUncountable(chaos) {
+			candidates = c.countCandidates(ctx, spec)
+		}
+	}
+
+	running := -1
+	for _, policy := range policies.Items {
+		if err := checkKinds(&policy, instance, spec); err != nil {
+			return err
+		}
+
+		if spec != nil {
+			var err error
+			switch {
+			case isNodeDrain(chaos):
+				err = checkEvictions(&policy, instance, spec.GetMode(), spec.GetValue(), evictable)
+			case isUncountable(chaos):
+				err = checkUncountable(&policy, instance)
+			default:
+				err = checkTargets(&policy, instance, spec.GetMode(), spec.GetValue(), candidates)
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		if !checkRunning || policy.Spec.MaxConcurrentExperiments == nil {
+			continue
+		}
+		if running < 0 {
+			var err error
+			running, err = c.countRunning(ctx, instance.UID)
+			if err != nil {
+				return fmt.Errorf("failed to count running experiments: %v", err)
+			}
+		}
+		if running >= int(*policy.Spec.MaxConcurrentExperiments) {
+			return fmt.Errorf("chaos policy %q allows at most %d running experiments, but %d experiments are already running",
+				policy.Name, *policy.Spec.MaxConcurrentExperiments, running)
+		}
+	}
+
+	return nil
+}
+
+// countCandidates returns the number of the candidate pods of chaos, or -1 if it is unknown
+func (c *Checker) countCandidates(ctx context.Context, spec v1alpha1.SelectSpec) int {
+	pods, err := utils.SelectCandidatePods(ctx, c.Client, c.Reader, spec.GetSelector())
+	if err != nil {
+		c.Log.Info("failed to select candidate pods", "error", err.Error())
+		return -1
+	}
+	return len(pods)
+}
+
+// countEvictable returns the number of the pods evicted by draining each candidate node in descending order,
+// or nil if it is unknown
+func (c *Checker) countEvictable(ctx context.Context, selector v1alpha1.SelectorSpec) []int {
+	nodes, err := utils.SelectNodes(ctx, c.Client, selector)
+	if err != nil {
+		c.Log.Info("failed to select candidate nodes", "error", err.Error())
+		return nil
+	}
+
+	evictable := make([]int, 0, len(nodes))
+	for _, node := range nodes {
+		pods, err := utils.SelectEvictablePods(ctx, c.Client, c.Reader, node.Name)
+		if err != nil {
+			c.Log.Info("failed to select evictable pods", "node", node.Name, "error", err.Error())
+			return nil
+		}
+		evictable = append(evictable, len(pods))
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(evictable)))
+	return evictable
+}
+
+// countRunning returns the number of the running experiments except the one with the uid
+func (c *Checker) countRunning(ctx context.Context, uid string) (int, error) {
+	running := 0
+	for _, kind := range v1alpha1.AllKinds() {
+		list := kind.ChaosList.DeepCopyObject()
+		if err := c.Client.List(ctx, list); err != nil {
+			return 0, err
+		}
+
+		items, err := apimeta.ExtractList(list)
+		if err != nil {
+			return 0, err
+		}
+		for _, item := range items {
+			chaos, ok := item.(v1alpha1.InnerObject)
+			if !ok || chaos.GetChaos().UID == uid {
+				continue
+			}
+			if chaos.GetStatus().Experiment.Phase == v1alpha1.ExperimentPhaseRunning {
+				running++
+			}
+		}
+	}
+
+	return running, nil
+}
+
+// checkKinds checks whether the kind of chaos is allowed in the namespace of chaos and the namespaces of its targets
+func checkKinds(policy *v1alpha1.ChaosPolicy, instance *v1alpha1.ChaosInstance, spec v1alpha1.SelectSpec) error {
+	namespaces := []string{instance.Namespace}
+	if spec != nil {
+		namespaces = append(namespaces, targetNamespaces(spec.GetSelector())...)
+	}
+
+	for _, namespacePolicy := range policy.Spec.Namespaces {
+		if !containsString(namespaces, namespacePolicy.Namespace) || containsString(namespacePolicy.AllowedKinds, instance.Kind) {
+			continue
+		}
+		return fmt.Errorf("chaos policy %q does not allow %s in namespace %s, allowed kinds: %v",
+			policy.Name, instance.Kind, namespacePolicy.Namespace, namespacePolicy.AllowedKinds)
+	}
+
+	return nil
+}
+
+// isNodeDrain returns whether the chaos drains nodes, whose targets are the pods evicted from the nodes
+func isNodeDrain(chaos v1alpha1.InnerObject) bool {
+	nodeChaos, ok := chaos.(*v1alpha1.NodeChaos)
+	return ok && nodeChaos.Spec.Action == v1alpha1.NodeDrainAction
+}
+
+// isUncountable returns whether the pods affected by chaos can't be counted, such as the pods using the
+// volumes injected by PersistentVolumeChaos and the pods on the nodes injected by NodeChaos other than draining
+func isUncountable(chaos v1alpha1.InnerObject) bool {
+	switch chaos := chaos.(type) {
+	case *v1alpha1.PersistentVolumeChaos, *v1alpha1.PersistentVolumeClaimChaos:
+		return true
+	case *v1alpha1.NodeChaos:
+		return chaos.Spec.Action != v1alpha1.NodeDrainAction
+	}
+	return false
+}
+
+// checkUncountable rejects the chaos whose affected pods can't be counted if the policy limits the targets
+func checkUncountable(policy *v1alpha1.ChaosPolicy, instance *v1alpha1.ChaosInstance) error {
+	if policy.Spec.MaxPods == nil && policy.Spec.MaxPercent == nil {
+		return nil
+	}
+	return fmt.Errorf("chaos policy %q limits the targets per experiment, but the pods affected by %s %s/%s can't be counted",
+		policy.Name, instance.Kind, instance.Namespace, instance.Name)
+}
+
+// checkEvictions checks the number and the percent of the pods evicted by draining nodes.
+// The nodes with the most evictable pods are counted as the selected ones, and the evictions are
+// rejected if the evictable pods are unknown.
+func checkEvictions(policy *v1alpha1.ChaosPolicy, instance *v1alpha1.ChaosInstance, mode v1alpha1.PodMode, value string, evictable []int) error {
+	maxPods, maxPercent := policy.Spec.MaxPods, policy.Spec.MaxPercent
+	if maxPods == nil && maxPercent == nil {
+		return nil
+	}
+	if evictable == nil {
+		return fmt.Errorf("chaos policy %q limits the targets per experiment, but the pods evicted by %s %s/%s can't be counted",
+			policy.Name, instance.Kind, instance.Namespace, instance.Name)
+	}
+
+	nodes := len(evictable)
+	switch mode {
+	case v1alpha1.OnePodMode:
+		nodes = 1
+	case v1alpha1.FixedPodMode:
+		num, err := strconv.Atoi(value)
+		if err != nil {
+			return err
+		}
+		nodes = num
+	case v1alpha1.FixedPercentPodMode, v1alpha1.RandomMaxPercentPodMode:
+		percent, err := strconv.Atoi(value)
+		if err != nil {
+			return err
+		}
+		nodes = len(evictable) * percent / 100
+	}
+	if nodes > len(evictable) {
+		nodes = len(evictable)
+	}
+
+	pods, total := 0, 0
+	for i, num := range evictable {
+		if i < nodes {
+			pods += num
+		}
+		total += num
+	}
+
+	if maxPods != nil && pods > int(*maxPods) {
+		return fmt.Errorf("chaos policy %q allows at most %d targets per experiment, but %s %s/%s evicts up to %d pods in mode %s",
+			policy.Name, *maxPods, instance.Kind, instance.Namespace, instance.Name, pods, mode)
+	}
+	if maxPercent != nil && total > 0 {
+		// round up, so that the evicted pods exceed the limit if and only if the percent does
+		percent := (pods*100 + total - 1) / total
+		if percent > int(*maxPercent) {
+			return fmt.Errorf("chaos policy %q allows at most %d%% of the targets per experiment, but %s %s/%s evicts up to %d%% of the pods on the candidate nodes in mode %s",
+				policy.Name, *maxPercent, instance.Kind, instance.Namespace, instance.Name, percent, mode)
+		}
+	}
+
+	return nil
+}
+
+// checkTargets checks the number and the percent of the targets injected by chaos.
+// The modes whose number of targets is only known at injection time are counted as all the candidates.
+func checkTargets(policy *v1alpha1.ChaosPolicy, instance *v1alpha1.ChaosInstance, mode v1alpha1.PodMode, value string, candidates int) error {
+	maxPods, maxPercent := policy.Spec.MaxPods, policy.Spec.MaxPercent
+
+	if mode == v1alpha1.FixedPercentPodMode || mode == v1alpha1.RandomMaxPercentPodMode {
+		percent, err := strconv.Atoi(value)
+		if err != nil {
+			return err
+		}
+		if maxPercent != nil && percent > int(*maxPercent) {
+			return fmt.Errorf("chaos policy %q allows at most %d%% of the targets per experiment, but %s %s/%s injects %d%% in mode %s",
+				policy.Name, *maxPercent, instance.Kind, instance.Namespace, instance.Name, percent, mode)
+		}
+		if maxPods != nil && candidates >= 0 && candidates*percent/100 > int(*maxPods) {
+			return fmt.Errorf("chaos policy %q allows at most %d targets per experiment, but %s %s/%s injects %d in mode %s",
+				policy.Name, *maxPods, instance.Kind, instance.Namespace, instance.Name, candidates*percent/100, mode)
+		}
+		return nil
+	}
+
+	pods := candidates
+	switch mode {
+	case v1alpha1.OnePodMode:
+		pods = 1
+	case v1alpha1.FixedPodMode, v1alpha1.FixedSpreadNodesPodMode:
+		num, err := strconv.Atoi(value)
+		if err != nil {
+			return err
+		}
+		if candidates < 0 || num < candidates {
+			pods = num
+		}
+	}
+
+	if maxPods != nil && pods > int(*maxPods) {
+		return fmt.Errorf("chaos policy %q allows at most %d targets per experiment, but %s %s/%s injects %d in mode %s",
+			policy.Name, *maxPods, instance.Kind, instance.Namespace, instance.Name, pods, mode)
+	}
+
+	if maxPercent == nil {
+		return nil
+	}
+	percent := -1
+	if candidates > 0 {
+		// round up, so that the targets exceed the limit if and only if the percent does
+		percent = (pods*100 + candidates - 1) / candidates
+	} else if candidates < 0 && mode == v1alpha1.AllPodMode {
+		percent = 100
+	}
+	if percent > int(*maxPercent) {
+		return fmt.Errorf("chaos policy %q allows at most %d%% of the targets per experiment, but %s %s/%s injects %d%% in mode %s",
+			policy.Name, *maxPercent, instance.Kind, instance.Namespace, instance.Name, percent, mode)
+	}
+
+	return nil
+}
+
+// targetNamespaces returns the namespaces of the targets specified by the selector
+func targetNamespaces(selector v1alpha1.SelectorSpec) []string {
+	namespaces := append([]string{}, selector.Namespaces...)
+	for namespace := range selector.Pods {
+		namespaces = append(namespaces, namespace)
+	}
+	for _, workload := range selector.Workloads {
+		namespaces = append(namespaces, workload.Namespace)
+	}
+	if len(selector.Components) > 0 {
+		namespaces = append(namespaces, v1alpha1.KubeSystemNamespace)
+	}
+	return namespaces
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,356 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaospolicy
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/common"
+)
+
+func int32Ptr(i int32) *int32 {
+	return &i
+}
+
+func newPodChaos(namespace string, mode v1alpha1.PodMode, value string) *v1alpha1.PodChaos {
+	return &v1alpha1.PodChaos{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pod-chaos",
+			Namespace: namespace,
+			UID:       types.UID("pod-chaos"),
+		},
+		Spec: v1alpha1.PodChaosSpec{
+			Action: v1alpha1.PodKillAction,
+			Mode:   mode,
+			Value:  value,
+			Selector: v1alpha1.SelectorSpec{
+				Namespaces: []string{metav1.NamespaceDefault},
+			},
+		},
+	}
+}
+
+func newChecker(g *WithT, objects ...runtime.Object) *Checker {
+	scheme := runtime.NewScheme()
+	g.Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+	g.Expect(v1alpha1.AddToScheme(scheme)).To(Succeed())
+
+	for i := 0; i < 5; i++ {
+		objects = append(objects, &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("pod%d", i),
+				Namespace: metav1.NamespaceDefault,
+			},
+			Spec:   v1.PodSpec{NodeName: "node0"},
+			Status: v1.PodStatus{Phase: v1.PodRunning},
+		})
+	}
+
+	c := fake.NewFakeClientWithScheme(scheme, objects...)
+	return &Checker{
+		Client: c,
+		Reader: c,
+		Log:    ctrl.Log.WithName("chaospolicy"),
+	}
+}
+
+func TestCheckWithoutPolicy(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	checker := newChecker(g)
+	g.Expect(checker.Check(context.Background(), newPodChaos(metav1.NamespaceDefault, v1alpha1.AllPodMode, ""), true)).To(Succeed())
+}
+
+func TestCheckKinds(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	policy := &v1alpha1.ChaosPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "kinds"},
+		Spec: v1alpha1.ChaosPolicySpec{
+			Namespaces: []v1alpha1.NamespacePolicy{{
+				Namespace:    metav1.NamespaceDefault,
+				AllowedKinds: []string{v1alpha1.KindNetworkChaos},
+			}},
+		},
+	}
+	checker := newChecker(g, policy)
+
+	err := checker.Check(context.Background(), newPodChaos(metav1.NamespaceDefault, v1alpha1.OnePodMode, ""), true)
+	g.Expect(err).To(MatchError(`chaos policy "kinds" does not allow PodChaos in namespace default, allowed kinds: [NetworkChaos]`))
+
+	// the chaos in another namespace is rejected because of the namespace of its targets
+	err = checker.Check(context.Background(), newPodChaos("chaos-testing", v1alpha1.OnePodMode, ""), true)
+	g.Expect(err).To(HaveOccurred())
+
+	chaos := newPodChaos("chaos-testing", v1alpha1.OnePodMode, "")
+	chaos.Spec.Selector.Namespaces = []string{"chaos-testing"}
+	g.Expect(checker.Check(context.Background(), chaos, true)).To(Succeed())
+}
+
+func TestCheckTargets(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type TestCase struct {
+		name     string
+		spec     v1alpha1.ChaosPolicySpec
+		mode     v1alpha1.PodMode
+		value    string
+		expected string
+	}
+
+	tcs := []TestCase{
+		{
+			name:  "one pod within max pods",
+			spec:  v1alpha1.ChaosPolicySpec{MaxPods: int32Ptr(1)},
+			mode:  v1alpha1.OnePodMode,
+			value: "",
+		},
+		{
+			name:     "fixed pods over max pods",
+			spec:     v1alpha1.ChaosPolicySpec{MaxPods: int32Ptr(2)},
+			mode:     v1alpha1.FixedPodMode,
+			value:    "3",
+			expected: `chaos policy "limit" allows at most 2 targets per experiment, but PodChaos default/pod-chaos injects 3 in mode fixed`,
+		},
+		{
+			name:     "all pods over max pods",
+			spec:     v1alpha1.ChaosPolicySpec{MaxPods: int32Ptr(4)},
+			mode:     v1alpha1.AllPodMode,
+			value:    "",
+			expected: `chaos policy "limit" allows at most 4 targets per experiment, but PodChaos default/pod-chaos injects 5 in mode all`,
+		},
+		{
+			name:     "fixed percent over max pods",
+			spec:     v1alpha1.ChaosPolicySpec{MaxPods: int32Ptr(1)},
+			mode:     v1alpha1.FixedPercentPodMode,
+			value:    "40",
+			expected: `chaos policy "limit" allows at most 1 targets per experiment, but PodChaos default/pod-chaos injects 2 in mode fixed-percent`,
+		},
+		{
+			name:     "fixed percent over max percent",
+			spec:     v1alpha1.ChaosPolicySpec{MaxPercent: int32Ptr(50)},
+			mode:     v1alpha1.FixedPercentPodMode,
+			value:    "60",
+			expected: `chaos policy "limit" allows at most 50% of the targets per experiment, but PodChaos default/pod-chaos injects 60% in mode fixed-percent`,
+		},
+		{
+			name:  "fixed pods within max percent",
+			spec:  v1alpha1.ChaosPolicySpec{MaxPercent: int32Ptr(40)},
+			mode:  v1alpha1.FixedPodMode,
+			value: "2",
+		},
+		{
+			name:     "fixed pods over max percent",
+			spec:     v1alpha1.ChaosPolicySpec{MaxPercent: int32Ptr(50)},
+			mode:     v1alpha1.FixedPodMode,
+			value:    "3",
+			expected: `chaos policy "limit" allows at most 50% of the targets per experiment, but PodChaos default/pod-chaos injects 60% in mode fixed`,
+		},
+		{
+			name:     "all pods over max percent",
+			spec:     v1alpha1.ChaosPolicySpec{MaxPercent: int32Ptr(99)},
+			mode:     v1alpha1.AllPodMode,
+			value:    "",
+			expected: `chaos policy "limit" allows at most 99% of the targets per experiment, but PodChaos default/pod-chaos injects 100% in mode all`,
+		},
+	}
+
+	for _, tc := range tcs {
+		checker := newChecker(g, &v1alpha1.ChaosPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "limit"},
+			Spec:       tc.spec,
+		})
+
+		err := checker.Check(context.Background(), newPodChaos(metav1.NamespaceDefault, tc.mode, tc.value), true)
+		if tc.expected == "" {
+			g.Expect(err).ToNot(HaveOccurred(), tc.name)
+		} else {
+			g.Expect(err).To(MatchError(tc.expected), tc.name)
+		}
+	}
+}
+
+func TestCheckTargetsWithoutCandidates(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	policy := &v1alpha1.ChaosPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "limit"},
+		Spec:       v1alpha1.ChaosPolicySpec{MaxPods: int32Ptr(2), MaxPercent: int32Ptr(50)},
+	}
+	instance := &v1alpha1.ChaosInstance{Name: "pod-chaos", Namespace: "default", Kind: v1alpha1.KindPodChaos}
+
+	g.Expect(checkTargets(policy, instance, v1alpha1.FixedPodMode, "2", -1)).To(Succeed())
+	g.Expect(checkTargets(policy, instance, v1alpha1.FixedPodMode, "3", -1)).To(HaveOccurred())
+	g.Expect(checkTargets(policy, instance, v1alpha1.RandomMaxPercentPodMode, "50", -1)).To(Succeed())
+	g.Expect(checkTargets(policy, instance, v1alpha1.AllPodMode, "", -1)).To(HaveOccurred())
+}
+
+func TestCheckEvictions(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	common.ControllerCfg.ClusterScoped = true
+	defer func() {
+		common.ControllerCfg.ClusterScoped = false
+	}()
+
+	// node0 runs the 5 pods created by newChecker, node1 runs 1 pod and a protected pod
+	objects := []runtime.Object{
+		&v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node0", Labels: map[string]string{"drain": "true"}}},
+		&v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node1", Labels: map[string]string{"drain": "true"}}},
+		&v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "pod5", Namespace: metav1.NamespaceDefault},
+			Spec:       v1.PodSpec{NodeName: "node1"},
+			Status:     v1.PodStatus{Phase: v1.PodRunning},
+		},
+		&v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "protected",
+				Namespace:   metav1.NamespaceDefault,
+				Annotations: map[string]string{v1alpha1.ProtectedAnnotationKey: "true"},
+			},
+			Spec:   v1.PodSpec{NodeName: "node1"},
+			Status: v1.PodStatus{Phase: v1.PodRunning},
+		},
+	}
+	newNodeChaos := func(action v1alpha1.NodeChaosAction, mode v1alpha1.PodMode, value string) *v1alpha1.NodeChaos {
+		return &v1alpha1.NodeChaos{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-chaos", Namespace: metav1.NamespaceDefault},
+			Spec: v1alpha1.NodeChaosSpec{
+				Action: action,
+				Mode:   mode,
+				Value:  value,
+				Selector: v1alpha1.SelectorSpec{
+					LabelSelectors: map[string]string{"drain": "true"},
+				},
+			},
+		}
+	}
+
+	type TestCase struct {
+		name     string
+		spec     v1alpha1.ChaosPolicySpec
+		chaos    v1alpha1.InnerObject
+		expected string
+	}
+
+	tcs := []TestCase{
+		{
+			name:     "one node over max pods",
+			spec:     v1alpha1.ChaosPolicySpec{MaxPods: int32Ptr(4)},
+			chaos:    newNodeChaos(v1alpha1.NodeDrainAction, v1alpha1.OnePodMode, ""),
+			expected: `chaos policy "limit" allows at most 4 targets per experiment, but NodeChaos default/node-chaos evicts up to 5 pods in mode one`,
+		},
+		{
+			name:  "one node within max pods",
+			spec:  v1alpha1.ChaosPolicySpec{MaxPods: int32Ptr(5)},
+			chaos: newNodeChaos(v1alpha1.NodeDrainAction, v1alpha1.OnePodMode, ""),
+		},
+		{
+			name:     "all nodes over max pods",
+			spec:     v1alpha1.ChaosPolicySpec{MaxPods: int32Ptr(5)},
+			chaos:    newNodeChaos(v1alpha1.NodeDrainAction, v1alpha1.AllPodMode, ""),
+			expected: `chaos policy "limit" allows at most 5 targets per experiment, but NodeChaos default/node-chaos evicts up to 6 pods in mode all`,
+		},
+		{
+			name:     "one node over max percent",
+			spec:     v1alpha1.ChaosPolicySpec{MaxPercent: int32Ptr(80)},
+			chaos:    newNodeChaos(v1alpha1.NodeDrainAction, v1alpha1.OnePodMode, ""),
+			expected: `chaos policy "limit" allows at most 80% of the targets per experiment, but NodeChaos default/node-chaos evicts up to 84% of the pods on the candidate nodes in mode one`,
+		},
+		{
+			name:  "fixed percent within max percent",
+			spec:  v1alpha1.ChaosPolicySpec{MaxPercent: int32Ptr(50)},
+			chaos: newNodeChaos(v1alpha1.NodeDrainAction, v1alpha1.FixedPercentPodMode, "40"),
+		},
+		{
+			name:     "cordon with max pods",
+			spec:     v1alpha1.ChaosPolicySpec{MaxPods: int32Ptr(100)},
+			chaos:    newNodeChaos(v1alpha1.NodeCordonAction, v1alpha1.OnePodMode, ""),
+			expected: `chaos policy "limit" limits the targets per experiment, but the pods affected by NodeChaos default/node-chaos can't be counted`,
+		},
+		{
+			name:  "cordon without limits",
+			spec:  v1alpha1.ChaosPolicySpec{MaxConcurrentExperiments: int32Ptr(1)},
+			chaos: newNodeChaos(v1alpha1.NodeCordonAction, v1alpha1.OnePodMode, ""),
+		},
+		{
+			name: "persistent volume chaos with max percent",
+			spec: v1alpha1.ChaosPolicySpec{MaxPercent: int32Ptr(100)},
+			chaos: &v1alpha1.PersistentVolumeChaos{
+				ObjectMeta: metav1.ObjectMeta{Name: "pv-chaos", Namespace: metav1.NamespaceDefault},
+				Spec:       v1alpha1.PersistentVolumeChaosSpec{Mode: v1alpha1.OnePodMode},
+			},
+			expected: `chaos policy "limit" limits the targets per experiment, but the pods affected by PersistentVolumeChaos default/pv-chaos can't be counted`,
+		},
+	}
+
+	for _, tc := range tcs {
+		checker := newChecker(g, append([]runtime.Object{&v1alpha1.ChaosPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "limit"},
+			Spec:       tc.spec,
+		}}, objects...)...)
+
+		err := checker.Check(context.Background(), tc.chaos, true)
+		if tc.expected == "" {
+			g.Expect(err).ToNot(HaveOccurred(), tc.name)
+		} else {
+			g.Expect(err).To(MatchError(tc.expected), tc.name)
+		}
+	}
+}
+
+func TestCheckRunning(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	running := &v1alpha1.NetworkChaos{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "network-chaos",
+			Namespace: metav1.NamespaceDefault,
+			UID:       types.UID("network-chaos"),
+		},
+		Status: v1alpha1.NetworkChaosStatus{
+			ChaosStatus: v1alpha1.ChaosStatus{
+				Experiment: v1alpha1.ExperimentStatus{Phase: v1alpha1.ExperimentPhaseRunning},
+			},
+		},
+	}
+	policy := &v1alpha1.ChaosPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "concurrency"},
+		Spec:       v1alpha1.ChaosPolicySpec{MaxConcurrentExperiments: int32Ptr(1)},
+	}
+	checker := newChecker(g, policy, running)
+
+	chaos := newPodChaos(metav1.NamespaceDefault, v1alpha1.OnePodMode, "")
+	err := checker.Check(context.Background(), chaos, true)
+	g.Expect(err).To(MatchError(`chaos policy "concurrency" allows at most 1 running experiments, but 1 experiments are already running`))
+
+	// the running experiments are not checked when updating the chaos
+	g.Expect(checker.Check(context.Background(), chaos, false)).To(Succeed())
+
+	// the running experiment itself is not counted
+	g.Expect(checker.Check(context.Background(), running, true)).To(Succeed())
+}
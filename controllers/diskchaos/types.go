@@ -0,0 +1,268 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diskchaos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"golang.org/x/sync/errgroup"
+	v1 "k8s.io/api/core/v1"
+	k8serror "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/common"
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	"github.com/chaos-mesh/chaos-mesh/pkg/router"
+	ctx "github.com/chaos-mesh/chaos-mesh/pkg/router/context"
+	end "github.com/chaos-mesh/chaos-mesh/pkg/router/endpoint"
+	"github.com/chaos-mesh/chaos-mesh/pkg/utils"
+)
+
+const diskChaosMsg = "%s on %s"
+
+// endpoint is diskchaos reconciler
+type endpoint struct {
+	ctx.Context
+}
+
+// Apply applies disk-chaos
+func (r *endpoint) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1.InnerObject) error {
+	diskchaos, ok := chaos.(*v1alpha1.DiskChaos)
+	if !ok {
+		err := errors.New("chaos is not diskchaos")
+		r.Log.Error(err, "chaos is not DiskChaos", "chaos", chaos)
+		return err
+	}
+
+	seed := utils.NewSeed(diskchaos.Spec.Seed)
+	diskchaos.Status.Experiment.Seed = &seed
+	pods, err := utils.SelectAndFilterPods(ctx, r.Client, r.Reader, &diskchaos.Spec, seed)
+	if err != nil {
+		r.Log.Error(err, "failed to select and filter pods")
+		return err
+	}
+
+	results := utils.NewInjectionResults(diskchaos.Spec.FailurePolicy)
+	err = r.applyAllPods(ctx, pods, diskchaos, results)
+
+	diskchaos.Status.Experiment.PodRecords = make([]v1alpha1.PodStatus, 0, len(pods))
+	for _, pod := range pods {
+		ps := v1alpha1.PodStatus{
+			Namespace: pod.Namespace,
+			Name:      pod.Name,
+			HostIP:    pod.Status.HostIP,
+			PodIP:     pod.Status.PodIP,
+			Action:    string(diskchaos.Spec.Action),
+			Message:   fmt.Sprintf(diskChaosMsg, diskchaos.Spec.Action, diskchaos.Spec.Path),
+		}
+
+		diskchaos.Status.Experiment.PodRecords = append(diskchaos.Status.Experiment.PodRecords, ps)
+	}
+	results.Fill(diskchaos.Status.Experiment.PodRecords)
+	if err != nil {
+		r.Log.Error(err, "failed to apply chaos on all pods")
+		return err
+	}
+	r.Event(diskchaos, v1.EventTypeNormal, utils.EventChaosInjected, "")
+	return nil
+}
+
+// Recover means the reconciler recovers the chaos action
+func (r *endpoint) Recover(ctx context.Context, req ctrl.Request, chaos v1alpha1.InnerObject) error {
+	diskchaos, ok := chaos.(*v1alpha1.DiskChaos)
+	if !ok {
+		err := errors.New("chaos is not DiskChaos")
+		r.Log.Error(err, "chaos is not DiskChaos", "chaos", chaos)
+		return err
+	}
+
+	if err := r.cleanFinalizersAndRecover(ctx, diskchaos); err != nil {
+		return err
+	}
+	r.Event(diskchaos, v1.EventTypeNormal, utils.EventChaosRecovered, "")
+
+	return nil
+}
+
+func (r *endpoint) cleanFinalizersAndRecover(ctx context.Context, chaos *v1alpha1.DiskChaos) error {
+	var result error
+
+	for _, key := range chaos.Finalizers {
+		ns, name, err := cache.SplitMetaNamespaceKey(key)
+		if err != nil {
+			result = multierror.Append(result, err)
+			continue
+		}
+
+		var pod v1.Pod
+		err = r.Client.Get(ctx, types.NamespacedName{
+			Namespace: ns,
+			Name:      name,
+		}, &pod)
+
+		if err != nil {
+			if !k8serror.IsNotFound(err) {
+				result = multierror.Append(result, err)
+				continue
+			}
+
+			r.Log.Info("Pod not found", "namespace", ns, "name", name)
+			chaos.Finalizers = utils.RemoveFromFinalizer(chaos.Finalizers, key)
+			continue
+		}
+
+		err = r.recoverPod(ctx, &pod, chaos)
+		if err != nil {
+			result = multierror.Append(result, err)
+			continue
+		}
+
+		chaos.Finalizers = utils.RemoveFromFinalizer(chaos.Finalizers, key)
+	}
+
+	if chaos.Annotations[common.AnnotationCleanFinalizer] == common.AnnotationCleanFinalizerForced {
+		r.Log.Info("Force cleanup all finalizers", "chaos", chaos)
+		chaos.Finalizers = chaos.Finalizers[:0]
+		return nil
+	}
+
+	return result
+}
+
+func (r *endpoint) recoverPod(ctx context.Context, pod *v1.Pod, chaos *v1alpha1.DiskChaos) error {
+	r.Log.Info("Try to release disk on pod", "namespace", pod.Namespace, "name", pod.Name)
+
+	pbClient, err := utils.NewChaosDaemonClient(ctx, r.Client, pod, common.ControllerCfg.ChaosDaemonPort)
+	if err != nil {
+		return err
+	}
+	defer pbClient.Close()
+
+	containerID, err := targetContainerID(pod, chaos)
+	if err != nil {
+		return err
+	}
+
+	_, err = pbClient.RecoverDiskChaos(ctx, &pb.DiskChaosRequest{
+		ContainerId: containerID,
+		Path:        chaos.Spec.Path,
+		Instance:    string(chaos.UID),
+	})
+
+	return err
+}
+
+// Object would return the instance of chaos
+func (r *endpoint) Object() v1alpha1.InnerObject {
+	return &v1alpha1.DiskChaos{}
+}
+
+func (r *endpoint) applyAllPods(ctx context.Context, pods []v1.Pod, chaos *v1alpha1.DiskChaos, results *utils.InjectionResults) error {
+	g := errgroup.Group{}
+	for index := range pods {
+		pod := &pods[index]
+
+		key, err := cache.MetaNamespaceKeyFunc(pod)
+		if err != nil {
+			return err
+		}
+		chaos.Finalizers = utils.InsertFinalizer(chaos.Finalizers, key)
+
+		g.Go(func() error {
+			return results.Inject(pod.Namespace, pod.Name, func() error {
+				return r.applyPod(ctx, pod, chaos)
+			})
+		})
+	}
+
+	return g.Wait()
+}
+
+func (r *endpoint) applyPod(ctx context.Context, pod *v1.Pod, chaos *v1alpha1.DiskChaos) error {
+	r.Log.Info("Try to apply disk chaos", "namespace", pod.Namespace, "name", pod.Name)
+
+	pbClient, err := utils.NewChaosDaemonClient(ctx, r.Client, pod, common.ControllerCfg.ChaosDaemonPort)
+	if err != nil {
+		return err
+	}
+	defer pbClient.Close()
+
+	containerID, err := targetContainerID(pod, chaos)
+	if err != nil {
+		return err
+	}
+
+	request := &pb.DiskChaosRequest{
+		ContainerId: containerID,
+		Path:        chaos.Spec.Path,
+		Instance:    string(chaos.UID),
+	}
+	switch chaos.Spec.Action {
+	case v1alpha1.DiskFillAction:
+		size, err := resource.ParseQuantity(chaos.Spec.Size)
+		if err != nil {
+			return err
+		}
+		request.Action = pb.DiskChaosRequest_FILL
+		request.Size = uint64(size.Value())
+	case v1alpha1.InodeExhaustionAction:
+		request.Action = pb.DiskChaosRequest_INODE_EXHAUSTION
+		request.Inodes = uint64(chaos.Spec.Inodes)
+	default:
+		return fmt.Errorf("unknown disk chaos action %s", chaos.Spec.Action)
+	}
+
+	_, err = pbClient.ApplyDiskChaos(ctx, request)
+
+	return err
+}
+
+// targetContainerID returns the ID of the container specified by ContainerName,
+// or the first container of the pod if it's not set
+func targetContainerID(pod *v1.Pod, chaos *v1alpha1.DiskChaos) (string, error) {
+	if len(pod.Status.ContainerStatuses) == 0 {
+		return "", fmt.Errorf("%s %s can't get the state of container", pod.Namespace, pod.Name)
+	}
+
+	if chaos.Spec.ContainerName == nil || len(strings.TrimSpace(*chaos.Spec.ContainerName)) == 0 {
+		return pod.Status.ContainerStatuses[0].ContainerID, nil
+	}
+
+	for _, container := range pod.Status.ContainerStatuses {
+		if container.Name == *chaos.Spec.ContainerName {
+			return container.ContainerID, nil
+		}
+	}
+
+	return "", fmt.Errorf("cannot find container with name %s", *chaos.Spec.ContainerName)
+}
+
+func init() {
+	router.Register("diskchaos", &v1alpha1.DiskChaos{}, func(obj runtime.Object) bool {
+		return true
+	}, func(ctx ctx.Context) end.Endpoint {
+		return &endpoint{
+			Context: ctx,
+		}
+	})
+}
@@ -60,7 +60,9 @@ func (r *endpoint) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1.I
 		return err
 	}
 
-	pods, err := utils.SelectAndFilterPods(ctx, r.Client, r.Reader, &dnschaos.Spec)
+	seed := utils.NewSeed(dnschaos.Spec.Seed)
+	dnschaos.Status.Experiment.Seed = &seed
+	pods, err := utils.SelectAndFilterPods(ctx, r.Client, r.Reader, &dnschaos.Spec, seed)
 	if err != nil {
 		r.Log.Error(err, "failed to select and generate pods")
 		return err
@@ -68,10 +70,8 @@ func (r *endpoint) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1.I
 
 	// TODO: get chaos dns server's address, and send request to this server to set chaos rules.
 
-	if err = r.applyAllPods(ctx, pods, dnschaos, ""); err != nil {
-		r.Log.Error(err, "failed to apply chaos on all pods")
-		return err
-	}
+	results := utils.NewInjectionResults(dnschaos.Spec.FailurePolicy)
+	err = r.applyAllPods(ctx, pods, dnschaos, "", results)
 
 	dnschaos.Status.Experiment.PodRecords = make([]v1alpha1.PodStatus, 0, len(pods))
 	for _, pod := range pods {
@@ -84,6 +84,11 @@ func (r *endpoint) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1.I
 
 		dnschaos.Status.Experiment.PodRecords = append(dnschaos.Status.Experiment.PodRecords, ps)
 	}
+	results.Fill(dnschaos.Status.Experiment.PodRecords)
+	if err != nil {
+		r.Log.Error(err, "failed to apply chaos on all pods")
+		return err
+	}
 	r.Event(dnschaos, v1.EventTypeNormal, utils.EventChaosInjected, "")
 	return nil
 }
@@ -163,7 +168,7 @@ func (r *endpoint) Object() v1alpha1.InnerObject {
 	return &v1alpha1.DNSChaos{}
 }
 
-func (r *endpoint) applyAllPods(ctx context.Context, pods []v1.Pod, chaos *v1alpha1.DNSChaos, dnsServerIP string) error {
+func (r *endpoint) applyAllPods(ctx context.Context, pods []v1.Pod, chaos *v1alpha1.DNSChaos, dnsServerIP string, results *utils.InjectionResults) error {
 	g := errgroup.Group{}
 	for index := range pods {
 		pod := &pods[index]
@@ -176,7 +181,9 @@ func (r *endpoint) applyAllPods(ctx context.Context, pods []v1.Pod, chaos *v1alp
 		chaos.Finalizers = utils.InsertFinalizer(chaos.Finalizers, key)
 
 		g.Go(func() error {
-			return r.applyPod(ctx, pod, chaos, dnsServerIP)
+			return results.Inject(pod.Namespace, pod.Name, func() error {
+				return r.applyPod(ctx, pod, chaos, dnsServerIP)
+			})
 		})
 	}
 	err := g.Wait()
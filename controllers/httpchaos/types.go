@@ -42,12 +42,30 @@ func (r *endpoint) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1.I
 		return err
 	}
 
-	pods, err := utils.SelectAndFilterPods(ctx, r.Client, r.Reader, &httpFaultChaos.Spec)
+	seed := utils.NewSeed(httpFaultChaos.Spec.Seed)
+	httpFaultChaos.Status.Experiment.Seed = &seed
+	pods, err := utils.SelectAndFilterPods(ctx, r.Client, r.Reader, &httpFaultChaos.Spec, seed)
 	if err != nil {
 		r.Log.Error(err, "failed to select and filter pods")
 		return err
 	}
-	if err = r.applyAllPods(ctx, pods, httpFaultChaos); err != nil {
+	results := utils.NewInjectionResults(httpFaultChaos.Spec.FailurePolicy)
+	err = r.applyAllPods(ctx, pods, httpFaultChaos, results)
+
+	httpFaultChaos.Status.Experiment.PodRecords = make([]v1alpha1.PodStatus, 0, len(pods))
+	for _, pod := range pods {
+		ps := v1alpha1.PodStatus{
+			Namespace: pod.Namespace,
+			Name:      pod.Name,
+			HostIP:    pod.Status.HostIP,
+			PodIP:     pod.Status.PodIP,
+			Action:    string(httpFaultChaos.Spec.Action),
+		}
+
+		httpFaultChaos.Status.Experiment.PodRecords = append(httpFaultChaos.Status.Experiment.PodRecords, ps)
+	}
+	results.Fill(httpFaultChaos.Status.Experiment.PodRecords)
+	if err != nil {
 		r.Log.Error(err, "failed to apply chaos on all pods")
 		return err
 	}
@@ -69,7 +87,7 @@ func (r *endpoint) Object() v1alpha1.InnerObject {
 	return &v1alpha1.HTTPChaos{}
 }
 
-func (r *endpoint) applyAllPods(ctx context.Context, pods []v1.Pod, chaos *v1alpha1.HTTPChaos) error {
+func (r *endpoint) applyAllPods(ctx context.Context, pods []v1.Pod, chaos *v1alpha1.HTTPChaos, results *utils.InjectionResults) error {
 	g := errgroup.Group{}
 	for index := range pods {
 		pod := &pods[index]
@@ -81,7 +99,9 @@ func (r *endpoint) applyAllPods(ctx context.Context, pods []v1.Pod, chaos *v1alp
 		chaos.Finalizers = utils.InsertFinalizer(chaos.Finalizers, key)
 
 		g.Go(func() error {
-			return r.applyPod(ctx, pod, chaos)
+			return results.Inject(pod.Namespace, pod.Name, func() error {
+				return r.applyPod(ctx, pod, chaos)
+			})
 		})
 	}
 
@@ -26,6 +26,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/utils"
 )
 
 // PodIoManager will save all the related podnetworkchaos
@@ -35,6 +36,11 @@ type PodIoManager struct {
 	client.Client
 
 	Modifications map[types.NamespacedName]*PodIoTransaction
+
+	// Results records the result of the modification on each pod if it is set, and the failed modification
+	// is retried according to the failure policy. The result reflects the update of the PodIoChaos rather than
+	// the flush by chaos-daemon, a failed flush is only seen when the webhook of PodIoChaos rejects the update.
+	Results *utils.InjectionResults
 }
 
 // New creates a new PodIoMap
@@ -68,48 +74,56 @@ func (m *PodIoManager) Commit(ctx context.Context) error {
 		t := t
 		g.Go(func() error {
 			m.Log.Info("running modification on pod", "key", key, "modification", t)
-			updateError := retry.RetryOnConflict(retry.DefaultRetry, func() error {
-				chaos := &v1alpha1.PodIoChaos{}
+			update := func() error {
+				return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+					chaos := &v1alpha1.PodIoChaos{}
 
-				err := m.Client.Get(ctx, key, chaos)
-				if err != nil {
-					if !k8sError.IsNotFound(err) {
-						m.Log.Error(err, "error while getting podnetworkchaos")
-						return err
-					}
-
-					pod := v1.Pod{}
-					err = m.Client.Get(ctx, key, &pod)
+					err := m.Client.Get(ctx, key, chaos)
 					if err != nil {
-						m.Log.Error(err, "error while finding pod", "key", key)
+						if !k8sError.IsNotFound(err) {
+							m.Log.Error(err, "error while getting podnetworkchaos")
+							return err
+						}
+
+						pod := v1.Pod{}
+						err = m.Client.Get(ctx, key, &pod)
+						if err != nil {
+							m.Log.Error(err, "error while finding pod", "key", key)
+						}
+
+						chaos.Name = key.Name
+						chaos.Namespace = key.Namespace
+						chaos.OwnerReferences = []metav1.OwnerReference{
+							{
+								APIVersion: pod.APIVersion,
+								Kind:       pod.Kind,
+								Name:       pod.Name,
+								UID:        pod.UID,
+							},
+						}
+						err = m.Client.Create(ctx, chaos)
+
+						if err != nil {
+							m.Log.Error(err, "error while creating podnetworkchaos")
+							return err
+						}
 					}
 
-					chaos.Name = key.Name
-					chaos.Namespace = key.Namespace
-					chaos.OwnerReferences = []metav1.OwnerReference{
-						{
-							APIVersion: pod.APIVersion,
-							Kind:       pod.Kind,
-							Name:       pod.Name,
-							UID:        pod.UID,
-						},
-					}
-					err = m.Client.Create(ctx, chaos)
-
+					err = t.Apply(chaos)
 					if err != nil {
-						m.Log.Error(err, "error while creating podnetworkchaos")
+						m.Log.Error(err, "error while applying transactions", "transaction", t)
 						return err
 					}
-				}
-
-				err = t.Apply(chaos)
-				if err != nil {
-					m.Log.Error(err, "error while applying transactions", "transaction", t)
-					return err
-				}
 
-				return m.Client.Update(ctx, chaos)
-			})
+					return m.Client.Update(ctx, chaos)
+				})
+			}
+			var updateError error
+			if m.Results != nil {
+				updateError = m.Results.Inject(key.Namespace, key.Name, update)
+			} else {
+				updateError = update()
+			}
 			if updateError != nil {
 				m.Log.Error(updateError, "error while updating")
 				return updateError
@@ -52,8 +52,12 @@ func (r *endpoint) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1.I
 
 	source := iochaos.Namespace + "/" + iochaos.Name
 	m := podiochaosmanager.New(source, r.Log, r.Client)
+	results := utils.NewInjectionResults(iochaos.Spec.FailurePolicy)
+	m.Results = results
 
-	pods, err := utils.SelectAndFilterPods(ctx, r.Client, r.Reader, &iochaos.Spec)
+	seed := utils.NewSeed(iochaos.Spec.Seed)
+	iochaos.Status.Experiment.Seed = &seed
+	pods, err := utils.SelectAndFilterPods(ctx, r.Client, r.Reader, &iochaos.Spec, seed)
 	if err != nil {
 		r.Log.Error(err, "failed to select and filter pods")
 		return err
@@ -95,10 +99,6 @@ func (r *endpoint) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1.I
 	}
 	r.Log.Info("commiting updates of podiochaos")
 	err = m.Commit(ctx)
-	if err != nil {
-		r.Log.Error(err, "fail to commit")
-		return err
-	}
 
 	iochaos.Status.Experiment.PodRecords = make([]v1alpha1.PodStatus, 0, len(pods))
 	for _, pod := range pods {
@@ -112,6 +112,11 @@ func (r *endpoint) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1.I
 
 		iochaos.Status.Experiment.PodRecords = append(iochaos.Status.Experiment.PodRecords, ps)
 	}
+	results.Fill(iochaos.Status.Experiment.PodRecords)
+	if err != nil {
+		r.Log.Error(err, "fail to commit")
+		return err
+	}
 	r.Event(iochaos, v1.EventTypeNormal, utils.EventChaosInjected, "")
 
 	return nil
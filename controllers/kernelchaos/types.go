@@ -55,16 +55,16 @@ func (r *endpoint) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1.I
 		return err
 	}
 
-	pods, err := utils.SelectAndFilterPods(ctx, r.Client, r.Reader, &kernelChaos.Spec)
+	seed := utils.NewSeed(kernelChaos.Spec.Seed)
+	kernelChaos.Status.Experiment.Seed = &seed
+	pods, err := utils.SelectAndFilterPods(ctx, r.Client, r.Reader, &kernelChaos.Spec, seed)
 	if err != nil {
 		r.Log.Error(err, "failed to select and filter pods")
 		return err
 	}
 
-	if err = r.applyAllPods(ctx, pods, kernelChaos); err != nil {
-		r.Log.Error(err, "failed to apply chaos on all pods")
-		return err
-	}
+	results := utils.NewInjectionResults(kernelChaos.Spec.FailurePolicy)
+	err = r.applyAllPods(ctx, pods, kernelChaos, results)
 
 	kernelChaos.Status.Experiment.PodRecords = make([]v1alpha1.PodStatus, 0, len(pods))
 	for _, pod := range pods {
@@ -78,6 +78,11 @@ func (r *endpoint) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1.I
 
 		kernelChaos.Status.Experiment.PodRecords = append(kernelChaos.Status.Experiment.PodRecords, ps)
 	}
+	results.Fill(kernelChaos.Status.Experiment.PodRecords)
+	if err != nil {
+		r.Log.Error(err, "failed to apply chaos on all pods")
+		return err
+	}
 	r.Event(kernelChaos, v1.EventTypeNormal, utils.EventChaosInjected, "")
 
 	return nil
@@ -200,7 +205,7 @@ func (r *endpoint) Object() v1alpha1.InnerObject {
 	return &v1alpha1.KernelChaos{}
 }
 
-func (r *endpoint) applyAllPods(ctx context.Context, pods []v1.Pod, chaos *v1alpha1.KernelChaos) error {
+func (r *endpoint) applyAllPods(ctx context.Context, pods []v1.Pod, chaos *v1alpha1.KernelChaos, results *utils.InjectionResults) error {
 	g := errgroup.Group{}
 	for index := range pods {
 		pod := &pods[index]
@@ -212,7 +217,9 @@ func (r *endpoint) applyAllPods(ctx context.Context, pods []v1.Pod, chaos *v1alp
 		chaos.Finalizers = utils.InsertFinalizer(chaos.Finalizers, key)
 
 		g.Go(func() error {
-			return r.applyPod(ctx, pod, chaos)
+			return results.Inject(pod.Namespace, pod.Name, func() error {
+				return r.applyPod(ctx, pod, chaos)
+			})
 		})
 	}
 
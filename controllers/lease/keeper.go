@@ -0,0 +1,231 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lease
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"github.com/hashicorp/go-multierror"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
+	k8serror "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/common"
+	"github.com/chaos-mesh/chaos-mesh/controllers/podnetworkchaos"
+	"github.com/chaos-mesh/chaos-mesh/controllers/timechaos"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	"github.com/chaos-mesh/chaos-mesh/pkg/utils"
+)
+
+// Keeper renews the leases on the injections made by chaos-daemon periodically.
+// Once the controller manager is down, the leases will not be renewed any more,
+// and chaos-daemon recovers the tc rules, iptables chains and time offsets by itself.
+// They are flushed again by the keeper once the controller manager is back.
+type Keeper struct {
+	client.Client
+	Log logr.Logger
+}
+
+var _ manager.Runnable = &Keeper{}
+
+// Start renews the leases until the stop channel is closed. The leases are renewed
+// three times in a lease duration, so a failed renewal will not expire them.
+func (k *Keeper) Start(stop <-chan struct{}) error {
+	period := common.ControllerCfg.ChaosDaemonLeaseDuration / 3
+	if period <= 0 {
+		k.Log.Info("lease on chaos-daemon injections is disabled")
+		return nil
+	}
+
+	wait.Until(func() {
+		if err := k.Renew(context.Background()); err != nil {
+			k.Log.Error(err, "fail to renew leases")
+		}
+	}, period, stop)
+	return nil
+}
+
+// Renew extends the leases on all pods with tc rules, iptables chains or time offsets,
+// and flushes the injections again on the pods whose leases have expired
+func (k *Keeper) Renew(ctx context.Context) error {
+	pods, err := k.leasedPods(ctx)
+	if err != nil {
+		return err
+	}
+
+	deadline := utils.LeaseDeadline()
+	var result error
+	for _, key := range pods {
+		expired, err := k.renewPod(ctx, key, deadline)
+		if err != nil {
+			result = multierror.Append(result, err)
+			continue
+		}
+		if expired {
+			if err := k.reflush(ctx, key); err != nil {
+				result = multierror.Append(result, err)
+			}
+		}
+	}
+	return result
+}
+
+func (k *Keeper) leasedPods(ctx context.Context) ([]types.NamespacedName, error) {
+	var pods []types.NamespacedName
+	seen := make(map[types.NamespacedName]bool)
+	add := func(key types.NamespacedName) {
+		if !seen[key] {
+			seen[key] = true
+			pods = append(pods, key)
+		}
+	}
+
+	var networkChaos v1alpha1.PodNetworkChaosList
+	if err := k.List(ctx, &networkChaos); err != nil {
+		return nil, err
+	}
+	for _, chaos := range networkChaos.Items {
+		if len(chaos.Spec.TrafficControls) == 0 && len(chaos.Spec.Iptables) == 0 {
+			continue
+		}
+		// PodNetworkChaos has the same namespace and name as the pod
+		add(types.NamespacedName{Namespace: chaos.Namespace, Name: chaos.Name})
+	}
+
+	var timeChaos v1alpha1.TimeChaosList
+	if err := k.List(ctx, &timeChaos); err != nil {
+		return nil, err
+	}
+	for _, chaos := range timeChaos.Items {
+		for _, key := range chaos.Finalizers {
+			ns, name, err := cache.SplitMetaNamespaceKey(key)
+			if err != nil {
+				return nil, err
+			}
+			add(types.NamespacedName{Namespace: ns, Name: name})
+		}
+	}
+
+	return pods, nil
+}
+
+// renewPod extends the lease on the pod, and returns whether the lease has expired on chaos-daemon
+func (k *Keeper) renewPod(ctx context.Context, key types.NamespacedName, deadline int64) (bool, error) {
+	var pod v1.Pod
+	if err := k.Get(ctx, key, &pod); err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+
+	pbClient, err := utils.NewChaosDaemonClient(ctx, k.Client, &pod, common.ControllerCfg.ChaosDaemonPort)
+	if err != nil {
+		return false, err
+	}
+	defer pbClient.Close()
+
+	renewed := 0
+	for _, container := range pod.Status.ContainerStatuses {
+		_, err := pbClient.RenewLease(ctx, &pb.LeaseRequest{
+			ContainerId:   container.ContainerID,
+			LeaseDeadline: deadline,
+		})
+		if status.Code(err) == codes.NotFound {
+			// there is no injection on this container
+			continue
+		}
+		if err != nil {
+			return false, err
+		}
+		renewed++
+	}
+
+	if renewed == 0 {
+		k.Log.Info("no lease is held by pod, the injections recovered by chaos-daemon will be flushed again", "pod", key)
+		return true, nil
+	}
+	return false, nil
+}
+
+// reflush applies the injections on the pod again, after chaos-daemon recovered them because
+// the lease was not renewed in time, e.g. the controller manager was down for a lease duration
+func (k *Keeper) reflush(ctx context.Context, key types.NamespacedName) error {
+	networkChaos, timeChaos, err := k.expiredInjections(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	var result error
+	if networkChaos != nil {
+		handler := &podnetworkchaos.Handler{
+			Client: k.Client,
+			Log:    k.Log,
+		}
+		if err := handler.Apply(ctx, networkChaos); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if len(timeChaos) > 0 {
+		var pod v1.Pod
+		if err := k.Get(ctx, key, &pod); err != nil {
+			return multierror.Append(result, err)
+		}
+		for i := range timeChaos {
+			if err := timechaos.Reflush(ctx, k.Client, k.Log, &pod, &timeChaos[i]); err != nil {
+				result = multierror.Append(result, err)
+			}
+		}
+	}
+
+	return result
+}
+
+// expiredInjections returns the PodNetworkChaos and the TimeChaos whose injections on the pod
+// should be flushed again
+func (k *Keeper) expiredInjections(ctx context.Context, key types.NamespacedName) (*v1alpha1.PodNetworkChaos, []v1alpha1.TimeChaos, error) {
+	var networkChaos *v1alpha1.PodNetworkChaos
+	var chaos v1alpha1.PodNetworkChaos
+	err := k.Get(ctx, key, &chaos)
+	if err != nil && !k8serror.IsNotFound(err) {
+		return nil, nil, err
+	}
+	if err == nil && (len(chaos.Spec.TrafficControls) > 0 || len(chaos.Spec.Iptables) > 0) {
+		networkChaos = &chaos
+	}
+
+	var timeChaosList v1alpha1.TimeChaosList
+	if err := k.List(ctx, &timeChaosList); err != nil {
+		return nil, nil, err
+	}
+	// the finalizers of the TimeChaos are the keys of the pods on which the time is shifted
+	podKey := key.String()
+	var timeChaos []v1alpha1.TimeChaos
+	for _, chaos := range timeChaosList.Items {
+		for _, finalizer := range chaos.Finalizers {
+			if finalizer == podKey {
+				timeChaos = append(timeChaos, chaos)
+				break
+			}
+		}
+	}
+
+	return networkChaos, timeChaos, nil
+}
@@ -0,0 +1,151 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lease
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func newPod(name string, containerIDs ...string) *v1.Pod {
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: metav1.NamespaceDefault,
+		},
+	}
+	for _, id := range containerIDs {
+		pod.Status.ContainerStatuses = append(pod.Status.ContainerStatuses, v1.ContainerStatus{ContainerID: id})
+	}
+	return pod
+}
+
+func TestKeeperLeasedPods(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+	g.Expect(v1alpha1.AddToScheme(scheme)).To(Succeed())
+
+	objects := []runtime.Object{
+		newPod("network", "docker://network-0", "docker://network-1"),
+		newPod("idle", "docker://idle-0"),
+		newPod("time", "docker://time-0"),
+		&v1alpha1.PodNetworkChaos{
+			ObjectMeta: metav1.ObjectMeta{Name: "network", Namespace: metav1.NamespaceDefault},
+			Spec: v1alpha1.PodNetworkChaosSpec{
+				TrafficControls: []v1alpha1.RawTrafficControl{{Type: v1alpha1.Netem}},
+			},
+		},
+		&v1alpha1.PodNetworkChaos{
+			ObjectMeta: metav1.ObjectMeta{Name: "idle", Namespace: metav1.NamespaceDefault},
+		},
+		&v1alpha1.TimeChaos{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "time-chaos",
+				Namespace:  metav1.NamespaceDefault,
+				Finalizers: []string{"default/time", "default/deleted"},
+			},
+		},
+	}
+
+	keeper := &Keeper{
+		Client: fake.NewFakeClientWithScheme(scheme, objects...),
+		Log:    ctrl.Log.WithName("lease-keeper"),
+	}
+	pods, err := keeper.leasedPods(context.TODO())
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(pods).To(ConsistOf(
+		types.NamespacedName{Namespace: metav1.NamespaceDefault, Name: "network"},
+		types.NamespacedName{Namespace: metav1.NamespaceDefault, Name: "time"},
+		types.NamespacedName{Namespace: metav1.NamespaceDefault, Name: "deleted"},
+	))
+}
+
+func TestKeeperExpiredInjections(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+	g.Expect(v1alpha1.AddToScheme(scheme)).To(Succeed())
+
+	newTimeChaos := func(name string, finalizers ...string) *v1alpha1.TimeChaos {
+		return &v1alpha1.TimeChaos{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       name,
+				Namespace:  metav1.NamespaceDefault,
+				Finalizers: finalizers,
+			},
+		}
+	}
+	objects := []runtime.Object{
+		newPod("network", "docker://network-0"),
+		newPod("idle", "docker://idle-0"),
+		newPod("time", "docker://time-0"),
+		&v1alpha1.PodNetworkChaos{
+			ObjectMeta: metav1.ObjectMeta{Name: "network", Namespace: metav1.NamespaceDefault},
+			Spec: v1alpha1.PodNetworkChaosSpec{
+				Iptables: []v1alpha1.RawIptables{{Name: "chain", Direction: v1alpha1.Input}},
+			},
+		},
+		&v1alpha1.PodNetworkChaos{
+			ObjectMeta: metav1.ObjectMeta{Name: "idle", Namespace: metav1.NamespaceDefault},
+		},
+		newTimeChaos("time-chaos", "default/time", "default/network"),
+		newTimeChaos("other-time-chaos", "default/time"),
+		newTimeChaos("recovered-time-chaos"),
+	}
+
+	keeper := &Keeper{
+		Client: fake.NewFakeClientWithScheme(scheme, objects...),
+		Log:    ctrl.Log.WithName("lease-keeper"),
+	}
+	key := func(name string) types.NamespacedName {
+		return types.NamespacedName{Namespace: metav1.NamespaceDefault, Name: name}
+	}
+	names := func(chaos []v1alpha1.TimeChaos) []string {
+		var names []string
+		for _, c := range chaos {
+			names = append(names, c.Name)
+		}
+		return names
+	}
+
+	networkChaos, timeChaos, err := keeper.expiredInjections(context.TODO(), key("network"))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(networkChaos).ToNot(BeNil())
+	g.Expect(networkChaos.Spec.Iptables).To(HaveLen(1))
+	g.Expect(names(timeChaos)).To(ConsistOf("time-chaos"))
+
+	networkChaos, timeChaos, err = keeper.expiredInjections(context.TODO(), key("time"))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(networkChaos).To(BeNil())
+	g.Expect(names(timeChaos)).To(ConsistOf("time-chaos", "other-time-chaos"))
+
+	networkChaos, timeChaos, err = keeper.expiredInjections(context.TODO(), key("idle"))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(networkChaos).To(BeNil())
+	g.Expect(timeChaos).To(BeEmpty())
+}
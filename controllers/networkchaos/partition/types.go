@@ -68,21 +68,110 @@ func (e *endpoint) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1.I
 
 	source := networkchaos.Namespace + "/" + networkchaos.Name
 	m := podnetworkmanager.New(source, e.Log, e.Client, e.Reader)
+	results := utils.NewInjectionResults(networkchaos.Spec.FailurePolicy)
+	m.Results = results
 
-	sources, err := utils.SelectAndFilterPods(ctx, e.Client, e.Reader, &networkchaos.Spec)
+	allPods, err := e.prepare(ctx, m, networkchaos)
+	if err != nil {
+		return err
+	}
+
+	err = m.Commit(ctx)
 
+	networkchaos.Status.Experiment.PodRecords = make([]v1alpha1.PodStatus, 0, len(allPods))
+	for _, pod := range allPods {
+		ps := v1alpha1.PodStatus{
+			Namespace: pod.Namespace,
+			Name:      pod.Name,
+			HostIP:    pod.Status.HostIP,
+			PodIP:     pod.Status.PodIP,
+			Action:    string(networkchaos.Spec.Action),
+		}
+
+		if networkchaos.Spec.Duration != nil {
+			ps.Message = fmt.Sprintf(networkPartitionActionMsg, *networkchaos.Spec.Duration)
+		}
+
+		networkchaos.Status.Experiment.PodRecords = append(networkchaos.Status.Experiment.PodRecords, ps)
+	}
+	results.Fill(networkchaos.Status.Experiment.PodRecords)
 	if err != nil {
-		e.Log.Error(err, "failed to select and filter pods")
+		// if pod is not found or not running, don't print error log and wait next time.
+		if err != podnetworkmanager.ErrPodNotFound && err != podnetworkmanager.ErrPodNotRunning {
+			e.Log.Error(err, "fail to commit")
+		}
 		return err
 	}
 
+	e.Event(networkchaos, v1.EventTypeNormal, utils.EventChaosInjected, "")
+	return nil
+}
+
+// SetChains sets iptables chains for pods
+func (e *endpoint) SetChains(ctx context.Context, pods []v1.Pod, chains []v1alpha1.RawIptables, m *podnetworkmanager.PodNetworkManager, networkchaos *v1alpha1.NetworkChaos) error {
+	for index := range pods {
+		pod := &pods[index]
+
+		key, err := cache.MetaNamespaceKeyFunc(pod)
+		if err != nil {
+			return err
+		}
+
+		t := m.WithInit(types.NamespacedName{
+			Name:      pod.Name,
+			Namespace: pod.Namespace,
+		})
+		for _, chain := range chains {
+			t.Append(chain)
+		}
+
+		networkchaos.Finalizers = utils.InsertFinalizer(networkchaos.Finalizers, key)
+
+	}
+	return nil
+}
+
+// DryRun implements the end.DryRunner.DryRun
+func (e *endpoint) DryRun(ctx context.Context, req ctrl.Request, chaos v1alpha1.InnerObject) ([]v1alpha1.DryRunTarget, error) {
+	networkchaos, ok := chaos.(*v1alpha1.NetworkChaos)
+	if !ok {
+		err := errors.New("chaos is not NetworkChaos")
+		e.Log.Error(err, "chaos is not NetworkChaos", "chaos", chaos)
+
+		return nil, err
+	}
+
+	source := networkchaos.Namespace + "/" + networkchaos.Name
+	m := podnetworkmanager.New(source, e.Log, e.Client, e.Reader)
+
+	allPods, err := e.prepare(ctx, m, networkchaos)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.DryRun(ctx, allPods)
+}
+
+// prepare selects the pods and records the ipsets and the chains on them in m, it returns all the selected pods
+func (e *endpoint) prepare(ctx context.Context, m *podnetworkmanager.PodNetworkManager, networkchaos *v1alpha1.NetworkChaos) ([]v1.Pod, error) {
+	source := m.Source
+
+	seed := utils.NewSeed(networkchaos.Spec.Seed)
+	networkchaos.Status.Experiment.Seed = &seed
+	sources, err := utils.SelectAndFilterPods(ctx, e.Client, e.Reader, &networkchaos.Spec, seed)
+
+	if err != nil {
+		e.Log.Error(err, "failed to select and filter pods")
+		return nil, err
+	}
+
 	var targets []v1.Pod
 
 	if networkchaos.Spec.Target != nil {
-		targets, err = utils.SelectAndFilterPods(ctx, e.Client, e.Reader, networkchaos.Spec.Target)
+		targets, err = utils.SelectAndFilterPods(ctx, e.Client, e.Reader, networkchaos.Spec.Target, utils.TargetSeed(seed))
 		if err != nil {
 			e.Log.Error(err, "failed to select and filter pods")
-			return err
+			return nil, err
 		}
 	}
 
@@ -90,7 +179,7 @@ func (e *endpoint) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1.I
 	externalCidrs, err := netutils.ResolveCidrs(networkchaos.Spec.ExternalTargets)
 	if err != nil {
 		e.Log.Error(err, "failed to resolve external targets")
-		return err
+		return nil, err
 	}
 	targetSet := ipset.BuildIPSet(targets, externalCidrs, networkchaos, targetIPSetPostFix, source)
 
@@ -155,66 +244,15 @@ func (e *endpoint) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1.I
 
 	err = e.SetChains(ctx, sources, sourcesChains, m, networkchaos)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	err = e.SetChains(ctx, targets, targetsChains, m, networkchaos)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	err = m.Commit(ctx)
-	if err != nil {
-		// if pod is not found or not running, don't print error log and wait next time.
-		if err != podnetworkmanager.ErrPodNotFound && err != podnetworkmanager.ErrPodNotRunning {
-			e.Log.Error(err, "fail to commit")
-		}
-		return err
-	}
-
-	networkchaos.Status.Experiment.PodRecords = make([]v1alpha1.PodStatus, 0, len(allPods))
-	for _, pod := range allPods {
-		ps := v1alpha1.PodStatus{
-			Namespace: pod.Namespace,
-			Name:      pod.Name,
-			HostIP:    pod.Status.HostIP,
-			PodIP:     pod.Status.PodIP,
-			Action:    string(networkchaos.Spec.Action),
-		}
-
-		if networkchaos.Spec.Duration != nil {
-			ps.Message = fmt.Sprintf(networkPartitionActionMsg, *networkchaos.Spec.Duration)
-		}
-
-		networkchaos.Status.Experiment.PodRecords = append(networkchaos.Status.Experiment.PodRecords, ps)
-	}
-
-	e.Event(networkchaos, v1.EventTypeNormal, utils.EventChaosInjected, "")
-	return nil
-}
-
-// SetChains sets iptables chains for pods
-func (e *endpoint) SetChains(ctx context.Context, pods []v1.Pod, chains []v1alpha1.RawIptables, m *podnetworkmanager.PodNetworkManager, networkchaos *v1alpha1.NetworkChaos) error {
-	for index := range pods {
-		pod := &pods[index]
-
-		key, err := cache.MetaNamespaceKeyFunc(pod)
-		if err != nil {
-			return err
-		}
-
-		t := m.WithInit(types.NamespacedName{
-			Name:      pod.Name,
-			Namespace: pod.Namespace,
-		})
-		for _, chain := range chains {
-			t.Append(chain)
-		}
-
-		networkchaos.Finalizers = utils.InsertFinalizer(networkchaos.Finalizers, key)
-
-	}
-	return nil
+	return allPods, nil
 }
 
 // Recover recovers the chaos
@@ -27,6 +27,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/podnetworkchaos"
+	"github.com/chaos-mesh/chaos-mesh/pkg/utils"
 )
 
 var (
@@ -46,6 +48,11 @@ type PodNetworkManager struct {
 	client.Reader
 
 	Modifications map[types.NamespacedName]*PodNetworkTransaction
+
+	// Results records the result of the modification on each pod if it is set, and the failed modification
+	// is retried according to the failure policy. The result reflects the update of the PodNetworkChaos rather than
+	// the flush by chaos-daemon, a failed flush is only seen when the webhook of PodNetworkChaos rejects the update.
+	Results *utils.InjectionResults
 }
 
 // New creates a new PodNetworkMap
@@ -80,61 +87,69 @@ func (m *PodNetworkManager) Commit(ctx context.Context) error {
 		t := t
 		g.Go(func() error {
 			m.Log.Info("running modification on pod", "key", key, "modification", t)
-			updateError := retry.RetryOnConflict(retry.DefaultRetry, func() error {
-				chaos := &v1alpha1.PodNetworkChaos{}
-
-				err := m.Client.Get(ctx, key, chaos)
-				if err != nil {
-					if !k8sError.IsNotFound(err) {
-						m.Log.Error(err, "error while getting podnetworkchaos")
-						return err
-					}
+			update := func() error {
+				return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+					chaos := &v1alpha1.PodNetworkChaos{}
 
-					pod := v1.Pod{}
-					err = m.Client.Get(ctx, key, &pod)
+					err := m.Client.Get(ctx, key, chaos)
 					if err != nil {
 						if !k8sError.IsNotFound(err) {
-							m.Log.Error(err, "error while finding pod")
+							m.Log.Error(err, "error while getting podnetworkchaos")
 							return err
 						}
 
-						m.Log.Info("pod not found", "key", key, "error", err.Error())
-						err = ErrPodNotFound
-						return err
-					}
+						pod := v1.Pod{}
+						err = m.Client.Get(ctx, key, &pod)
+						if err != nil {
+							if !k8sError.IsNotFound(err) {
+								m.Log.Error(err, "error while finding pod")
+								return err
+							}
 
-					if pod.Status.Phase != v1.PodRunning {
-						m.Log.Info("pod is not running", "key", key)
-						err = ErrPodNotRunning
-						return err
-					}
+							m.Log.Info("pod not found", "key", key, "error", err.Error())
+							err = ErrPodNotFound
+							return err
+						}
+
+						if pod.Status.Phase != v1.PodRunning {
+							m.Log.Info("pod is not running", "key", key)
+							err = ErrPodNotRunning
+							return err
+						}
 
-					chaos.Name = key.Name
-					chaos.Namespace = key.Namespace
-					chaos.OwnerReferences = []metav1.OwnerReference{
-						{
-							APIVersion: pod.APIVersion,
-							Kind:       pod.Kind,
-							Name:       pod.Name,
-							UID:        pod.UID,
-						},
+						chaos.Name = key.Name
+						chaos.Namespace = key.Namespace
+						chaos.OwnerReferences = []metav1.OwnerReference{
+							{
+								APIVersion: pod.APIVersion,
+								Kind:       pod.Kind,
+								Name:       pod.Name,
+								UID:        pod.UID,
+							},
+						}
+						err = m.Client.Create(ctx, chaos)
+
+						if err != nil {
+							m.Log.Error(err, "error while creating podnetworkchaos")
+							return err
+						}
 					}
-					err = m.Client.Create(ctx, chaos)
 
+					err = t.Apply(chaos)
 					if err != nil {
-						m.Log.Error(err, "error while creating podnetworkchaos")
+						m.Log.Error(err, "error while applying transactions", "transaction", t)
 						return err
 					}
-				}
-
-				err = t.Apply(chaos)
-				if err != nil {
-					m.Log.Error(err, "error while applying transactions", "transaction", t)
-					return err
-				}
 
-				return m.Client.Update(ctx, chaos)
-			})
+					return m.Client.Update(ctx, chaos)
+				})
+			}
+			var updateError error
+			if m.Results != nil {
+				updateError = m.Results.Inject(key.Namespace, key.Name, update)
+			} else {
+				updateError = update()
+			}
 			if updateError != nil {
 				if updateError != ErrPodNotFound && updateError != ErrPodNotRunning {
 					m.Log.Error(updateError, "error while updating")
@@ -150,3 +165,51 @@ func (m *PodNetworkManager) Commit(ctx context.Context) error {
 
 	return g.Wait()
 }
+
+// DryRun returns the pods with their network configuration after the modifications,
+// the podnetworkchaos are not updated
+func (m *PodNetworkManager) DryRun(ctx context.Context, pods []v1.Pod) ([]v1alpha1.DryRunTarget, error) {
+	targets := make([]v1alpha1.DryRunTarget, 0, len(pods))
+	visited := make(map[types.NamespacedName]bool, len(pods))
+	for index := range pods {
+		pod := &pods[index]
+		key := types.NamespacedName{Namespace: pod.Namespace, Name: pod.Name}
+		if visited[key] {
+			continue
+		}
+		visited[key] = true
+
+		target := v1alpha1.DryRunTarget{
+			Kind:      "Pod",
+			Namespace: pod.Namespace,
+			Name:      pod.Name,
+		}
+
+		t, ok := m.Modifications[key]
+		if !ok {
+			targets = append(targets, target)
+			continue
+		}
+
+		chaos := &v1alpha1.PodNetworkChaos{}
+		err := m.Client.Get(ctx, key, chaos)
+		if err != nil && !k8sError.IsNotFound(err) {
+			m.Log.Error(err, "error while getting podnetworkchaos")
+			return nil, err
+		}
+
+		err = t.Apply(chaos)
+		if err != nil {
+			m.Log.Error(err, "error while applying transactions", "transaction", t)
+			return nil, err
+		}
+
+		target.Network = &chaos.Spec
+		if err := podnetworkchaos.Check(pod, &chaos.Spec); err != nil {
+			target.Error = err.Error()
+		}
+		targets = append(targets, target)
+	}
+
+	return targets, nil
+}
@@ -62,21 +62,82 @@ func (r *endpoint) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1.I
 
 	source := networkchaos.Namespace + "/" + networkchaos.Name
 	m := podnetworkmanager.New(source, r.Log, r.Client, r.Reader)
+	results := utils.NewInjectionResults(networkchaos.Spec.FailurePolicy)
+	m.Results = results
 
-	sources, err := utils.SelectAndFilterPods(ctx, r.Client, r.Reader, &networkchaos.Spec)
+	pods, err := r.prepare(ctx, m, networkchaos)
 	if err != nil {
-		r.Log.Error(err, "failed to select and filter pods")
 		return err
 	}
 
+	err = m.Commit(ctx)
+
+	networkchaos.Status.Experiment.PodRecords = make([]v1alpha1.PodStatus, 0, len(pods))
+	for _, pod := range pods {
+		ps := v1alpha1.PodStatus{
+			Namespace: pod.Namespace,
+			Name:      pod.Name,
+			HostIP:    pod.Status.HostIP,
+			PodIP:     pod.Status.PodIP,
+			Action:    string(networkchaos.Spec.Action),
+		}
+
+		if networkchaos.Spec.Duration != nil {
+			ps.Message = fmt.Sprintf(networkTcActionMsg, *networkchaos.Spec.Duration)
+		}
+
+		networkchaos.Status.Experiment.PodRecords = append(networkchaos.Status.Experiment.PodRecords, ps)
+	}
+	results.Fill(networkchaos.Status.Experiment.PodRecords)
+	if err != nil {
+		// if pod is not found or not running, don't print error log and wait next time.
+		if err != podnetworkmanager.ErrPodNotFound && err != podnetworkmanager.ErrPodNotRunning {
+			r.Log.Error(err, "fail to commit")
+		}
+		return err
+	}
+	r.Event(networkchaos, v1.EventTypeNormal, utils.EventChaosInjected, "")
+	return nil
+}
+
+// DryRun implements the end.DryRunner.DryRun
+func (r *endpoint) DryRun(ctx context.Context, req ctrl.Request, chaos v1alpha1.InnerObject) ([]v1alpha1.DryRunTarget, error) {
+	networkchaos, ok := chaos.(*v1alpha1.NetworkChaos)
+	if !ok {
+		err := errors.New("chaos is not NetworkChaos")
+		r.Log.Error(err, "chaos is not NetworkChaos", "chaos", chaos)
+		return nil, err
+	}
+
+	source := networkchaos.Namespace + "/" + networkchaos.Name
+	m := podnetworkmanager.New(source, r.Log, r.Client, r.Reader)
+
+	pods, err := r.prepare(ctx, m, networkchaos)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.DryRun(ctx, pods)
+}
+
+// prepare selects the pods and records the traffic control on them in m, it returns all the selected pods
+func (r *endpoint) prepare(ctx context.Context, m *podnetworkmanager.PodNetworkManager, networkchaos *v1alpha1.NetworkChaos) ([]v1.Pod, error) {
+	seed := utils.NewSeed(networkchaos.Spec.Seed)
+	networkchaos.Status.Experiment.Seed = &seed
+	sources, err := utils.SelectAndFilterPods(ctx, r.Client, r.Reader, &networkchaos.Spec, seed)
+	if err != nil {
+		r.Log.Error(err, "failed to select and filter pods")
+		return nil, err
+	}
+
 	var targets []v1.Pod
 
 	// We should only apply filter when we specify targets
 	if networkchaos.Spec.Target != nil {
-		targets, err = utils.SelectAndFilterPods(ctx, r.Client, r.Reader, networkchaos.Spec.Target)
+		targets, err = utils.SelectAndFilterPods(ctx, r.Client, r.Reader, networkchaos.Spec.Target, utils.TargetSeed(seed))
 		if err != nil {
 			r.Log.Error(err, "failed to select and filter pods")
-			return err
+			return nil, err
 		}
 	}
 
@@ -85,7 +146,7 @@ func (r *endpoint) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1.I
 	externalCidrs, err := netutils.ResolveCidrs(networkchaos.Spec.ExternalTargets)
 	if err != nil {
 		r.Log.Error(err, "failed to resolve external targets")
-		return err
+		return nil, err
 	}
 
 	switch networkchaos.Spec.Direction {
@@ -93,53 +154,27 @@ func (r *endpoint) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1.I
 		err = r.applyTc(ctx, sources, targets, externalCidrs, m, networkchaos)
 		if err != nil {
 			r.Log.Error(err, "failed to apply traffic control", "sources", sources, "targets", targets)
-			return err
+			return nil, err
 		}
 	case v1alpha1.From:
 		err = r.applyTc(ctx, targets, sources, []string{}, m, networkchaos)
 		if err != nil {
 			r.Log.Error(err, "failed to apply traffic control", "sources", targets, "targets", sources)
-			return err
+			return nil, err
 		}
 	case v1alpha1.Both:
 		err = r.applyTc(ctx, pods, pods, externalCidrs, m, networkchaos)
 		if err != nil {
 			r.Log.Error(err, "failed to apply traffic control", "sources", pods, "targets", pods)
-			return err
+			return nil, err
 		}
 	default:
 		err = fmt.Errorf("unknown direction %s", networkchaos.Spec.Direction)
 		r.Log.Error(err, "unknown direction", "direction", networkchaos.Spec.Direction)
-		return err
-	}
-
-	err = m.Commit(ctx)
-	if err != nil {
-		// if pod is not found or not running, don't print error log and wait next time.
-		if err != podnetworkmanager.ErrPodNotFound && err != podnetworkmanager.ErrPodNotRunning {
-			r.Log.Error(err, "fail to commit")
-		}
-		return err
+		return nil, err
 	}
 
-	networkchaos.Status.Experiment.PodRecords = make([]v1alpha1.PodStatus, 0, len(pods))
-	for _, pod := range pods {
-		ps := v1alpha1.PodStatus{
-			Namespace: pod.Namespace,
-			Name:      pod.Name,
-			HostIP:    pod.Status.HostIP,
-			PodIP:     pod.Status.PodIP,
-			Action:    string(networkchaos.Spec.Action),
-		}
-
-		if networkchaos.Spec.Duration != nil {
-			ps.Message = fmt.Sprintf(networkTcActionMsg, *networkchaos.Spec.Duration)
-		}
-
-		networkchaos.Status.Experiment.PodRecords = append(networkchaos.Status.Experiment.PodRecords, ps)
-	}
-	r.Event(networkchaos, v1.EventTypeNormal, utils.EventChaosInjected, "")
-	return nil
+	return pods, nil
 }
 
 // Recover implements the reconciler.InnerReconciler.Recover
@@ -0,0 +1,417 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodechaos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"golang.org/x/sync/errgroup"
+	v1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	k8serror "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/common"
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	"github.com/chaos-mesh/chaos-mesh/pkg/router"
+	ctx "github.com/chaos-mesh/chaos-mesh/pkg/router/context"
+	end "github.com/chaos-mesh/chaos-mesh/pkg/router/endpoint"
+	"github.com/chaos-mesh/chaos-mesh/pkg/utils"
+)
+
+const (
+	nodeChaosMsg = "%s on node %s"
+
+	// nodeChaosAnnotationPrefix is the prefix of the annotations recording the changes
+	// made on the node by a node chaos, the full key is suffixed by the UID of the chaos
+	nodeChaosAnnotationPrefix = "nodechaos.chaos-mesh.org/"
+
+	// cordonRecord records the node is cordoned by the chaos
+	cordonRecord = "cordon"
+	// kubeletRecord records the kubelet is stopped by the chaos
+	kubeletRecord = "kubelet"
+	// taintRecordPrefix is the prefix of the record of the taint added by the chaos
+	taintRecordPrefix = "taint:"
+)
+
+var kubernetesNewForConfig = func() (kubernetes.Interface, error) {
+	config, err := ctrl.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+	return kubernetes.NewForConfig(config)
+}
+
+// endpoint is nodechaos reconciler
+type endpoint struct {
+	ctx.Context
+}
+
+// Apply applies node-chaos
+func (r *endpoint) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1.InnerObject) error {
+	nodechaos, ok := chaos.(*v1alpha1.NodeChaos)
+	if !ok {
+		err := errors.New("chaos is not nodechaos")
+		r.Log.Error(err, "chaos is not NodeChaos", "chaos", chaos)
+		return err
+	}
+
+	if !common.ControllerCfg.ClusterScoped {
+		err := utils.ErrNodesNotClusterScoped
+		r.Log.Error(err, "node chaos is not allowed in the namespace scoped mode")
+		return err
+	}
+
+	seed := utils.NewSeed(nodechaos.Spec.Seed)
+	nodechaos.Status.Experiment.Seed = &seed
+	nodes, err := utils.SelectAndFilterNodes(ctx, r.Client, &nodechaos.Spec, seed)
+	if err != nil {
+		r.Log.Error(err, "failed to select and filter nodes")
+		return err
+	}
+
+	results := utils.NewInjectionResults(nodechaos.Spec.FailurePolicy)
+	err = r.applyAllNodes(ctx, nodes, nodechaos, results)
+
+	nodechaos.Status.Experiment.PodRecords = make([]v1alpha1.PodStatus, 0, len(nodes))
+	for _, node := range nodes {
+		ps := v1alpha1.PodStatus{
+			Name:    node.Name,
+			HostIP:  nodeAddress(&node),
+			Action:  string(nodechaos.Spec.Action),
+			Message: fmt.Sprintf(nodeChaosMsg, nodechaos.Spec.Action, node.Name),
+		}
+
+		nodechaos.Status.Experiment.PodRecords = append(nodechaos.Status.Experiment.PodRecords, ps)
+	}
+	results.Fill(nodechaos.Status.Experiment.PodRecords)
+	if err != nil {
+		r.Log.Error(err, "failed to apply chaos on all nodes")
+		return err
+	}
+	r.Event(nodechaos, v1.EventTypeNormal, utils.EventChaosInjected, "")
+	return nil
+}
+
+// Recover means the reconciler recovers the chaos action
+func (r *endpoint) Recover(ctx context.Context, req ctrl.Request, chaos v1alpha1.InnerObject) error {
+	nodechaos, ok := chaos.(*v1alpha1.NodeChaos)
+	if !ok {
+		err := errors.New("chaos is not NodeChaos")
+		r.Log.Error(err, "chaos is not NodeChaos", "chaos", chaos)
+		return err
+	}
+
+	if err := r.cleanFinalizersAndRecover(ctx, nodechaos); err != nil {
+		return err
+	}
+	r.Event(nodechaos, v1.EventTypeNormal, utils.EventChaosRecovered, "")
+
+	return nil
+}
+
+func (r *endpoint) cleanFinalizersAndRecover(ctx context.Context, chaos *v1alpha1.NodeChaos) error {
+	var result error
+
+	for _, key := range chaos.Finalizers {
+		_, name, err := cache.SplitMetaNamespaceKey(key)
+		if err != nil {
+			result = multierror.Append(result, err)
+			continue
+		}
+
+		var node v1.Node
+		err = r.Client.Get(ctx, types.NamespacedName{
+			Name: name,
+		}, &node)
+
+		if err != nil {
+			if !k8serror.IsNotFound(err) {
+				result = multierror.Append(result, err)
+				continue
+			}
+
+			r.Log.Info("Node not found", "name", name)
+			chaos.Finalizers = utils.RemoveFromFinalizer(chaos.Finalizers, key)
+			continue
+		}
+
+		err = r.recoverNode(ctx, &node, chaos)
+		if err != nil {
+			result = multierror.Append(result, err)
+			continue
+		}
+
+		chaos.Finalizers = utils.RemoveFromFinalizer(chaos.Finalizers, key)
+	}
+
+	if chaos.Annotations[common.AnnotationCleanFinalizer] == common.AnnotationCleanFinalizerForced {
+		r.Log.Info("Force cleanup all finalizers", "chaos", chaos)
+		chaos.Finalizers = chaos.Finalizers[:0]
+		return nil
+	}
+
+	return result
+}
+
+// recoverNode undoes the change recorded on the node by the chaos,
+// the change is kept if another node chaos still records the same change
+func (r *endpoint) recoverNode(ctx context.Context, node *v1.Node, chaos *v1alpha1.NodeChaos) error {
+	r.Log.Info("Try to recover node", "name", node.Name)
+
+	annotationKey := recordAnnotationKey(chaos)
+	record, ok := node.Annotations[annotationKey]
+	if !ok {
+		return nil
+	}
+
+	if record == kubeletRecord && !hasOtherRecord(node, annotationKey, record) {
+		pbClient, err := utils.NewChaosDaemonClientForNode(ctx, r.Client, node.Name, common.ControllerCfg.ChaosDaemonPort)
+		if err != nil {
+			return err
+		}
+		defer pbClient.Close()
+
+		if _, err = pbClient.StartKubelet(ctx, &pb.KubeletRequest{
+			Service: chaos.Spec.GetKubeletService(),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return r.updateNode(ctx, node.Name, func(node *v1.Node) {
+		if !hasOtherRecord(node, annotationKey, record) {
+			switch {
+			case record == cordonRecord:
+				node.Spec.Unschedulable = false
+			case strings.HasPrefix(record, taintRecordPrefix):
+				taint := chaos.Spec.GetTaint()
+				node.Spec.Taints = removeTaint(node.Spec.Taints, &taint)
+			}
+		}
+		delete(node.Annotations, annotationKey)
+	})
+}
+
+// Object would return the instance of chaos
+func (r *endpoint) Object() v1alpha1.InnerObject {
+	return &v1alpha1.NodeChaos{}
+}
+
+func (r *endpoint) applyAllNodes(ctx context.Context, nodes []v1.Node, chaos *v1alpha1.NodeChaos, results *utils.InjectionResults) error {
+	g := errgroup.Group{}
+	for index := range nodes {
+		node := &nodes[index]
+
+		key, err := cache.MetaNamespaceKeyFunc(node)
+		if err != nil {
+			return err
+		}
+		chaos.Finalizers = utils.InsertFinalizer(chaos.Finalizers, key)
+
+		g.Go(func() error {
+			return results.Inject(node.Namespace, node.Name, func() error {
+				return r.applyNode(ctx, node, chaos)
+			})
+		})
+	}
+
+	return g.Wait()
+}
+
+func (r *endpoint) applyNode(ctx context.Context, node *v1.Node, chaos *v1alpha1.NodeChaos) error {
+	r.Log.Info("Try to apply node chaos", "name", node.Name, "action", chaos.Spec.Action)
+
+	switch chaos.Spec.Action {
+	case v1alpha1.NodeCordonAction:
+		return r.cordonNode(ctx, node.Name, chaos)
+	case v1alpha1.NodeDrainAction:
+		if err := r.cordonNode(ctx, node.Name, chaos); err != nil {
+			return err
+		}
+		return r.evictPods(ctx, node.Name)
+	case v1alpha1.NodeTaintAction:
+		return r.taintNode(ctx, node.Name, chaos)
+	case v1alpha1.KubeletStopAction:
+		return r.stopKubelet(ctx, node.Name, chaos)
+	default:
+		return fmt.Errorf("unknown node chaos action %s", chaos.Spec.Action)
+	}
+}
+
+// cordonNode marks the node as unschedulable, it's recorded only if the node is
+// schedulable or is cordoned by another node chaos
+func (r *endpoint) cordonNode(ctx context.Context, name string, chaos *v1alpha1.NodeChaos) error {
+	annotationKey := recordAnnotationKey(chaos)
+
+	return r.updateNode(ctx, name, func(node *v1.Node) {
+		if node.Spec.Unschedulable && !hasOtherRecord(node, annotationKey, cordonRecord) {
+			r.Log.Info("Node is already cordoned", "name", node.Name)
+			return
+		}
+		node.Spec.Unschedulable = true
+		setRecord(node, annotationKey, cordonRecord)
+	})
+}
+
+// taintNode adds the taint to the node, it's recorded only if the node doesn't have
+// the taint or the taint is added by another node chaos
+func (r *endpoint) taintNode(ctx context.Context, name string, chaos *v1alpha1.NodeChaos) error {
+	annotationKey := recordAnnotationKey(chaos)
+	taint := chaos.Spec.GetTaint()
+	record := taintRecordPrefix + taint.Key + ":" + string(taint.Effect)
+
+	return r.updateNode(ctx, name, func(node *v1.Node) {
+		for _, t := range node.Spec.Taints {
+			if t.MatchTaint(&taint) {
+				if !hasOtherRecord(node, annotationKey, record) {
+					r.Log.Info("Node already has the taint", "name", node.Name, "taint", taint.ToString())
+					return
+				}
+				setRecord(node, annotationKey, record)
+				return
+			}
+		}
+		node.Spec.Taints = append(node.Spec.Taints, taint)
+		setRecord(node, annotationKey, record)
+	})
+}
+
+func (r *endpoint) stopKubelet(ctx context.Context, name string, chaos *v1alpha1.NodeChaos) error {
+	annotationKey := recordAnnotationKey(chaos)
+
+	// record before stopping, so that the kubelet is started on recover even if the request fails halfway
+	if err := r.updateNode(ctx, name, func(node *v1.Node) {
+		setRecord(node, annotationKey, kubeletRecord)
+	}); err != nil {
+		return err
+	}
+
+	pbClient, err := utils.NewChaosDaemonClientForNode(ctx, r.Client, name, common.ControllerCfg.ChaosDaemonPort)
+	if err != nil {
+		return err
+	}
+	defer pbClient.Close()
+
+	_, err = pbClient.StopKubelet(ctx, &pb.KubeletRequest{
+		Service: chaos.Spec.GetKubeletService(),
+	})
+
+	return err
+}
+
+// evictPods evicts the pods selected by utils.SelectEvictablePods on the node,
+// the evicted pods are not restored on recover
+func (r *endpoint) evictPods(ctx context.Context, name string) error {
+	pods, err := utils.SelectEvictablePods(ctx, r.Client, r.Reader, name)
+	if err != nil {
+		return err
+	}
+
+	clientset, err := kubernetesNewForConfig()
+	if err != nil {
+		return err
+	}
+
+	var result error
+	for _, pod := range pods {
+		r.Log.Info("Try to evict pod", "namespace", pod.Namespace, "name", pod.Name, "node", name)
+		err := clientset.PolicyV1beta1().Evictions(pod.Namespace).Evict(&policyv1beta1.Eviction{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: pod.Namespace,
+				Name:      pod.Name,
+			},
+		})
+		if err != nil && !k8serror.IsNotFound(err) {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	return result
+}
+
+func (r *endpoint) updateNode(ctx context.Context, name string, mutate func(node *v1.Node)) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		var node v1.Node
+		if err := r.Client.Get(ctx, types.NamespacedName{Name: name}, &node); err != nil {
+			return err
+		}
+
+		mutate(&node)
+
+		return r.Client.Update(ctx, &node)
+	})
+}
+
+func recordAnnotationKey(chaos *v1alpha1.NodeChaos) string {
+	return nodeChaosAnnotationPrefix + string(chaos.UID)
+}
+
+func setRecord(node *v1.Node, annotationKey string, record string) {
+	if node.Annotations == nil {
+		node.Annotations = make(map[string]string)
+	}
+	node.Annotations[annotationKey] = record
+}
+
+// hasOtherRecord checks if another node chaos records the same change on the node
+func hasOtherRecord(node *v1.Node, annotationKey string, record string) bool {
+	for key, value := range node.Annotations {
+		if key != annotationKey && strings.HasPrefix(key, nodeChaosAnnotationPrefix) && value == record {
+			return true
+		}
+	}
+	return false
+}
+
+func removeTaint(taints []v1.Taint, taint *v1.Taint) []v1.Taint {
+	var newTaints []v1.Taint
+	for _, t := range taints {
+		if t.MatchTaint(taint) {
+			continue
+		}
+		newTaints = append(newTaints, t)
+	}
+	return newTaints
+}
+
+func nodeAddress(node *v1.Node) string {
+	for _, address := range node.Status.Addresses {
+		if address.Type == v1.NodeInternalIP {
+			return address.Address
+		}
+	}
+	return ""
+}
+
+func init() {
+	router.Register("nodechaos", &v1alpha1.NodeChaos{}, func(obj runtime.Object) bool {
+		return true
+	}, func(ctx ctx.Context) end.Endpoint {
+		return &endpoint{
+			Context: ctx,
+		}
+	})
+}
@@ -0,0 +1,203 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oneshot
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	ctx "github.com/chaos-mesh/chaos-mesh/pkg/router/context"
+	end "github.com/chaos-mesh/chaos-mesh/pkg/router/endpoint"
+)
+
+func TestOneShot(t *testing.T) {
+	RegisterFailHandler(Fail)
+
+	RunSpecsWithDefaultAndCustomReporters(t,
+		"OneShot Suite",
+		[]Reporter{envtest.NewlineReporter{}})
+}
+
+var _ = BeforeSuite(func(done Done) {
+	logf.SetLogger(zap.LoggerTo(GinkgoWriter, true))
+
+	close(done)
+}, 60)
+
+var _ end.Endpoint = (*fakeEndpoint)(nil)
+
+type fakeEndpoint struct {
+	applied   int
+	recovered int
+	applyErr  error
+}
+
+func (e *fakeEndpoint) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1.InnerObject) error {
+	if e.applyErr != nil {
+		return e.applyErr
+	}
+	e.applied++
+	return nil
+}
+
+func (e *fakeEndpoint) Recover(ctx context.Context, req ctrl.Request, chaos v1alpha1.InnerObject) error {
+	e.recovered++
+	return nil
+}
+
+func (e *fakeEndpoint) Object() v1alpha1.InnerObject {
+	return &v1alpha1.NetworkChaos{}
+}
+
+var _ = Describe("OneShot", func() {
+	Context("OneShot", func() {
+		duration := "5m"
+
+		req := ctrl.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      "fakechaos-name",
+				Namespace: metav1.NamespaceDefault,
+			},
+		}
+
+		newReconciler := func(chaos *v1alpha1.NetworkChaos) (*Reconciler, *fakeEndpoint) {
+			scheme := runtime.NewScheme()
+			Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+			Expect(v1alpha1.AddToScheme(scheme)).To(Succeed())
+
+			endpoint := &fakeEndpoint{}
+			return &Reconciler{
+				Endpoint: endpoint,
+				Context: ctx.Context{
+					Client: fake.NewFakeClientWithScheme(scheme, chaos),
+					Log:    ctrl.Log.WithName("controllers").WithName("OneShot"),
+				},
+			}, endpoint
+		}
+
+		newChaos := func() *v1alpha1.NetworkChaos {
+			return &v1alpha1.NetworkChaos{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: metav1.NamespaceDefault,
+					Name:      "fakechaos-name",
+				},
+				Spec: v1alpha1.NetworkChaosSpec{
+					Duration: &duration,
+				},
+			}
+		}
+
+		getChaos := func(r *Reconciler) *v1alpha1.NetworkChaos {
+			chaos := &v1alpha1.NetworkChaos{}
+			Expect(r.Client.Get(context.TODO(), req.NamespacedName, chaos)).To(Succeed())
+			return chaos
+		}
+
+		It("OneShot Apply", func() {
+			r, endpoint := newReconciler(newChaos())
+
+			result, err := r.Reconcile(req)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(endpoint.applied).To(Equal(1))
+			Expect(result.RequeueAfter).To(BeNumerically("~", 5*time.Minute, time.Second))
+
+			chaos := getChaos(r)
+			Expect(chaos.Status.Experiment.Phase).To(Equal(v1alpha1.ExperimentPhaseRunning))
+			Expect(chaos.GetNextRecover()).To(BeTemporally("~", time.Now().Add(5*time.Minute), time.Second))
+
+			// the running chaos is not applied again before the duration passes
+			result, err = r.Reconcile(req)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(endpoint.applied).To(Equal(1))
+			Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+		})
+
+		It("OneShot Recover", func() {
+			chaos := newChaos()
+			chaos.Status.Experiment.Phase = v1alpha1.ExperimentPhaseRunning
+			chaos.SetNextRecover(time.Now().Add(-time.Second))
+			r, endpoint := newReconciler(chaos)
+
+			_, err := r.Reconcile(req)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(endpoint.recovered).To(Equal(1))
+			Expect(getChaos(r).Status.Experiment.Phase).To(Equal(v1alpha1.ExperimentPhaseFinished))
+
+			// the finished chaos is never applied again
+			_, err = r.Reconcile(req)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(endpoint.applied).To(Equal(0))
+			Expect(endpoint.recovered).To(Equal(1))
+		})
+
+		It("OneShot Resume", func() {
+			chaos := newChaos()
+			chaos.Status.Experiment.Phase = v1alpha1.ExperimentPhasePaused
+			nextRecover := time.Now().Add(time.Minute)
+			chaos.SetNextRecover(nextRecover)
+			r, endpoint := newReconciler(chaos)
+
+			_, err := r.Reconcile(req)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(endpoint.applied).To(Equal(1))
+
+			chaos = getChaos(r)
+			Expect(chaos.Status.Experiment.Phase).To(Equal(v1alpha1.ExperimentPhaseRunning))
+			// the chaos is still recovered at the end of the original duration
+			Expect(chaos.GetNextRecover()).To(BeTemporally("~", nextRecover, time.Second))
+		})
+
+		It("OneShot Paused After Duration", func() {
+			chaos := newChaos()
+			chaos.Status.Experiment.Phase = v1alpha1.ExperimentPhasePaused
+			chaos.SetNextRecover(time.Now().Add(-time.Minute))
+			r, endpoint := newReconciler(chaos)
+
+			_, err := r.Reconcile(req)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(endpoint.applied).To(Equal(0))
+			Expect(endpoint.recovered).To(Equal(0))
+			Expect(getChaos(r).Status.Experiment.Phase).To(Equal(v1alpha1.ExperimentPhaseFinished))
+		})
+
+		It("OneShot Apply Failed", func() {
+			r, endpoint := newReconciler(newChaos())
+			endpoint.applyErr = errors.New("ApplyError")
+
+			_, err := r.Reconcile(req)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("ApplyError"))
+
+			chaos := getChaos(r)
+			Expect(chaos.Status.Experiment.Phase).To(Equal(v1alpha1.ExperimentPhaseFailed))
+			Expect(chaos.Status.FailedMessage).To(Equal("ApplyError"))
+		})
+	})
+})
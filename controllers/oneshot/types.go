@@ -0,0 +1,181 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oneshot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/client-go/util/retry"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	ctx "github.com/chaos-mesh/chaos-mesh/pkg/router/context"
+	"github.com/chaos-mesh/chaos-mesh/pkg/router/endpoint"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+const emptyString = ""
+
+// Reconciler for the chaos with a duration but no scheduler.
+// The chaos is applied once, recovered after the duration and then finished.
+type Reconciler struct {
+	endpoint.Endpoint
+	ctx.Context
+}
+
+// NewReconciler would create reconciler for oneshot chaos
+func NewReconciler(e endpoint.Endpoint, ctx ctx.Context) *Reconciler {
+	return &Reconciler{
+		Endpoint: e,
+		Context:  ctx,
+	}
+}
+
+// Reconcile is oneshot reconcile implement
+func (r *Reconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	var err error
+	now := time.Now()
+
+	r.Log.Info("Reconciling a oneshot chaos", "name", req.Name, "namespace", req.Namespace)
+	ctx := context.Background()
+
+	_chaos := r.Object()
+	if err = r.Client.Get(ctx, req.NamespacedName, _chaos); err != nil {
+		r.Log.Error(err, "unable to get chaos")
+		return ctrl.Result{}, err
+	}
+	chaos := _chaos.(v1alpha1.InnerSchedulerObject)
+
+	duration, err := chaos.GetDuration()
+	if err != nil {
+		r.Log.Error(err, "failed to get chaos duration")
+		return ctrl.Result{}, err
+	}
+	if duration == nil {
+		r.Log.Info("Duration should be defined currently")
+		return ctrl.Result{}, fmt.Errorf("misdefined duration")
+	}
+
+	status := chaos.GetStatus()
+
+	if chaos.IsDeleted() {
+		// This chaos was deleted
+		r.Log.Info("Removing self")
+		if err = r.Recover(ctx, req, chaos); err != nil {
+			r.Log.Error(err, "failed to recover chaos")
+			updateFailedMessage(ctx, r, chaos, err.Error())
+			return ctrl.Result{Requeue: true}, err
+		}
+		status.Experiment.Phase = v1alpha1.ExperimentPhaseFinished
+		status.FailedMessage = emptyString
+	} else if status.Experiment.Phase == v1alpha1.ExperimentPhaseFinished {
+		r.Log.Info("The oneshot chaos is already finished", "name", req.Name, "namespace", req.Namespace)
+		return ctrl.Result{}, nil
+	} else if chaos.IsPaused() {
+		if status.Experiment.Phase == v1alpha1.ExperimentPhaseRunning {
+			r.Log.Info("Pausing")
+
+			if err = r.Recover(ctx, req, chaos); err != nil {
+				r.Log.Error(err, "failed to pause chaos")
+				updateFailedMessage(ctx, r, chaos, err.Error())
+				return ctrl.Result{Requeue: true}, err
+			}
+			setEndTime(status, now)
+		}
+		status.Experiment.Phase = v1alpha1.ExperimentPhasePaused
+		status.FailedMessage = emptyString
+	} else if !chaos.GetNextRecover().IsZero() && !chaos.GetNextRecover().After(now) {
+		// The duration has passed, the chaos is recovered and never applied again
+		r.Log.Info("Recovering")
+
+		// Don't need to recover again if chaos was paused before
+		if status.Experiment.Phase == v1alpha1.ExperimentPhaseRunning {
+			if err = r.Recover(ctx, req, chaos); err != nil {
+				r.Log.Error(err, "failed to recover chaos")
+				updateFailedMessage(ctx, r, chaos, err.Error())
+				return ctrl.Result{Requeue: true}, err
+			}
+			setEndTime(status, now)
+		}
+
+		status.Experiment.Phase = v1alpha1.ExperimentPhaseFinished
+		status.FailedMessage = emptyString
+	} else if status.Experiment.Phase == v1alpha1.ExperimentPhaseRunning {
+		remaining := chaos.GetNextRecover().Sub(now)
+		r.Log.Info("Requeue request", "after", remaining)
+		return ctrl.Result{RequeueAfter: remaining}, nil
+	} else {
+		// Start the chaos, or resume/retry it within the duration
+		r.Log.Info("Performing Action")
+
+		if err = r.Apply(ctx, req, chaos); err != nil {
+			r.Log.Error(err, "failed to apply chaos action")
+
+			status.Experiment.Phase = v1alpha1.ExperimentPhaseFailed
+			status.FailedMessage = err.Error()
+
+			updateError := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+				return r.Update(ctx, chaos)
+			})
+			if updateError != nil {
+				r.Log.Error(updateError, "unable to update chaos status")
+			}
+
+			return ctrl.Result{Requeue: true}, err
+		}
+
+		if chaos.GetNextRecover().IsZero() {
+			chaos.SetNextRecover(now.Add(*duration))
+		}
+		status.Experiment.StartTime = &metav1.Time{Time: now}
+		status.Experiment.Phase = v1alpha1.ExperimentPhaseRunning
+		status.Experiment.Duration = chaos.GetNextRecover().Sub(now).String()
+		status.FailedMessage = emptyString
+	}
+
+	if err := r.Update(ctx, chaos); err != nil {
+		r.Log.Error(err, "unable to update chaos status")
+		return ctrl.Result{}, err
+	}
+
+	if status.Experiment.Phase == v1alpha1.ExperimentPhaseRunning {
+		return ctrl.Result{RequeueAfter: chaos.GetNextRecover().Sub(now)}, nil
+	}
+	return ctrl.Result{}, nil
+}
+
+func setEndTime(status *v1alpha1.ChaosStatus, now time.Time) {
+	status.Experiment.EndTime = &metav1.Time{
+		Time: now,
+	}
+	if status.Experiment.StartTime != nil {
+		status.Experiment.Duration = now.Sub(status.Experiment.StartTime.Time).String()
+	}
+}
+
+func updateFailedMessage(
+	ctx context.Context,
+	r *Reconciler,
+	chaos v1alpha1.InnerSchedulerObject,
+	err string,
+) {
+	status := chaos.GetStatus()
+	status.FailedMessage = err
+	if err := r.Update(ctx, chaos); err != nil {
+		r.Log.Error(err, "unable to update chaos status")
+	}
+}
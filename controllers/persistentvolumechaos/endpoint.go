@@ -35,7 +35,9 @@ func (e *endpoint) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1.I
 		e.Log.Error(err, "chaos is not PersistentVolumeChaos", "chaos", chaos)
 		return err
 	}
-	pvs, err := utils.SelectAndFilterPV(ctx, e.Client, e.Reader, &pvchaos.Spec)
+	seed := utils.NewSeed(pvchaos.Spec.Seed)
+	pvchaos.Status.Experiment.Seed = &seed
+	pvs, err := utils.SelectAndFilterPV(ctx, e.Client, e.Reader, &pvchaos.Spec, seed)
 	if err != nil {
 		e.Log.Error(err, "fail to select pv")
 		return err
@@ -52,13 +54,17 @@ func (e *endpoint) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1.I
 		return err
 	}
 
+	results := utils.NewInjectionResults(pvchaos.Spec.FailurePolicy)
 	for index := range pvs {
 		pv := &pvs[index]
 		g.Go(func() error {
 			e.Log.Info("Deleting pv", "name", pv.Name)
-			err := e.Delete(ctx, pv, &client.DeleteOptions{})
+			err := results.Inject(pv.Namespace, pv.Name, func() error {
+				return e.Delete(ctx, pv, &client.DeleteOptions{})
+			})
 			if err != nil {
 				e.Log.Error(err, "Can't delete PV!")
+				return err
 			}
 			if pvchaos.Spec.RemoveFinalizers {
 				e.Log.Info("Removing finalizers")
@@ -71,7 +77,18 @@ func (e *endpoint) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1.I
 		})
 	}
 
-	return nil
+	err = g.Wait()
+
+	pvchaos.Status.Experiment.PodRecords = make([]v1alpha1.PodStatus, 0, len(pvs))
+	for _, pv := range pvs {
+		pvchaos.Status.Experiment.PodRecords = append(pvchaos.Status.Experiment.PodRecords, v1alpha1.PodStatus{
+			Namespace: pv.Namespace,
+			Name:      pv.Name,
+			Action:    "delete",
+		})
+	}
+	results.Fill(pvchaos.Status.Experiment.PodRecords)
+	return err
 }
 
 func (e *endpoint) Recover(ctx context.Context, req ctrl.Request, chaos v1alpha1.InnerObject) error {
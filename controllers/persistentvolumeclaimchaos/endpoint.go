@@ -34,7 +34,9 @@ func (e *endpoint) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1.I
 		e.Log.Error(err, "chaos is not PersistentVolumeClaimChaos", "chaos", chaos)
 		return err
 	}
-	pvcs, err := utils.SelectAndFilterPVC(ctx, e.Client, e.Reader, &pvcchaos.Spec)
+	seed := utils.NewSeed(pvcchaos.Spec.Seed)
+	pvcchaos.Status.Experiment.Seed = &seed
+	pvcs, err := utils.SelectAndFilterPVC(ctx, e.Client, e.Reader, &pvcchaos.Spec, seed)
 	if err != nil {
 		e.Log.Error(err, "fail to select pv")
 		return err
@@ -51,13 +53,17 @@ func (e *endpoint) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1.I
 		e.Log.Error(err, "failure creating patch")
 		return err
 	}
+	results := utils.NewInjectionResults(pvcchaos.Spec.FailurePolicy)
 	for index := range pvcs {
 		pvc := &pvcs[index]
 		g.Go(func() error {
 			e.Log.Info("Deleting pvc", "name", pvc.Name)
-			err := e.Delete(ctx, pvc, &client.DeleteOptions{})
+			err := results.Inject(pvc.Namespace, pvc.Name, func() error {
+				return e.Delete(ctx, pvc, &client.DeleteOptions{})
+			})
 			if err != nil {
 				e.Log.Error(err, "Can't delete PVC!")
+				return err
 			}
 			if pvcchaos.Spec.RemoveFinalizers {
 				e.Log.Info("Removing finalizers")
@@ -71,8 +77,18 @@ func (e *endpoint) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1.I
 		})
 	}
 
-	return nil
+	err = g.Wait()
 
+	pvcchaos.Status.Experiment.PodRecords = make([]v1alpha1.PodStatus, 0, len(pvcs))
+	for _, pvc := range pvcs {
+		pvcchaos.Status.Experiment.PodRecords = append(pvcchaos.Status.Experiment.PodRecords, v1alpha1.PodStatus{
+			Namespace: pvc.Namespace,
+			Name:      pvc.Name,
+			Action:    "delete",
+		})
+	}
+	results.Fill(pvcchaos.Status.Experiment.PodRecords)
+	return err
 }
 
 func (e *endpoint) Recover(ctx context.Context, req ctrl.Request, chaos v1alpha1.InnerObject) error {
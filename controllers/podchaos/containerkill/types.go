@@ -18,6 +18,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/hashicorp/go-multierror"
 	"golang.org/x/sync/errgroup"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -56,15 +57,21 @@ func (r *endpoint) Apply(ctx context.Context, req ctrl.Request, obj v1alpha1.Inn
 		return fmt.Errorf("podchaos[%s/%s] the name of container is empty", podchaos.Namespace, podchaos.Name)
 	}
 
-	pods, err := utils.SelectAndFilterPods(ctx, r.Client, r.Reader, &podchaos.Spec)
+	seed := utils.NewSeed(podchaos.Spec.Seed)
+	podchaos.Status.Experiment.Seed = &seed
+	pods, err := utils.SelectAndFilterPods(ctx, r.Client, r.Reader, &podchaos.Spec, seed)
 	if err != nil {
 		r.Log.Error(err, "fail to select and filter pods")
 		return err
 	}
 
+	results := utils.NewInjectionResults(podchaos.Spec.FailurePolicy)
+	// the pods leased by other experiments are handled according to the conflict policy,
+	// and the pods are not leased as the killed containers are restarted at once
+	free, conflicts := utils.CheckTargetLeases(ctx, r.Client, pods, podchaos, results)
 	g := errgroup.Group{}
-	for podIndex := range pods {
-		pod := &pods[podIndex]
+	for podIndex := range free {
+		pod := &free[podIndex]
 		haveContainer := false
 
 		for containerIndex := range pod.Status.ContainerStatuses {
@@ -74,7 +81,9 @@ func (r *endpoint) Apply(ctx context.Context, req ctrl.Request, obj v1alpha1.Inn
 			if containerName == podchaos.Spec.ContainerName {
 				haveContainer = true
 				g.Go(func() error {
-					err = r.KillContainer(ctx, pod, containerID)
+					err := results.Inject(pod.Namespace, pod.Name, func() error {
+						return r.KillContainer(ctx, pod, containerID)
+					})
 					if err != nil {
 						r.Log.Error(err, fmt.Sprintf(
 							"failed to kill container: %s, pod: %s, namespace: %s",
@@ -90,8 +99,9 @@ func (r *endpoint) Apply(ctx context.Context, req ctrl.Request, obj v1alpha1.Inn
 		}
 	}
 
-	if err := g.Wait(); err != nil {
-		return err
+	err = g.Wait()
+	if conflicts != nil {
+		err = multierror.Append(conflicts, err)
 	}
 
 	podchaos.Status.Experiment.PodRecords = make([]v1alpha1.PodStatus, 0, len(pods))
@@ -107,6 +117,10 @@ func (r *endpoint) Apply(ctx context.Context, req ctrl.Request, obj v1alpha1.Inn
 
 		podchaos.Status.Experiment.PodRecords = append(podchaos.Status.Experiment.PodRecords, ps)
 	}
+	results.Fill(podchaos.Status.Experiment.PodRecords)
+	if err != nil {
+		return err
+	}
 	r.Event(obj, v1.EventTypeNormal, utils.EventChaosInjected, "")
 	return nil
 }
@@ -63,14 +63,19 @@ func (r *endpoint) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1.I
 		return err
 	}
 
-	pods, err := utils.SelectAndFilterPods(ctx, r.Client, r.Reader, &podchaos.Spec)
+	seed := utils.NewSeed(podchaos.Spec.Seed)
+	podchaos.Status.Experiment.Seed = &seed
+	pods, err := utils.SelectAndFilterPods(ctx, r.Client, r.Reader, &podchaos.Spec, seed)
 	if err != nil {
 		r.Log.Error(err, "failed to select and filter pods")
 		return err
 	}
-	err = r.failAllPods(ctx, pods, podchaos)
-	if err != nil {
-		return err
+	results := utils.NewInjectionResults(podchaos.Spec.FailurePolicy)
+	// the pods leased by other experiments are handled according to the conflict policy
+	leased, conflicts := utils.AcquireTargetLeases(ctx, r.Client, pods, podchaos, results)
+	err = r.failAllPods(ctx, leased, podchaos, results)
+	if conflicts != nil {
+		err = multierror.Append(conflicts, err)
 	}
 
 	podchaos.Status.Experiment.PodRecords = make([]v1alpha1.PodStatus, 0, len(pods))
@@ -87,6 +92,10 @@ func (r *endpoint) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1.I
 		}
 		podchaos.Status.Experiment.PodRecords = append(podchaos.Status.Experiment.PodRecords, ps)
 	}
+	results.Fill(podchaos.Status.Experiment.PodRecords)
+	if err != nil {
+		return err
+	}
 	r.Event(podchaos, v1.EventTypeNormal, utils.EventChaosInjected, "")
 	return nil
 }
@@ -141,6 +150,7 @@ func (r *endpoint) cleanFinalizersAndRecover(ctx context.Context, podchaos *v1al
 			result = multierror.Append(result, err)
 			continue
 		}
+		// the lease on the pod is dropped together with the pod, which is deleted to be recovered
 
 		podchaos.Finalizers = utils.RemoveFromFinalizer(podchaos.Finalizers, key)
 	}
@@ -154,7 +164,7 @@ func (r *endpoint) cleanFinalizersAndRecover(ctx context.Context, podchaos *v1al
 	return result
 }
 
-func (r *endpoint) failAllPods(ctx context.Context, pods []v1.Pod, podchaos *v1alpha1.PodChaos) error {
+func (r *endpoint) failAllPods(ctx context.Context, pods []v1.Pod, podchaos *v1alpha1.PodChaos, results *utils.InjectionResults) error {
 	g := errgroup.Group{}
 	for index := range pods {
 		pod := &pods[index]
@@ -166,7 +176,9 @@ func (r *endpoint) failAllPods(ctx context.Context, pods []v1.Pod, podchaos *v1a
 		podchaos.Finalizers = utils.InsertFinalizer(podchaos.Finalizers, key)
 
 		g.Go(func() error {
-			return r.failPod(ctx, pod, podchaos)
+			return results.Inject(pod.Namespace, pod.Name, func() error {
+				return r.failPod(ctx, pod, podchaos)
+			})
 		})
 	}
 
@@ -216,19 +228,6 @@ func (r *endpoint) failPod(ctx context.Context, pod *v1.Pod, podchaos *v1alpha1.
 		return err
 	}
 
-	ps := v1alpha1.PodStatus{
-		Namespace: pod.Namespace,
-		Name:      pod.Name,
-		HostIP:    pod.Status.HostIP,
-		PodIP:     pod.Status.PodIP,
-		Action:    string(podchaos.Spec.Action),
-	}
-	if podchaos.Spec.Duration != nil {
-		ps.Message = fmt.Sprintf(podFailureActionMsg, *podchaos.Spec.Duration)
-	}
-
-	podchaos.Status.Experiment.PodRecords = append(podchaos.Status.Experiment.PodRecords, ps)
-
 	return nil
 }
 
@@ -17,6 +17,7 @@ import (
 	"context"
 	"errors"
 
+	"github.com/hashicorp/go-multierror"
 	"golang.org/x/sync/errgroup"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -46,20 +47,28 @@ func (r *endpoint) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1.I
 		r.Log.Error(err, "chaos is not PodChaos", "chaos", chaos)
 		return err
 	}
-	pods, err := utils.SelectAndFilterPods(ctx, r.Client, r.Reader, &podchaos.Spec)
+	seed := utils.NewSeed(podchaos.Spec.Seed)
+	podchaos.Status.Experiment.Seed = &seed
+	pods, err := utils.SelectAndFilterPods(ctx, r.Client, r.Reader, &podchaos.Spec, seed)
 	if err != nil {
 		r.Log.Error(err, "fail to select and generate pods")
 		return err
 	}
 
+	results := utils.NewInjectionResults(podchaos.Spec.FailurePolicy)
+	// the pods leased by other experiments are handled according to the conflict policy,
+	// and the killed pods are not leased as they are gone
+	free, conflicts := utils.CheckTargetLeases(ctx, r.Client, pods, podchaos, results)
 	g := errgroup.Group{}
-	for index := range pods {
-		pod := &pods[index]
+	for index := range free {
+		pod := &free[index]
 		g.Go(func() error {
 			r.Log.Info("Deleting", "namespace", pod.Namespace, "name", pod.Name)
 
-			if err := r.Delete(ctx, pod, &client.DeleteOptions{
-				GracePeriodSeconds: &podchaos.Spec.GracePeriod, // PeriodSeconds has to be set specifically
+			if err := results.Inject(pod.Namespace, pod.Name, func() error {
+				return r.Delete(ctx, pod, &client.DeleteOptions{
+					GracePeriodSeconds: &podchaos.Spec.GracePeriod, // PeriodSeconds has to be set specifically
+				})
 			}); err != nil {
 				r.Log.Error(err, "unable to delete pod")
 				return err
@@ -67,10 +76,11 @@ func (r *endpoint) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1.I
 			return nil
 		})
 	}
-
-	if err := g.Wait(); err != nil {
-		return err
+	err = g.Wait()
+	if conflicts != nil {
+		err = multierror.Append(conflicts, err)
 	}
+
 	podchaos.Status.Experiment.PodRecords = make([]v1alpha1.PodStatus, 0, len(pods))
 	for _, pod := range pods {
 		ps := v1alpha1.PodStatus{
@@ -84,6 +94,10 @@ func (r *endpoint) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1.I
 
 		podchaos.Status.Experiment.PodRecords = append(podchaos.Status.Experiment.PodRecords, ps)
 	}
+	results.Fill(podchaos.Status.Experiment.PodRecords)
+	if err != nil {
+		return err
+	}
 
 	r.Event(podchaos, v1.EventTypeNormal, utils.EventChaosInjected, "")
 	return nil
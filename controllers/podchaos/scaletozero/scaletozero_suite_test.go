@@ -0,0 +1,180 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scaletozero
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	ctx "github.com/chaos-mesh/chaos-mesh/pkg/router/context"
+)
+
+func TestScaleToZero(t *testing.T) {
+	RegisterFailHandler(Fail)
+
+	RunSpecsWithDefaultAndCustomReporters(t,
+		"ScaleToZero Suite",
+		[]Reporter{envtest.NewlineReporter{}})
+}
+
+var _ = BeforeSuite(func(done Done) {
+	logf.SetLogger(zap.LoggerTo(GinkgoWriter, true))
+
+	Expect(v1.AddToScheme(scheme.Scheme)).To(Succeed())
+	Expect(appsv1.AddToScheme(scheme.Scheme)).To(Succeed())
+	Expect(v1alpha1.AddToScheme(scheme.Scheme)).To(Succeed())
+
+	close(done)
+}, 60)
+
+var _ = AfterSuite(func() {
+})
+
+func controllerRef(kind, name string) []metav1.OwnerReference {
+	controller := true
+	return []metav1.OwnerReference{{
+		APIVersion: "apps/v1",
+		Kind:       kind,
+		Name:       name,
+		Controller: &controller,
+	}}
+}
+
+var _ = Describe("PodChaos", func() {
+	Context("ScaleToZero", func() {
+		replicas := int32(2)
+		deployment := appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: v1alpha1.KubeSystemNamespace,
+				Name:      "coredns",
+			},
+			Spec: appsv1.DeploymentSpec{
+				Replicas: &replicas,
+			},
+		}
+		rs := appsv1.ReplicaSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:       v1alpha1.KubeSystemNamespace,
+				Name:            "coredns-5644d7b6d9",
+				OwnerReferences: controllerRef("Deployment", "coredns"),
+			},
+		}
+		pods := []v1.Pod{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:       v1alpha1.KubeSystemNamespace,
+					Name:            "coredns-5644d7b6d9-4hbxq",
+					Labels:          map[string]string{appsv1.DefaultDeploymentUniqueLabelKey: "5644d7b6d9"},
+					OwnerReferences: controllerRef("ReplicaSet", "coredns-5644d7b6d9"),
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:       v1alpha1.KubeSystemNamespace,
+					Name:            "coredns-5644d7b6d9-8jqvm",
+					Labels:          map[string]string{appsv1.DefaultDeploymentUniqueLabelKey: "5644d7b6d9"},
+					OwnerReferences: controllerRef("ReplicaSet", "coredns-5644d7b6d9"),
+				},
+			},
+		}
+
+		podChaos := v1alpha1.PodChaos{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "PodChaos",
+				APIVersion: "v1",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: metav1.NamespaceDefault,
+				Name:      "podchaos-name",
+				UID:       "podchaos-uid",
+			},
+			Spec: v1alpha1.PodChaosSpec{
+				Action: v1alpha1.ScaleToZeroAction,
+				Selector: v1alpha1.SelectorSpec{
+					Components: []v1alpha1.KubeComponent{v1alpha1.CoreDNSComponent},
+				},
+				Mode: v1alpha1.AllPodMode,
+			},
+		}
+
+		c := fake.NewFakeClientWithScheme(scheme.Scheme, []runtime.Object{&deployment, &rs, &pods[0], &pods[1]}...)
+		r := endpoint{
+			Context: ctx.Context{
+				Client:        c,
+				EventRecorder: &record.FakeRecorder{},
+				Log:           ctrl.Log.WithName("controllers").WithName("PodChaos"),
+			},
+		}
+
+		It("ScaleToZero Action", func() {
+			key := types.NamespacedName{Namespace: deployment.Namespace, Name: deployment.Name}
+			var err error
+
+			err = r.Apply(context.TODO(), ctrl.Request{}, &podChaos)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(podChaos.Finalizers).To(Equal([]string{"Deployment:kube-system/coredns"}))
+			Expect(podChaos.Status.Experiment.PodRecords).To(HaveLen(2))
+
+			var scaled appsv1.Deployment
+			Expect(c.Get(context.TODO(), key, &scaled)).To(Succeed())
+			Expect(*scaled.Spec.Replicas).To(Equal(int32(0)))
+			Expect(scaled.Annotations).To(HaveKeyWithValue(scaleToZeroAnnotationPrefix+"podchaos-uid", "2"))
+
+			err = r.Recover(context.TODO(), ctrl.Request{}, &podChaos)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(podChaos.Finalizers).To(BeEmpty())
+
+			var restored appsv1.Deployment
+			Expect(c.Get(context.TODO(), key, &restored)).To(Succeed())
+			Expect(*restored.Spec.Replicas).To(Equal(int32(2)))
+			Expect(restored.Annotations).NotTo(HaveKey(scaleToZeroAnnotationPrefix + "podchaos-uid"))
+		})
+
+		It("should keep the replicas scaled by another chaos", func() {
+			key := types.NamespacedName{Namespace: deployment.Namespace, Name: deployment.Name}
+			another := podChaos.DeepCopy()
+			another.UID = "another-uid"
+
+			Expect(r.Apply(context.TODO(), ctrl.Request{}, &podChaos)).To(Succeed())
+			Expect(r.Apply(context.TODO(), ctrl.Request{}, another)).To(Succeed())
+
+			Expect(r.Recover(context.TODO(), ctrl.Request{}, &podChaos)).To(Succeed())
+			var scaled appsv1.Deployment
+			Expect(c.Get(context.TODO(), key, &scaled)).To(Succeed())
+			Expect(*scaled.Spec.Replicas).To(Equal(int32(0)))
+
+			Expect(r.Recover(context.TODO(), ctrl.Request{}, another)).To(Succeed())
+			var restored appsv1.Deployment
+			Expect(c.Get(context.TODO(), key, &restored)).To(Succeed())
+			Expect(*restored.Spec.Replicas).To(Equal(int32(2)))
+		})
+	})
+})
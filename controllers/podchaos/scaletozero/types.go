@@ -0,0 +1,346 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scaletozero
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	k8serror "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/common"
+	"github.com/chaos-mesh/chaos-mesh/pkg/router"
+	ctx "github.com/chaos-mesh/chaos-mesh/pkg/router/context"
+	end "github.com/chaos-mesh/chaos-mesh/pkg/router/endpoint"
+	"github.com/chaos-mesh/chaos-mesh/pkg/utils"
+)
+
+const (
+	scaleToZeroActionMsg = "scale %s %s to zero"
+
+	// scaleToZeroAnnotationPrefix is the prefix of the annotations recording the original replicas
+	// of the workload scaled by a pod chaos, the full key is suffixed by the UID of the chaos
+	scaleToZeroAnnotationPrefix = "scaletozero.chaos-mesh.org/"
+)
+
+type endpoint struct {
+	ctx.Context
+}
+
+// workload is a Deployment or StatefulSet which owns the selected pods
+type workload struct {
+	Kind      v1alpha1.WorkloadKind
+	Namespace string
+	Name      string
+}
+
+// Object implements the reconciler.InnerReconciler.Object
+func (r *endpoint) Object() v1alpha1.InnerObject {
+	return &v1alpha1.PodChaos{}
+}
+
+// Apply implements the reconciler.InnerReconciler.Apply
+func (r *endpoint) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1.InnerObject) error {
+	podchaos, ok := chaos.(*v1alpha1.PodChaos)
+	if !ok {
+		err := errors.New("chaos is not PodChaos")
+		r.Log.Error(err, "chaos is not PodChaos", "chaos", chaos)
+		return err
+	}
+
+	seed := utils.NewSeed(podchaos.Spec.Seed)
+	podchaos.Status.Experiment.Seed = &seed
+	pods, err := utils.SelectAndFilterPods(ctx, r.Client, r.Reader, &podchaos.Spec, seed)
+	if err != nil {
+		r.Log.Error(err, "failed to select and filter pods")
+		return err
+	}
+
+	var result error
+	results := utils.NewInjectionResults(podchaos.Spec.FailurePolicy)
+	podchaos.Status.Experiment.PodRecords = make([]v1alpha1.PodStatus, 0, len(pods))
+	// the pods of the same workload share the result of scaling the workload
+	scaled := make(map[workload]error)
+	for index := range pods {
+		pod := &pods[index]
+
+		ps := v1alpha1.PodStatus{
+			Namespace: pod.Namespace,
+			Name:      pod.Name,
+			HostIP:    pod.Status.HostIP,
+			PodIP:     pod.Status.PodIP,
+			Action:    string(podchaos.Spec.Action),
+		}
+
+		w, err := r.getWorkload(ctx, pod)
+		if err != nil {
+			r.Log.Error(err, "failed to get the workload of pod", "namespace", pod.Namespace, "name", pod.Name)
+			result = multierror.Append(result, results.Record(pod.Namespace, pod.Name, err))
+			podchaos.Status.Experiment.PodRecords = append(podchaos.Status.Experiment.PodRecords, ps)
+			continue
+		}
+
+		err, ok := scaled[w]
+		if !ok {
+			podchaos.Finalizers = utils.InsertFinalizer(podchaos.Finalizers, w.key())
+			if err = results.Inject(pod.Namespace, pod.Name, func() error {
+				return r.scaleToZero(ctx, w, podchaos)
+			}); err != nil {
+				r.Log.Error(err, "failed to scale workload to zero", "kind", w.Kind, "namespace", w.Namespace, "name", w.Name)
+				result = multierror.Append(result, err)
+			}
+			scaled[w] = err
+		} else {
+			results.Record(pod.Namespace, pod.Name, err)
+		}
+
+		ps.Message = fmt.Sprintf(scaleToZeroActionMsg, w.Kind, w.Name)
+		podchaos.Status.Experiment.PodRecords = append(podchaos.Status.Experiment.PodRecords, ps)
+	}
+	results.Fill(podchaos.Status.Experiment.PodRecords)
+	if result != nil {
+		return result
+	}
+
+	r.Event(podchaos, v1.EventTypeNormal, utils.EventChaosInjected, "")
+	return nil
+}
+
+// Recover implements the reconciler.InnerReconciler.Recover
+func (r *endpoint) Recover(ctx context.Context, req ctrl.Request, obj v1alpha1.InnerObject) error {
+	podchaos, ok := obj.(*v1alpha1.PodChaos)
+	if !ok {
+		err := errors.New("chaos is not PodChaos")
+		r.Log.Error(err, "chaos is not PodChaos", "chaos", obj)
+		return err
+	}
+
+	if err := r.cleanFinalizersAndRecover(ctx, podchaos); err != nil {
+		return err
+	}
+
+	r.Event(podchaos, v1.EventTypeNormal, utils.EventChaosRecovered, "")
+	return nil
+}
+
+func (r *endpoint) cleanFinalizersAndRecover(ctx context.Context, podchaos *v1alpha1.PodChaos) error {
+	var result error
+
+	for _, key := range podchaos.Finalizers {
+		w, err := parseWorkloadKey(key)
+		if err != nil {
+			result = multierror.Append(result, err)
+			continue
+		}
+
+		err = r.restoreReplicas(ctx, w, podchaos)
+		if err != nil {
+			if !k8serror.IsNotFound(err) {
+				result = multierror.Append(result, err)
+				continue
+			}
+
+			r.Log.Info("Workload not found", "kind", w.Kind, "namespace", w.Namespace, "name", w.Name)
+		}
+
+		podchaos.Finalizers = utils.RemoveFromFinalizer(podchaos.Finalizers, key)
+	}
+
+	if podchaos.Annotations[common.AnnotationCleanFinalizer] == common.AnnotationCleanFinalizerForced {
+		r.Log.Info("Force cleanup all finalizers", "chaos", podchaos)
+		podchaos.Finalizers = podchaos.Finalizers[:0]
+		return nil
+	}
+
+	return result
+}
+
+// getWorkload returns the Deployment or StatefulSet owning the pod
+func (r *endpoint) getWorkload(ctx context.Context, pod *v1.Pod) (workload, error) {
+	owner := metav1.GetControllerOf(pod)
+	if owner == nil {
+		return workload{}, fmt.Errorf("pod %s/%s is not owned by any workload", pod.Namespace, pod.Name)
+	}
+
+	switch owner.Kind {
+	case string(v1alpha1.StatefulSetWorkload):
+		return workload{Kind: v1alpha1.StatefulSetWorkload, Namespace: pod.Namespace, Name: owner.Name}, nil
+	case string(v1alpha1.ReplicaSetWorkload):
+		var rs appsv1.ReplicaSet
+		if err := r.Client.Get(ctx, types.NamespacedName{
+			Namespace: pod.Namespace,
+			Name:      owner.Name,
+		}, &rs); err != nil {
+			return workload{}, err
+		}
+
+		rsOwner := metav1.GetControllerOf(&rs)
+		if rsOwner == nil || rsOwner.Kind != string(v1alpha1.DeploymentWorkload) {
+			return workload{}, fmt.Errorf("replicaset %s/%s is not owned by any deployment", rs.Namespace, rs.Name)
+		}
+		return workload{Kind: v1alpha1.DeploymentWorkload, Namespace: pod.Namespace, Name: rsOwner.Name}, nil
+	default:
+		return workload{}, fmt.Errorf("%s %s/%s owning pod %s can not be scaled", owner.Kind, pod.Namespace, owner.Name, pod.Name)
+	}
+}
+
+// scaleToZero scales the workload to zero and records the original replicas in its annotations.
+// If the workload has been scaled by another pod chaos, the original replicas recorded by it is reused.
+func (r *endpoint) scaleToZero(ctx context.Context, w workload, podchaos *v1alpha1.PodChaos) error {
+	r.Log.Info("Try to scale workload to zero", "kind", w.Kind, "namespace", w.Namespace, "name", w.Name)
+
+	annotationKey := recordAnnotationKey(podchaos)
+	return r.updateReplicas(ctx, w, func(meta *metav1.ObjectMeta, replicas *int32) *int32 {
+		if _, ok := meta.Annotations[annotationKey]; ok {
+			return replicas
+		}
+
+		original := "1"
+		if replicas != nil {
+			original = strconv.Itoa(int(*replicas))
+		}
+		if record, ok := otherRecord(meta, annotationKey); ok {
+			original = record
+		}
+
+		if meta.Annotations == nil {
+			meta.Annotations = make(map[string]string)
+		}
+		meta.Annotations[annotationKey] = original
+
+		zero := int32(0)
+		return &zero
+	})
+}
+
+// restoreReplicas restores the replicas of the workload if no other pod chaos is scaling it
+func (r *endpoint) restoreReplicas(ctx context.Context, w workload, podchaos *v1alpha1.PodChaos) error {
+	r.Log.Info("Try to restore the replicas of workload", "kind", w.Kind, "namespace", w.Namespace, "name", w.Name)
+
+	annotationKey := recordAnnotationKey(podchaos)
+	var parseErr error
+	err := r.updateReplicas(ctx, w, func(meta *metav1.ObjectMeta, replicas *int32) *int32 {
+		record, ok := meta.Annotations[annotationKey]
+		if !ok {
+			return replicas
+		}
+		delete(meta.Annotations, annotationKey)
+
+		if _, ok := otherRecord(meta, annotationKey); ok {
+			return replicas
+		}
+
+		original, err := strconv.ParseInt(record, 10, 32)
+		if err != nil {
+			parseErr = err
+			return replicas
+		}
+		restored := int32(original)
+		return &restored
+	})
+	if err != nil {
+		return err
+	}
+
+	return parseErr
+}
+
+// updateReplicas updates the replicas of the workload with the value returned by mutate
+func (r *endpoint) updateReplicas(ctx context.Context, w workload, mutate func(meta *metav1.ObjectMeta, replicas *int32) *int32) error {
+	key := types.NamespacedName{
+		Namespace: w.Namespace,
+		Name:      w.Name,
+	}
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		switch w.Kind {
+		case v1alpha1.DeploymentWorkload:
+			var deployment appsv1.Deployment
+			if err := r.Client.Get(ctx, key, &deployment); err != nil {
+				return err
+			}
+			deployment.Spec.Replicas = mutate(&deployment.ObjectMeta, deployment.Spec.Replicas)
+			return r.Client.Update(ctx, &deployment)
+		case v1alpha1.StatefulSetWorkload:
+			var statefulSet appsv1.StatefulSet
+			if err := r.Client.Get(ctx, key, &statefulSet); err != nil {
+				return err
+			}
+			statefulSet.Spec.Replicas = mutate(&statefulSet.ObjectMeta, statefulSet.Spec.Replicas)
+			return r.Client.Update(ctx, &statefulSet)
+		default:
+			return fmt.Errorf("%s %s/%s can not be scaled", w.Kind, w.Namespace, w.Name)
+		}
+	})
+}
+
+func (w workload) key() string {
+	return string(w.Kind) + ":" + w.Namespace + "/" + w.Name
+}
+
+func parseWorkloadKey(key string) (workload, error) {
+	parts := strings.SplitN(key, ":", 2)
+	if len(parts) != 2 {
+		return workload{}, fmt.Errorf("unexpected workload key format: %q", key)
+	}
+
+	ns, name, err := cache.SplitMetaNamespaceKey(parts[1])
+	if err != nil {
+		return workload{}, err
+	}
+
+	return workload{Kind: v1alpha1.WorkloadKind(parts[0]), Namespace: ns, Name: name}, nil
+}
+
+func recordAnnotationKey(podchaos *v1alpha1.PodChaos) string {
+	return scaleToZeroAnnotationPrefix + string(podchaos.UID)
+}
+
+// otherRecord returns the original replicas recorded by another pod chaos
+func otherRecord(meta *metav1.ObjectMeta, annotationKey string) (string, bool) {
+	for key, value := range meta.Annotations {
+		if key != annotationKey && strings.HasPrefix(key, scaleToZeroAnnotationPrefix) {
+			return value, true
+		}
+	}
+	return "", false
+}
+
+func init() {
+	router.Register("podchaos", &v1alpha1.PodChaos{}, func(obj runtime.Object) bool {
+		chaos, ok := obj.(*v1alpha1.PodChaos)
+		if !ok {
+			return false
+		}
+
+		return chaos.Spec.Action == v1alpha1.ScaleToZeroAction
+	}, func(ctx ctx.Context) end.Endpoint {
+		return &endpoint{
+			Context: ctx,
+		}
+	})
+}
@@ -42,8 +42,9 @@ func SetIptablesChains(ctx context.Context, c client.Client, pod *v1.Pod, chains
 	containerID := pod.Status.ContainerStatuses[0].ContainerID
 
 	_, err = pbClient.SetIptablesChains(ctx, &pb.IptablesChainsRequest{
-		Chains:      chains,
-		ContainerId: containerID,
+		Chains:        chains,
+		ContainerId:   containerID,
+		LeaseDeadline: utils.LeaseDeadline(),
 	})
 	return err
 }
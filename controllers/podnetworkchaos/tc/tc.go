@@ -40,8 +40,9 @@ func SetTcs(ctx context.Context, c client.Client, pod *v1.Pod, tcs []*pb.Tc) err
 	containerID := pod.Status.ContainerStatuses[0].ContainerID
 
 	_, err = pbClient.SetTcs(ctx, &pb.TcsRequest{
-		Tcs:         tcs,
-		ContainerId: containerID,
+		Tcs:           tcs,
+		ContainerId:   containerID,
+		LeaseDeadline: utils.LeaseDeadline(),
 	})
 	return err
 }
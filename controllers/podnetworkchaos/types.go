@@ -95,17 +95,25 @@ func (h *Handler) SetIPSets(ctx context.Context, pod *corev1.Pod, chaos *v1alpha
 
 // SetIptables sets iptables on pod
 func (h *Handler) SetIptables(ctx context.Context, pod *corev1.Pod, chaos *v1alpha1.PodNetworkChaos) error {
+	chains, err := GenerateIptablesChains(&chaos.Spec)
+	if err != nil {
+		h.Log.Error(err, "unknown direction")
+		return err
+	}
+	return iptable.SetIptablesChains(ctx, h.Client, pod, chains)
+}
+
+// GenerateIptablesChains converts the iptables in spec into the chains flushed by chaos-daemon
+func GenerateIptablesChains(spec *v1alpha1.PodNetworkChaosSpec) ([]*pb.Chain, error) {
 	chains := []*pb.Chain{}
-	for _, chain := range chaos.Spec.Iptables {
+	for _, chain := range spec.Iptables {
 		var direction pb.Chain_Direction
 		if chain.Direction == v1alpha1.Input {
 			direction = pb.Chain_INPUT
 		} else if chain.Direction == v1alpha1.Output {
 			direction = pb.Chain_OUTPUT
 		} else {
-			err := fmt.Errorf("unknown direction %s", string(chain.Direction))
-			h.Log.Error(err, "unknown direction")
-			return err
+			return nil, fmt.Errorf("unknown direction %s", string(chain.Direction))
 		}
 		chains = append(chains, &pb.Chain{
 			Name:      chain.Name,
@@ -114,17 +122,28 @@ func (h *Handler) SetIptables(ctx context.Context, pod *corev1.Pod, chaos *v1alp
 			Target:    "DROP",
 		})
 	}
-	return iptable.SetIptablesChains(ctx, h.Client, pod, chains)
+	return chains, nil
 }
 
 // SetTcs sets traffic control related chaos on pod
 func (h *Handler) SetTcs(ctx context.Context, pod *corev1.Pod, chaos *v1alpha1.PodNetworkChaos) error {
+	tcs, err := GenerateTcs(&chaos.Spec)
+	if err != nil {
+		return err
+	}
+
+	h.Log.Info("setting tcs", "tcs", tcs)
+	return tc.SetTcs(ctx, h.Client, pod, tcs)
+}
+
+// GenerateTcs converts the traffic controls in spec into the tcs set by chaos-daemon
+func GenerateTcs(spec *v1alpha1.PodNetworkChaosSpec) ([]*pb.Tc, error) {
 	tcs := []*pb.Tc{}
-	for _, tc := range chaos.Spec.TrafficControls {
+	for _, tc := range spec.TrafficControls {
 		if tc.Type == v1alpha1.Bandwidth {
 			tbf, err := tc.Bandwidth.ToTbf()
 			if err != nil {
-				return err
+				return nil, err
 			}
 			tcs = append(tcs, &pb.Tc{
 				Type:  pb.Tc_BANDWIDTH,
@@ -134,7 +153,7 @@ func (h *Handler) SetTcs(ctx context.Context, pod *corev1.Pod, chaos *v1alpha1.P
 		} else if tc.Type == v1alpha1.Netem {
 			netem, err := mergeNetem(tc.TcParameter)
 			if err != nil {
-				return err
+				return nil, err
 			}
 			tcs = append(tcs, &pb.Tc{
 				Type:  pb.Tc_NETEM,
@@ -142,12 +161,29 @@ func (h *Handler) SetTcs(ctx context.Context, pod *corev1.Pod, chaos *v1alpha1.P
 				Ipset: tc.IPSet,
 			})
 		} else {
-			return fmt.Errorf("unknown tc type")
+			return nil, fmt.Errorf("unknown tc type")
 		}
 	}
+	return tcs, nil
+}
 
-	h.Log.Info("setting tcs", "tcs", tcs)
-	return tc.SetTcs(ctx, h.Client, pod, tcs)
+// Check returns an error if the network configuration in spec can't be flushed into the pod,
+// it doesn't call chaos-daemon
+func Check(pod *corev1.Pod, spec *v1alpha1.PodNetworkChaosSpec) error {
+	if pod.Spec.HostNetwork {
+		return errors.Errorf("it's dangerous to inject network chaos on a pod(%s/%s) with `hostNetwork`", pod.Namespace, pod.Name)
+	}
+
+	if pod.Status.Phase != corev1.PodRunning {
+		return errors.Errorf("pod %s/%s is not running", pod.Namespace, pod.Name)
+	}
+
+	if _, err := GenerateIptablesChains(spec); err != nil {
+		return err
+	}
+
+	_, err := GenerateTcs(spec)
+	return err
 }
 
 // NetemSpec defines the interface to convert to a Netem protobuf
@@ -17,6 +17,7 @@ import (
 	"testing"
 
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
 
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
 	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
@@ -60,3 +61,31 @@ func TestMergenetem(t *testing.T) {
 		g.Expect(m).Should(Equal(em))
 	})
 }
+
+func TestCheck(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	pod := &corev1.Pod{Status: corev1.PodStatus{Phase: corev1.PodRunning}}
+	spec := &v1alpha1.PodNetworkChaosSpec{
+		Iptables: []v1alpha1.RawIptables{{Name: "chaos-output", Direction: v1alpha1.Output}},
+		TrafficControls: []v1alpha1.RawTrafficControl{{
+			Type: v1alpha1.Netem,
+			TcParameter: v1alpha1.TcParameter{
+				Delay: &v1alpha1.DelaySpec{Latency: "90ms", Jitter: "0ms", Correlation: "0"},
+			},
+		}},
+	}
+	g.Expect(Check(pod, spec)).Should(Succeed())
+
+	invalid := spec.DeepCopy()
+	invalid.TrafficControls[0].TcParameter = v1alpha1.TcParameter{}
+	g.Expect(Check(pod, invalid)).Should(MatchError(invalidNetemSpecMsg))
+
+	hostNetwork := pod.DeepCopy()
+	hostNetwork.Spec.HostNetwork = true
+	g.Expect(Check(hostNetwork, spec)).ShouldNot(Succeed())
+
+	pending := pod.DeepCopy()
+	pending.Status.Phase = corev1.PodPending
+	g.Expect(Check(pending, spec)).ShouldNot(Succeed())
+}
@@ -25,6 +25,7 @@ import (
 	"golang.org/x/sync/errgroup"
 	v1 "k8s.io/api/core/v1"
 	k8serror "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -56,16 +57,21 @@ func (r *endpoint) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1.I
 		return err
 	}
 
-	pods, err := utils.SelectAndFilterPods(ctx, r.Client, r.Reader, &stresschaos.Spec)
+	seed := utils.NewSeed(stresschaos.Spec.Seed)
+	stresschaos.Status.Experiment.Seed = &seed
+	pods, err := utils.SelectAndFilterPods(ctx, r.Client, r.Reader, &stresschaos.Spec, seed)
 	if err != nil {
 		r.Log.Error(err, "failed to select and generate pods")
 		return err
 	}
 
 	stresschaos.Status.Instances = make(map[string]v1alpha1.StressInstance, len(pods))
-	if err = r.applyAllPods(ctx, pods, stresschaos); err != nil {
-		r.Log.Error(err, "failed to apply chaos on all pods")
-		return err
+	results := utils.NewInjectionResults(stresschaos.Spec.FailurePolicy)
+	// the pods leased by other experiments are handled according to the conflict policy
+	leased, conflicts := utils.AcquireTargetLeases(ctx, r.Client, pods, stresschaos, results)
+	err = r.applyAllPods(ctx, leased, stresschaos, results)
+	if conflicts != nil {
+		err = multierror.Append(conflicts, err)
 	}
 
 	stresschaos.Status.Experiment.PodRecords = make([]v1alpha1.PodStatus, 0, len(pods))
@@ -80,6 +86,11 @@ func (r *endpoint) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1.I
 
 		stresschaos.Status.Experiment.PodRecords = append(stresschaos.Status.Experiment.PodRecords, ps)
 	}
+	results.Fill(stresschaos.Status.Experiment.PodRecords)
+	if err != nil {
+		r.Log.Error(err, "failed to apply chaos on all pods")
+		return err
+	}
 	r.Event(stresschaos, v1.EventTypeNormal, utils.EventChaosInjected, "")
 	return nil
 }
@@ -134,6 +145,12 @@ func (r *endpoint) cleanFinalizersAndRecover(ctx context.Context, chaos *v1alpha
 			continue
 		}
 
+		err = utils.ReleaseTargetLease(ctx, r.Client, &pod, chaos)
+		if err != nil {
+			result = multierror.Append(result, err)
+			continue
+		}
+
 		chaos.Finalizers = utils.RemoveFromFinalizer(chaos.Finalizers, key)
 	}
 
@@ -163,8 +180,10 @@ func (r *endpoint) recoverPod(ctx context.Context, pod *v1.Pod, chaos *v1alpha1.
 		return nil
 	}
 	if _, err = daemonClient.CancelStressors(ctx, &pb.CancelStressRequest{
-		Instance:  instance.UID,
-		StartTime: instance.StartTime.UnixNano() / int64(time.Millisecond),
+		Instance:        instance.UID,
+		StartTime:       timeToMilliseconds(instance.StartTime),
+		MemoryInstance:  instance.MemoryUID,
+		MemoryStartTime: timeToMilliseconds(instance.MemoryStartTime),
 	}); err != nil {
 		return err
 	}
@@ -177,7 +196,7 @@ func (r *endpoint) Object() v1alpha1.InnerObject {
 	return &v1alpha1.StressChaos{}
 }
 
-func (r *endpoint) applyAllPods(ctx context.Context, pods []v1.Pod, chaos *v1alpha1.StressChaos) error {
+func (r *endpoint) applyAllPods(ctx context.Context, pods []v1.Pod, chaos *v1alpha1.StressChaos, results *utils.InjectionResults) error {
 	g := errgroup.Group{}
 
 	instancesLock := &sync.RWMutex{}
@@ -191,7 +210,9 @@ func (r *endpoint) applyAllPods(ctx context.Context, pods []v1.Pod, chaos *v1alp
 		chaos.Finalizers = utils.InsertFinalizer(chaos.Finalizers, key)
 
 		g.Go(func() error {
-			return r.applyPod(ctx, pod, chaos, instancesLock)
+			return results.Inject(pod.Namespace, pod.Name, func() error {
+				return r.applyPod(ctx, pod, chaos, instancesLock)
+			})
 		})
 	}
 	return g.Wait()
@@ -220,12 +241,14 @@ func (r *endpoint) applyPod(ctx context.Context, pod *v1.Pod, chaos *v1alpha1.St
 		return fmt.Errorf("%s %s can't get the state of container", pod.Namespace, pod.Name)
 	}
 	target := pod.Status.ContainerStatuses[0].ContainerID
+	targetName := pod.Status.ContainerStatuses[0].Name
 	if chaos.Spec.ContainerName != nil &&
 		len(strings.TrimSpace(*chaos.Spec.ContainerName)) != 0 {
 		target = ""
 		for _, container := range pod.Status.ContainerStatuses {
 			if container.Name == *chaos.Spec.ContainerName {
 				target = container.ContainerID
+				targetName = container.Name
 			}
 		}
 		if len(target) == 0 {
@@ -233,33 +256,79 @@ func (r *endpoint) applyPod(ctx context.Context, pod *v1.Pod, chaos *v1alpha1.St
 		}
 	}
 
-	stressors := chaos.Spec.StressngStressors
-	if len(stressors) == 0 {
-		stressors, err = chaos.Spec.Stressors.Normalize()
+	req := &pb.ExecStressRequest{
+		Scope:     pb.ExecStressRequest_CONTAINER,
+		Target:    target,
+		Stressors: chaos.Spec.StressngStressors,
+	}
+	if len(req.Stressors) == 0 {
+		limit := memoryLimit(pod, targetName)
+		req.Stressors, err = chaos.Spec.Stressors.Normalize(limit)
 		if err != nil {
 			return err
 		}
+
+		if memory := chaos.Spec.Stressors.MemoryStressor; memory != nil && memory.IsHoldMode() {
+			size, err := memory.Bytes(limit)
+			if err != nil {
+				return err
+			}
+			rate, err := memory.RampRateBytes()
+			if err != nil {
+				return err
+			}
+			req.MemoryHoldSize = uint64(size)
+			req.MemoryRampRate = uint64(rate)
+		}
 	}
-	res, err := daemonClient.ExecStressors(ctx, &pb.ExecStressRequest{
-		Scope:     pb.ExecStressRequest_CONTAINER,
-		Target:    target,
-		Stressors: stressors,
-	})
+	res, err := daemonClient.ExecStressors(ctx, req)
 	if err != nil {
 		return err
 	}
 
-	instancesLock.Lock()
-	chaos.Status.Instances[key] = v1alpha1.StressInstance{
-		UID: res.Instance,
-		StartTime: &metav1.Time{
-			Time: time.Unix(res.StartTime/1000, (res.StartTime%1000)*int64(time.Millisecond)),
-		},
+	instance := v1alpha1.StressInstance{}
+	if len(res.Instance) != 0 {
+		instance.UID = res.Instance
+		instance.StartTime = millisecondsToTime(res.StartTime)
 	}
+	if len(res.MemoryInstance) != 0 {
+		instance.MemoryUID = res.MemoryInstance
+		instance.MemoryStartTime = millisecondsToTime(res.MemoryStartTime)
+	}
+
+	instancesLock.Lock()
+	chaos.Status.Instances[key] = instance
 	instancesLock.Unlock()
 	return nil
 }
 
+func millisecondsToTime(ms int64) *metav1.Time {
+	return &metav1.Time{
+		Time: time.Unix(ms/1000, (ms%1000)*int64(time.Millisecond)),
+	}
+}
+
+// timeToMilliseconds converts the time back to milliseconds, nil time is converted to 0
+func timeToMilliseconds(t *metav1.Time) int64 {
+	if t == nil {
+		return 0
+	}
+	return t.UnixNano() / int64(time.Millisecond)
+}
+
+// memoryLimit returns the memory limit of the container, or nil if it's not limited
+func memoryLimit(pod *v1.Pod, containerName string) *resource.Quantity {
+	for _, container := range pod.Spec.Containers {
+		if container.Name != containerName {
+			continue
+		}
+		if limit, ok := container.Resources.Limits[v1.ResourceMemory]; ok {
+			return &limit
+		}
+	}
+	return nil
+}
+
 func init() {
 	router.Register("stresschaos", &v1alpha1.StressChaos{}, func(obj runtime.Object) bool {
 		return true
@@ -82,10 +82,30 @@ func (c *MockChaosDaemonClient) ApplyIoChaos(ctx context.Context, in *chaosdaemo
 	return nil, mockError("ApplyIoChaos")
 }
 
+func (c *MockChaosDaemonClient) ApplyDiskChaos(ctx context.Context, in *chaosdaemon.DiskChaosRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return nil, mockError("ApplyDiskChaos")
+}
+
+func (c *MockChaosDaemonClient) RecoverDiskChaos(ctx context.Context, in *chaosdaemon.DiskChaosRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return nil, mockError("RecoverDiskChaos")
+}
+
 func (c *MockChaosDaemonClient) SetTcs(ctx context.Context, in *chaosdaemon.TcsRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
 	return nil, mockError("SetTcs")
 }
 
+func (c *MockChaosDaemonClient) StopKubelet(ctx context.Context, in *chaosdaemon.KubeletRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return nil, mockError("StopKubelet")
+}
+
+func (c *MockChaosDaemonClient) StartKubelet(ctx context.Context, in *chaosdaemon.KubeletRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return nil, mockError("StartKubelet")
+}
+
+func (c *MockChaosDaemonClient) RenewLease(ctx context.Context, in *chaosdaemon.LeaseRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return nil, mockError("RenewLease")
+}
+
 func (c *MockChaosDaemonClient) Close() error {
 	return mockError("CloseChaosDaemonClient")
 }
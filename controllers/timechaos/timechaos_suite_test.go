@@ -16,15 +16,19 @@ package timechaos
 import (
 	"context"
 	"errors"
+	"sync"
 	"testing"
 
 	"k8s.io/client-go/kubernetes/scheme"
 
+	"github.com/golang/protobuf/ptypes/empty"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
 
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -34,8 +38,10 @@ import (
 
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
 	. "github.com/chaos-mesh/chaos-mesh/controllers/test"
+	chaosdaemon "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
 	"github.com/chaos-mesh/chaos-mesh/pkg/mock"
 	ctx "github.com/chaos-mesh/chaos-mesh/pkg/router/context"
+	"github.com/chaos-mesh/chaos-mesh/pkg/utils"
 )
 
 func TestTimechaos(t *testing.T) {
@@ -50,6 +56,7 @@ var _ = BeforeSuite(func(done Done) {
 	logf.SetLogger(zap.LoggerTo(GinkgoWriter, true))
 
 	Expect(v1.AddToScheme(scheme.Scheme)).To(Succeed())
+	Expect(v1alpha1.AddToScheme(scheme.Scheme)).To(Succeed())
 
 	close(done)
 }, 60)
@@ -145,4 +152,104 @@ var _ = Describe("TimeChaos", func() {
 			Expect(err.Error()).To(ContainSubstring("RecoverTimeOffsetError"))
 		})
 	})
+
+	Context("TimeChaos merged on the same pod", func() {
+		podObjects, pods := GenerateNPods(
+			"p",
+			1,
+			v1.PodRunning,
+			metav1.NamespaceDefault,
+			nil,
+			map[string]string{"l1": "l1"},
+			v1.ContainerStatus{ContainerID: "fake-container-id"},
+		)
+
+		newTimeChaos := func(name, offset string) *v1alpha1.TimeChaos {
+			return &v1alpha1.TimeChaos{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "TimeChaos",
+					APIVersion: "v1",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: v1alpha1.TimeChaosSpec{
+					Mode:       v1alpha1.AllPodMode,
+					Selector:   v1alpha1.SelectorSpec{Namespaces: []string{metav1.NamespaceDefault}},
+					TimeOffset: offset,
+				},
+			}
+		}
+
+		It("should restore the time offset of the older TimeChaos after the newer one is recovered", func() {
+			older, newer := newTimeChaos("older", "-1h"), newTimeChaos("newer", "-2h")
+			objects := append(podObjects, older.DeepCopy(), newer.DeepCopy())
+			r := endpoint{
+				Context: ctx.Context{
+					Client:        fake.NewFakeClientWithScheme(scheme.Scheme, objects...),
+					EventRecorder: &record.FakeRecorder{},
+					Log:           ctrl.Log.WithName("controllers").WithName("TimeChaos"),
+				},
+			}
+
+			daemon := &timeOffsetRecorder{offsets: make(map[string]int64)}
+			defer mock.With("MockSelectAndFilterPods", func() []v1.Pod {
+				return pods
+			})()
+			defer mock.With("MockChaosDaemonClient", daemon)()
+
+			Expect(r.Apply(context.TODO(), ctrl.Request{}, older)).To(Succeed())
+			Expect(daemon.offset("fake-container-id")).To(Equal(int64(-3600)))
+			Expect(r.Apply(context.TODO(), ctrl.Request{}, newer)).To(Succeed())
+			Expect(daemon.offset("fake-container-id")).To(Equal(int64(-7200)))
+
+			var pod v1.Pod
+			key := types.NamespacedName{Namespace: pods[0].Namespace, Name: pods[0].Name}
+			leaseKey := utils.TargetLeaseAnnotationKey(v1alpha1.KindTimeChaos)
+			Expect(r.Client.Get(context.TODO(), key, &pod)).To(Succeed())
+			Expect(pod.Annotations[leaseKey]).To(Equal("default/older,default/newer"))
+
+			// the pod goes back to the offset of the older TimeChaos which still leases it
+			Expect(r.Recover(context.TODO(), ctrl.Request{}, newer)).To(Succeed())
+			Expect(newer.Finalizers).To(BeEmpty())
+			Expect(daemon.offset("fake-container-id")).To(Equal(int64(-3600)))
+			Expect(r.Client.Get(context.TODO(), key, &pod)).To(Succeed())
+			Expect(pod.Annotations[leaseKey]).To(Equal("default/older"))
+
+			Expect(r.Recover(context.TODO(), ctrl.Request{}, older)).To(Succeed())
+			Expect(daemon.offset("fake-container-id")).To(BeZero())
+			var released v1.Pod
+			Expect(r.Client.Get(context.TODO(), key, &released)).To(Succeed())
+			Expect(released.Annotations).ToNot(HaveKey(leaseKey))
+		})
+	})
 })
+
+// timeOffsetRecorder records the time offset of each container shifted by chaos-daemon
+type timeOffsetRecorder struct {
+	MockChaosDaemonClient
+
+	sync.Mutex
+	offsets map[string]int64
+}
+
+func (c *timeOffsetRecorder) SetTimeOffset(ctx context.Context, in *chaosdaemon.TimeRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	c.Lock()
+	defer c.Unlock()
+	c.offsets[in.ContainerId] = in.Sec
+	return nil, nil
+}
+
+func (c *timeOffsetRecorder) RecoverTimeOffset(ctx context.Context, in *chaosdaemon.TimeRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	c.Lock()
+	defer c.Unlock()
+	delete(c.offsets, in.ContainerId)
+	return nil, nil
+}
+
+func (c *timeOffsetRecorder) offset(containerID string) int64 {
+	c.Lock()
+	defer c.Unlock()
+	return c.offsets[containerID]
+}
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/go-logr/logr"
 	"github.com/hashicorp/go-multierror"
 	"golang.org/x/sync/errgroup"
 	v1 "k8s.io/api/core/v1"
@@ -27,6 +28,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/cache"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
 	"github.com/chaos-mesh/chaos-mesh/controllers/common"
@@ -55,16 +57,21 @@ func (r *endpoint) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1.I
 
 	timechaos.SetDefaultValue()
 
-	pods, err := utils.SelectAndFilterPods(ctx, r.Client, r.Reader, &timechaos.Spec)
+	seed := utils.NewSeed(timechaos.Spec.Seed)
+	timechaos.Status.Experiment.Seed = &seed
+	pods, err := utils.SelectAndFilterPods(ctx, r.Client, r.Reader, &timechaos.Spec, seed)
 
 	if err != nil {
 		r.Log.Error(err, "failed to select and filter pods")
 		return err
 	}
 
-	if err = r.applyAllPods(ctx, pods, timechaos); err != nil {
-		r.Log.Error(err, "failed to apply chaos on all pods")
-		return err
+	results := utils.NewInjectionResults(timechaos.Spec.FailurePolicy)
+	// the pods leased by other experiments are handled according to the conflict policy
+	leased, conflicts := utils.AcquireTargetLeases(ctx, r.Client, pods, timechaos, results)
+	err = r.applyAllPods(ctx, leased, timechaos, results)
+	if conflicts != nil {
+		err = multierror.Append(conflicts, err)
 	}
 
 	timechaos.Status.Experiment.PodRecords = make([]v1alpha1.PodStatus, 0, len(pods))
@@ -79,6 +86,11 @@ func (r *endpoint) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1.I
 
 		timechaos.Status.Experiment.PodRecords = append(timechaos.Status.Experiment.PodRecords, ps)
 	}
+	results.Fill(timechaos.Status.Experiment.PodRecords)
+	if err != nil {
+		r.Log.Error(err, "failed to apply chaos on all pods")
+		return err
+	}
 	r.Event(timechaos, v1.EventTypeNormal, utils.EventChaosInjected, "")
 	return nil
 }
@@ -127,12 +139,26 @@ func (r *endpoint) cleanFinalizersAndRecover(ctx context.Context, chaos *v1alpha
 			continue
 		}
 
+		err = utils.ReleaseTargetLease(ctx, r.Client, &pod, chaos)
+		if err != nil {
+			result = multierror.Append(result, err)
+			continue
+		}
+
 		err = r.recoverPod(ctx, &pod, chaos)
 		if err != nil {
 			result = multierror.Append(result, err)
 			continue
 		}
 
+		// the time offset is shared by the experiments merged on the pod, so the offsets
+		// of the remaining experiments are applied again after the recovery
+		err = r.reapplyOtherHolders(ctx, &pod, chaos)
+		if err != nil {
+			result = multierror.Append(result, err)
+			continue
+		}
+
 		chaos.Finalizers = utils.RemoveFromFinalizer(chaos.Finalizers, key)
 	}
 
@@ -145,6 +171,41 @@ func (r *endpoint) cleanFinalizersAndRecover(ctx context.Context, chaos *v1alpha
 	return result
 }
 
+// reapplyOtherHolders shifts the time of the pod with the other TimeChaos leasing it, in the order
+// they leased the pod, so that the offset of the latest one takes effect
+func (r *endpoint) reapplyOtherHolders(ctx context.Context, pod *v1.Pod, chaos *v1alpha1.TimeChaos) error {
+	others, err := utils.OtherTargetLeaseHolders(ctx, r.Client, pod, chaos)
+	if err != nil {
+		return err
+	}
+
+	for _, holder := range others {
+		ns, name, err := cache.SplitMetaNamespaceKey(holder)
+		if err != nil {
+			return err
+		}
+
+		var other v1alpha1.TimeChaos
+		err = r.Client.Get(ctx, types.NamespacedName{
+			Namespace: ns,
+			Name:      name,
+		}, &other)
+		if err != nil {
+			if k8serror.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+
+		r.Log.Info("Shift time on pod again with other TimeChaos", "namespace", pod.Namespace, "name", pod.Name, "chaos", holder)
+		if err := r.applyPod(ctx, pod, &other); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (r *endpoint) recoverPod(ctx context.Context, pod *v1.Pod, chaos *v1alpha1.TimeChaos) error {
 	r.Log.Info("Try to recover pod", "namespace", pod.Namespace, "name", pod.Name)
 
@@ -199,7 +260,16 @@ func (r *endpoint) Object() v1alpha1.InnerObject {
 	return &v1alpha1.TimeChaos{}
 }
 
-func (r *endpoint) applyAllPods(ctx context.Context, pods []v1.Pod, chaos *v1alpha1.TimeChaos) error {
+// Reflush shifts the time of the pod again with the spec of the chaos, it restores the time offset
+// recovered by chaos-daemon after the lease on it expired
+func Reflush(ctx context.Context, c client.Client, log logr.Logger, pod *v1.Pod, chaos *v1alpha1.TimeChaos) error {
+	r := &endpoint{}
+	r.Client = c
+	r.Log = log
+	return r.applyPod(ctx, pod, chaos)
+}
+
+func (r *endpoint) applyAllPods(ctx context.Context, pods []v1.Pod, chaos *v1alpha1.TimeChaos, results *utils.InjectionResults) error {
 	g := errgroup.Group{}
 	for index := range pods {
 		pod := &pods[index]
@@ -211,7 +281,9 @@ func (r *endpoint) applyAllPods(ctx context.Context, pods []v1.Pod, chaos *v1alp
 		chaos.Finalizers = utils.InsertFinalizer(chaos.Finalizers, key)
 
 		g.Go(func() error {
-			return r.applyPod(ctx, pod, chaos)
+			return results.Inject(pod.Namespace, pod.Name, func() error {
+				return r.applyPod(ctx, pod, chaos)
+			})
 		})
 	}
 
@@ -266,10 +338,11 @@ func (r *endpoint) applyContainer(ctx context.Context, client chaosdaemon.ChaosD
 
 	r.Log.Info("setting time shift", "mask", mask, "sec", sec, "nsec", nsec)
 	_, err = client.SetTimeOffset(ctx, &chaosdaemon.TimeRequest{
-		ContainerId: containerID,
-		Sec:         sec,
-		Nsec:        nsec,
-		ClkIdsMask:  mask,
+		ContainerId:   containerID,
+		Sec:           sec,
+		Nsec:          nsec,
+		ClkIdsMask:    mask,
+		LeaseDeadline: utils.LeaseDeadline(),
 	})
 
 	return err
@@ -117,6 +117,11 @@ func (in *fakeTwoPhaseChaos) IsPaused() bool {
 	return false
 }
 
+// IsDryRun returns whether this resource is only previewed without being injected
+func (in *fakeTwoPhaseChaos) IsDryRun() bool {
+	return false
+}
+
 func (r fakeEndpoint) Object() v1alpha1.InnerObject {
 	return &fakeTwoPhaseChaos{}
 }
@@ -399,6 +404,97 @@ var _ = Describe("TwoPhase", func() {
 			Expect(_chaos.(v1alpha1.InnerSchedulerObject).GetStatus().Experiment.Phase).To(Equal(v1alpha1.ExperimentPhaseRunning))
 		})
 
+		It("TwoPhase ToApply with randomization", func() {
+			chaos := fakeTwoPhaseChaos{
+				TypeMeta:   typeMeta,
+				ObjectMeta: objectMeta,
+				Scheduler: &v1alpha1.SchedulerSpec{
+					Cron:           "@hourly",
+					Jitter:         "10m",
+					RandomDuration: &v1alpha1.DurationRange{Min: "1m", Max: "5m"},
+				},
+			}
+
+			chaos.SetNextStart(pastTime)
+
+			c := fake.NewFakeClientWithScheme(scheme.Scheme, &chaos)
+
+			r := Reconciler{
+				Endpoint: fakeEndpoint{},
+				Context: ctx.Context{
+					Client: c,
+					Log:    ctrl.Log.WithName("controllers").WithName("TwoPhase"),
+				},
+			}
+
+			_, err = r.Reconcile(req)
+
+			Expect(err).ToNot(HaveOccurred())
+			_chaos := r.Object()
+			err = r.Client.Get(context.TODO(), req.NamespacedName, _chaos)
+			Expect(err).ToNot(HaveOccurred())
+
+			schedulerChaos := _chaos.(v1alpha1.InnerSchedulerObject)
+			status := schedulerChaos.GetStatus()
+			Expect(status.Experiment.Phase).To(Equal(v1alpha1.ExperimentPhaseRunning))
+
+			duration, err := time.ParseDuration(status.Scheduler.Duration)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(duration).To(BeNumerically(">=", time.Minute))
+			Expect(duration).To(BeNumerically("<=", 5*time.Minute))
+			// the times are serialized in seconds
+			Expect(schedulerChaos.GetNextRecover().Sub(status.Experiment.StartTime.Time)).To(BeNumerically("~", duration, time.Second))
+
+			offset, err := time.ParseDuration(status.Scheduler.StartOffset)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(offset).To(BeNumerically(">=", 0))
+			Expect(offset).To(BeNumerically("<", 10*time.Minute))
+			Expect(status.Scheduler.ScheduledStart).ToNot(BeNil())
+			Expect(schedulerChaos.GetNextStart().Sub(status.Scheduler.ScheduledStart.Time)).To(BeNumerically("~", offset, time.Second))
+		})
+
+		It("TwoPhase Skip", func() {
+			never := int32(0)
+			chaos := fakeTwoPhaseChaos{
+				TypeMeta:   typeMeta,
+				ObjectMeta: objectMeta,
+				Scheduler:  &v1alpha1.SchedulerSpec{Cron: "@hourly", Probability: &never},
+			}
+
+			chaos.SetNextStart(pastTime)
+
+			c := fake.NewFakeClientWithScheme(scheme.Scheme, &chaos)
+
+			r := Reconciler{
+				Endpoint: fakeEndpoint{},
+				Context: ctx.Context{
+					Client: c,
+					Log:    ctrl.Log.WithName("controllers").WithName("TwoPhase"),
+				},
+			}
+
+			_, err = r.Reconcile(req)
+
+			Expect(err).ToNot(HaveOccurred())
+			_chaos := r.Object()
+			err = r.Client.Get(context.TODO(), req.NamespacedName, _chaos)
+			Expect(err).ToNot(HaveOccurred())
+
+			schedulerChaos := _chaos.(v1alpha1.InnerSchedulerObject)
+			status := schedulerChaos.GetStatus()
+			Expect(status.Experiment.Phase).ToNot(Equal(v1alpha1.ExperimentPhaseRunning))
+			Expect(status.Experiment.StartTime).To(BeNil())
+			Expect(status.Scheduler.Skipped).To(Equal(int64(1)))
+			Expect(status.Scheduler.LastSkipped).ToNot(BeNil())
+			Expect(schedulerChaos.GetNextStart().After(time.Now())).To(BeTrue())
+
+			// the skipped start is not taken as a modification of the scheduler
+			result, err := r.Reconcile(req)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+			Expect(result.RequeueAfter).To(BeNumerically("<=", time.Hour))
+		})
+
 		It("TwoPhase ToApplyAgain", func() {
 			chaos := fakeTwoPhaseChaos{
 				TypeMeta:   typeMeta,
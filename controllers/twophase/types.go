@@ -16,6 +16,7 @@ package twophase
 import (
 	"context"
 	"fmt"
+	"math/rand"
 	"time"
 
 	"k8s.io/client-go/util/retry"
@@ -150,51 +151,116 @@ func (r *Reconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 
 		status.FailedMessage = emptyString
 	} else if chaos.GetNextStart().Before(now) {
-		r.Log.Info("Starting")
-
-		tempStart, err := utils.NextTime(*chaos.GetScheduler(), now)
+		calendar, err := utils.NewCalendar(ctx, r.Client, req.Namespace, *scheduler)
 		if err != nil {
-			r.Log.Error(err, "failed to calculate the start time")
+			r.Log.Error(err, "failed to get the calendar of the scheduler")
 			updateFailedMessage(ctx, r, chaos, err.Error())
 			return ctrl.Result{}, err
 		}
 
-		tempRecover := now.Add(*duration)
-		if tempStart.Before(tempRecover) {
-			err := fmt.Errorf("nextRecover shouldn't be later than nextStart")
-			r.Log.Error(err, "Then recover can never be reached.", "scheduler", *chaos.GetScheduler(), "duration", *duration)
-			updateFailedMessage(ctx, r, chaos, err.Error())
-			return ctrl.Result{}, err
+		// The start outside the allowed windows or in a blackout is postponed to the next allowed scheduled time.
+		// NextStart is kept, so that the chaos is started once the time is allowed.
+		if allowed, reason := calendar.Allowed(now); !allowed {
+			postponed, err := utils.NextAllowedTime(*scheduler, calendar, now)
+			if err != nil {
+				r.Log.Error(err, "failed to calculate the allowed start time")
+				updateFailedMessage(ctx, r, chaos, err.Error())
+				return ctrl.Result{}, err
+			}
+
+			r.Log.Info("Postponing the start", "reason", reason, "until", *postponed)
+			return ctrl.Result{RequeueAfter: postponed.Sub(now)}, nil
 		}
 
-		if err = applyAction(ctx, r, req, *duration, chaos); err != nil {
-			updateFailedMessage(ctx, r, chaos, err.Error())
-			return ctrl.Result{Requeue: true}, err
+		rnd := rand.New(rand.NewSource(now.UnixNano()))
+
+		if !utils.Fire(*scheduler, rnd) {
+			r.Log.Info("Skipping the start", "probability", *scheduler.Probability)
+
+			nextStart, err := utils.NextAllowedTime(*scheduler, calendar, now)
+			if err != nil {
+				r.Log.Error(err, "failed to get the next start time")
+				updateFailedMessage(ctx, r, chaos, err.Error())
+				return ctrl.Result{}, err
+			}
+			if err = scheduleNextStart(chaos, *nextStart, rnd); err != nil {
+				r.Log.Error(err, "failed to get the random offset of the next start")
+				updateFailedMessage(ctx, r, chaos, err.Error())
+				return ctrl.Result{}, err
+			}
+
+			status.Scheduler.Skipped++
+			status.Scheduler.LastSkipped = &metav1.Time{Time: now}
+			status.FailedMessage = emptyString
+		} else {
+			r.Log.Info("Starting")
+
+			roundDuration, err := utils.RandomDuration(*scheduler, *duration, rnd)
+			if err != nil {
+				r.Log.Error(err, "failed to get the random duration")
+				updateFailedMessage(ctx, r, chaos, err.Error())
+				return ctrl.Result{}, err
+			}
+
+			tempStart, err := utils.NextTime(*scheduler, now)
+			if err != nil {
+				r.Log.Error(err, "failed to calculate the start time")
+				updateFailedMessage(ctx, r, chaos, err.Error())
+				return ctrl.Result{}, err
+			}
+
+			tempRecover := now.Add(roundDuration)
+			if tempStart.Before(tempRecover) {
+				err := fmt.Errorf("nextRecover shouldn't be later than nextStart")
+				r.Log.Error(err, "Then recover can never be reached.", "scheduler", *scheduler, "duration", roundDuration)
+				updateFailedMessage(ctx, r, chaos, err.Error())
+				return ctrl.Result{}, err
+			}
+
+			if err = applyAction(ctx, r, req, roundDuration, chaos); err != nil {
+				updateFailedMessage(ctx, r, chaos, err.Error())
+				return ctrl.Result{Requeue: true}, err
+			}
+
+			nextStart, err := utils.NextAllowedTime(*scheduler, calendar, status.Experiment.StartTime.Time)
+			if err != nil {
+				r.Log.Error(err, "failed to get the next start time")
+				return ctrl.Result{}, err
+			}
+			if err = scheduleNextStart(chaos, *nextStart, rnd); err != nil {
+				r.Log.Error(err, "failed to get the random offset of the next start")
+				return ctrl.Result{}, err
+			}
+			chaos.SetNextRecover(status.Experiment.StartTime.Time.Add(roundDuration))
+
+			if scheduler.RandomDuration != nil {
+				status.Scheduler.Duration = roundDuration.String()
+			}
+			status.FailedMessage = emptyString
 		}
+	} else {
+		r.Log.Info("Waiting")
 
-		nextStart, err := utils.NextTime(*chaos.GetScheduler(), status.Experiment.StartTime.Time)
+		calendar, err := utils.NewCalendar(ctx, r.Client, req.Namespace, *scheduler)
 		if err != nil {
-			r.Log.Error(err, "failed to get the next start time")
+			r.Log.Error(err, "failed to get the calendar of the scheduler")
 			return ctrl.Result{}, err
 		}
-		nextRecover := status.Experiment.StartTime.Time.Add(*duration)
 
-		chaos.SetNextStart(*nextStart)
-		chaos.SetNextRecover(nextRecover)
-		status.FailedMessage = emptyString
-	} else {
-		r.Log.Info("Waiting")
-
-		nextStart, err := utils.NextTime(*chaos.GetScheduler(), status.Experiment.StartTime.Time)
+		nextStart, err := utils.NextAllowedTime(*scheduler, calendar, lastScheduled(status))
 		if err != nil {
 			r.Log.Error(err, "failed to get next start time")
 			return ctrl.Result{}, err
 		}
 		nextTime := chaos.GetNextStart()
+		scheduled := nextTime
+		if status.Scheduler.ScheduledStart != nil {
+			scheduled = status.Scheduler.ScheduledStart.Time
+		}
 
-		// if nextStart is not equal to nextTime, the scheduler may have been modified.
+		// if nextStart is not equal to nextTime, the scheduler or the calendar may have been modified.
 		// So set nextStart to time.Now.
-		if nextStart.Equal(nextTime) {
+		if nextStart.Equal(scheduled) {
 			if !chaos.GetNextRecover().IsZero() && chaos.GetNextRecover().Before(nextTime) {
 				nextTime = chaos.GetNextRecover()
 			}
@@ -252,6 +318,39 @@ func applyAction(
 	return nil
 }
 
+// scheduleNextStart sets the next start to the scheduled time, delayed by a random offset within the jitter
+func scheduleNextStart(chaos v1alpha1.InnerSchedulerObject, scheduled time.Time, rnd *rand.Rand) error {
+	scheduler := chaos.GetScheduler()
+	offset, err := utils.RandomOffset(*scheduler, rnd)
+	if err != nil {
+		return err
+	}
+
+	status := chaos.GetStatus()
+	chaos.SetNextStart(scheduled.Add(offset))
+	if scheduler.Jitter != "" {
+		status.Scheduler.ScheduledStart = &metav1.Time{Time: scheduled}
+		status.Scheduler.StartOffset = offset.String()
+	} else {
+		status.Scheduler.ScheduledStart = nil
+		status.Scheduler.StartOffset = emptyString
+	}
+	return nil
+}
+
+// lastScheduled returns the last time when the chaos was started or skipped,
+// the next start is scheduled after it
+func lastScheduled(status *v1alpha1.ChaosStatus) time.Time {
+	var last time.Time
+	if status.Experiment.StartTime != nil {
+		last = status.Experiment.StartTime.Time
+	}
+	if status.Scheduler.LastSkipped != nil && status.Scheduler.LastSkipped.After(last) {
+		last = status.Scheduler.LastSkipped.Time
+	}
+	return last
+}
+
 func updateFailedMessage(
 	ctx context.Context,
 	r *Reconciler,
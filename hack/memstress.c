@@ -0,0 +1,77 @@
+#include <signal.h>
+#include <unistd.h>
+#include <stdio.h>
+#include <stdlib.h>
+#include <string.h>
+#include <errno.h>
+#include <time.h>
+#include <sys/mman.h>
+
+// memstress allocates exactly <size> bytes of memory and holds it until it gets killed.
+// If <rate> is not zero, the memory is allocated at <rate> bytes per second.
+//
+// Usage: memstress <size> [rate]
+
+#define STEP_INTERVAL_MS 100
+
+static void sleep_ms(long ms) {
+  struct timespec ts = {ms / 1000, (ms % 1000) * 1000000};
+  while (nanosleep(&ts, &ts) == -1 && errno == EINTR) {}
+}
+
+int main(int argc, char* argv[]) {
+  if (argc < 2) {
+    fprintf(stderr, "usage: %s <size> [rate]\n", argv[0]);
+    return -1;
+  }
+
+  unsigned long long size = strtoull(argv[1], NULL, 10);
+  unsigned long long rate = argc > 2 ? strtoull(argv[2], NULL, 10) : 0;
+  if (size == 0) {
+    fprintf(stderr, "illegal size %s\n", argv[1]);
+    return -1;
+  }
+
+  // Let the OOM killer choose this process rather than the processes of the application,
+  // when the memory of the cgroup is exhausted.
+  FILE* oom = fopen("/proc/self/oom_score_adj", "w");
+  if (oom == NULL || fprintf(oom, "1000") < 0 || fclose(oom) != 0) {
+    fprintf(stderr, "fail to set oom_score_adj %s\n", strerror(errno));
+  }
+
+  char* mem = mmap(NULL, size, PROT_READ | PROT_WRITE, MAP_PRIVATE | MAP_ANONYMOUS, -1, 0);
+  if (mem == MAP_FAILED) {
+    fprintf(stderr, "fail to mmap %s\n", strerror(errno));
+    return -1;
+  }
+
+  long page_size = sysconf(_SC_PAGESIZE);
+  unsigned long long step = size;
+  if (rate != 0) {
+    step = rate * STEP_INTERVAL_MS / 1000;
+    if (step < (unsigned long long)page_size) {
+      step = page_size;
+    }
+  }
+
+  // The pages are written one by one, so that they are really allocated.
+  for (unsigned long long allocated = 0; allocated < size;) {
+    unsigned long long end = allocated + step;
+    if (end > size) {
+      end = size;
+    }
+    for (; allocated < end; allocated += page_size) {
+      mem[allocated] = 1;
+    }
+    allocated = end;
+
+    if (rate != 0 && allocated < size) {
+      sleep_ms(STEP_INTERVAL_MS);
+    }
+  }
+
+  for (;;) {
+    pause();
+  }
+  return 0;
+}
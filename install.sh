@@ -924,6 +924,15 @@ rules:
   - apiGroups: [ "" ]
     resources: [ "pods" ]
     verbs: [ "get", "list", "watch", "delete", "update" ]
+  - apiGroups: [ "" ]
+    resources: [ "pods/eviction" ]
+    verbs: [ "create" ]
+  - apiGroups: [ "apps" ]
+    resources: [ "replicasets" ]
+    verbs: [ "get", "list", "watch" ]
+  - apiGroups: [ "apps" ]
+    resources: [ "deployments", "statefulsets" ]
+    verbs: [ "get", "list", "watch", "update" ]
   - apiGroups:
       - ""
     resources:
@@ -952,8 +961,19 @@ rules:
   - apiGroups: [ "" ]
     resources:
       - namespaces
+    verbs: [ "get", "list", "watch" ]
+  - apiGroups: [ "" ]
+    resources:
       - nodes
+    verbs: [ "get", "list", "watch", "update", "patch" ]
+  - apiGroups: [ "chaos-mesh.org" ]
+    resources:
+      - chaospolicies
     verbs: [ "get", "list", "watch" ]
+  - apiGroups: [ "chaos-mesh.org" ]
+    resources:
+      - chaoskillswitches
+    verbs: [ "get", "list", "watch", "create", "update" ]
 ---
 # Source: chaos-mesh/templates/controller-manager-rbac.yaml
 # bindings cluster level
@@ -1265,6 +1285,8 @@ spec:
             value: "app.kubernetes.io/component:template"
           - name: CONFIGMAP_LABELS
             value: "app.kubernetes.io/component:webhook"
+          - name: ENABLE_FILTER_NAMESPACE
+            value: "false"
           - name: PPROF_ADDR
             value: ":10081"
         volumeMounts:
@@ -1471,6 +1493,42 @@ webhooks:
           - UPDATE
         resources:
           - dnschaos
+  - clientConfig:
+      caBundle: "${CA_BUNDLE}"
+      service:
+        name: chaos-mesh-controller-manager
+        namespace: chaos-testing
+        path: /mutate-chaos-mesh-org-v1alpha1-diskchaos
+    failurePolicy: Fail
+    name: mdiskchaos.kb.io
+    rules:
+      - apiGroups:
+          - chaos-mesh.org
+        apiVersions:
+          - v1alpha1
+        operations:
+          - CREATE
+          - UPDATE
+        resources:
+          - diskchaos
+  - clientConfig:
+      caBundle: "${CA_BUNDLE}"
+      service:
+        name: chaos-mesh-controller-manager
+        namespace: chaos-testing
+        path: /mutate-chaos-mesh-org-v1alpha1-nodechaos
+    failurePolicy: Fail
+    name: mnodechaos.kb.io
+    rules:
+      - apiGroups:
+          - chaos-mesh.org
+        apiVersions:
+          - v1alpha1
+        operations:
+          - CREATE
+          - UPDATE
+        resources:
+          - nodechaos
 ---
 # Source: chaos-mesh/templates/webhook-configuration.yaml
 apiVersion: admissionregistration.k8s.io/v1beta1
@@ -1626,6 +1684,42 @@ webhooks:
           - UPDATE
         resources:
           - dnschaos
+  - clientConfig:
+      caBundle: "${CA_BUNDLE}"
+      service:
+        name: chaos-mesh-controller-manager
+        namespace: chaos-testing
+        path: /validate-chaos-mesh-org-v1alpha1-diskchaos
+    failurePolicy: Fail
+    name: vdiskchaos.kb.io
+    rules:
+      - apiGroups:
+          - chaos-mesh.org
+        apiVersions:
+          - v1alpha1
+        operations:
+          - CREATE
+          - UPDATE
+        resources:
+          - diskchaos
+  - clientConfig:
+      caBundle: "${CA_BUNDLE}"
+      service:
+        name: chaos-mesh-controller-manager
+        namespace: chaos-testing
+        path: /validate-chaos-mesh-org-v1alpha1-nodechaos
+    failurePolicy: Fail
+    name: vnodechaos.kb.io
+    rules:
+      - apiGroups:
+          - chaos-mesh.org
+        apiVersions:
+          - v1alpha1
+        operations:
+          - CREATE
+          - UPDATE
+        resources:
+          - nodechaos
 EOF
     # chaos-mesh.yaml end
 }
@@ -20,6 +20,8 @@ import (
 	"github.com/chaos-mesh/chaos-mesh/pkg/apiserver/common"
 	"github.com/chaos-mesh/chaos-mesh/pkg/apiserver/event"
 	"github.com/chaos-mesh/chaos-mesh/pkg/apiserver/experiment"
+	"github.com/chaos-mesh/chaos-mesh/pkg/apiserver/killswitch"
+	"github.com/chaos-mesh/chaos-mesh/pkg/apiserver/template"
 )
 
 var handlerModule = fx.Options(
@@ -28,11 +30,15 @@ var handlerModule = fx.Options(
 		experiment.NewService,
 		event.NewService,
 		archive.NewService,
+		killswitch.NewService,
+		template.NewService,
 	),
 	fx.Invoke(
 		common.Register,
 		experiment.Register,
 		event.Register,
 		archive.Register,
+		killswitch.Register,
+		template.Register,
 	),
 )
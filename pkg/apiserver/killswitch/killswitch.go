@@ -0,0 +1,170 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package killswitch
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/apiserver/utils"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Service defines a handler service for the kill switch.
+type Service struct {
+	kubeCli client.Client
+}
+
+// NewService returns a kill switch service instance.
+func NewService(cli client.Client) *Service {
+	return &Service{
+		kubeCli: cli,
+	}
+}
+
+// Register mounts our HTTP handler on the mux.
+func Register(r *gin.RouterGroup, s *Service) {
+	endpoint := r.Group("/killswitch")
+
+	endpoint.GET("", s.get)
+	endpoint.PUT("/trip", s.trip)
+	endpoint.PUT("/reset", s.reset)
+}
+
+// KillSwitch defines the state of a kill switch.
+type KillSwitch struct {
+	Name    string `json:"name"`
+	Enabled bool   `json:"enabled"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+// State defines whether all the chaos experiments are stopped by the kill switches.
+type State struct {
+	Enabled      bool         `json:"enabled"`
+	KillSwitches []KillSwitch `json:"kill_switches"`
+}
+
+// TripRequest defines the request to trip the kill switch.
+type TripRequest struct {
+	Reason string `json:"reason"`
+}
+
+// @Summary Get the state of the kill switches.
+// @Description Get the state of the kill switches. All the chaos experiments are stopped if any of them is enabled.
+// @Tags killswitch
+// @Produce json
+// @Success 200 {object} State
+// @Router /killswitch [get]
+// @Failure 500 {object} utils.APIError
+func (s *Service) get(c *gin.Context) {
+	var killSwitches v1alpha1.ChaosKillSwitchList
+	if err := s.kubeCli.List(context.Background(), &killSwitches); err != nil {
+		c.Status(http.StatusInternalServerError)
+		_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
+		return
+	}
+
+	state := State{
+		Enabled:      killSwitches.Enabled() != nil,
+		KillSwitches: make([]KillSwitch, 0, len(killSwitches.Items)),
+	}
+	for _, killSwitch := range killSwitches.Items {
+		state.KillSwitches = append(state.KillSwitches, KillSwitch{
+			Name:    killSwitch.Name,
+			Enabled: killSwitch.Spec.Enabled,
+			Reason:  killSwitch.Spec.Reason,
+		})
+	}
+
+	c.JSON(http.StatusOK, state)
+}
+
+// @Summary Trip the kill switch.
+// @Description Trip the kill switch to recover all the running chaos experiments and stop applying chaos.
+// @Tags killswitch
+// @Produce json
+// @Param request body TripRequest false "Request body"
+// @Success 200 {object} KillSwitch
+// @Router /killswitch/trip [put]
+// @Failure 500 {object} utils.APIError
+func (s *Service) trip(c *gin.Context) {
+	req := &TripRequest{}
+	// the reason is optional, so the kill switch is tripped even if the body is empty
+	_ = c.ShouldBindJSON(req)
+
+	killSwitch, err := s.setEnabled(true, req.Reason)
+	if err != nil {
+		c.Status(http.StatusInternalServerError)
+		_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, killSwitch)
+}
+
+// @Summary Reset the kill switch.
+// @Description Reset the kill switch tripped by the dashboard, the stopped chaos experiments are resumed if no other kill switch is enabled.
+// @Tags killswitch
+// @Produce json
+// @Success 200 {object} KillSwitch
+// @Router /killswitch/reset [put]
+// @Failure 500 {object} utils.APIError
+func (s *Service) reset(c *gin.Context) {
+	killSwitch, err := s.setEnabled(false, "")
+	if err != nil {
+		c.Status(http.StatusInternalServerError)
+		_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, killSwitch)
+}
+
+// setEnabled creates or updates the kill switch of the dashboard
+func (s *Service) setEnabled(enabled bool, reason string) (*KillSwitch, error) {
+	ctx := context.Background()
+
+	var killSwitch v1alpha1.ChaosKillSwitch
+	err := s.kubeCli.Get(ctx, types.NamespacedName{Name: v1alpha1.DefaultChaosKillSwitchName}, &killSwitch)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	killSwitch.Spec = v1alpha1.ChaosKillSwitchSpec{
+		Enabled: enabled,
+		Reason:  reason,
+	}
+	if apierrors.IsNotFound(err) {
+		killSwitch.ObjectMeta = metav1.ObjectMeta{Name: v1alpha1.DefaultChaosKillSwitchName}
+		err = s.kubeCli.Create(ctx, &killSwitch)
+	} else {
+		err = s.kubeCli.Update(ctx, &killSwitch)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &KillSwitch{
+		Name:    killSwitch.Name,
+		Enabled: killSwitch.Spec.Enabled,
+		Reason:  killSwitch.Spec.Reason,
+	}, nil
+}
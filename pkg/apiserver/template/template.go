@@ -0,0 +1,201 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package template
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/apiserver/utils"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Service defines a handler service for chaos templates.
+type Service struct {
+	kubeCli client.Client
+}
+
+// NewService returns a chaos template service instance.
+func NewService(cli client.Client) *Service {
+	return &Service{
+		kubeCli: cli,
+	}
+}
+
+// Register mounts our HTTP handler on the mux.
+func Register(r *gin.RouterGroup, s *Service) {
+	endpoint := r.Group("/templates")
+
+	endpoint.GET("", s.listTemplates)
+	endpoint.GET("/detail/:namespace/:name", s.getTemplate)
+	endpoint.POST("/instantiate/:namespace/:name", s.instantiateTemplate)
+}
+
+// Template defines the basic information of a chaos template.
+type Template struct {
+	Name        string                       `json:"name"`
+	Namespace   string                       `json:"namespace"`
+	Kind        string                       `json:"kind"`
+	Description string                       `json:"description,omitempty"`
+	Parameters  []v1alpha1.TemplateParameter `json:"parameters,omitempty"`
+}
+
+// InstantiateRequest defines the request to instantiate a chaos template.
+type InstantiateRequest struct {
+	Name string `json:"name" binding:"required"`
+	// Namespace is the namespace of the chaos, the namespace of the template by default
+	Namespace  string            `json:"namespace"`
+	Parameters map[string]string `json:"parameters"`
+}
+
+// Instance defines the chaos instantiated from a chaos template.
+type Instance struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Kind      string `json:"kind"`
+}
+
+// @Summary Get chaos templates from Kubernetes cluster.
+// @Description Get chaos templates from Kubernetes cluster.
+// @Tags templates
+// @Produce json
+// @Param namespace query string false "namespace"
+// @Success 200 {array} Template
+// @Router /templates [get]
+// @Failure 500 {object} utils.APIError
+func (s *Service) listTemplates(c *gin.Context) {
+	var templates v1alpha1.ChaosTemplateList
+	if err := s.kubeCli.List(context.Background(), &templates, client.InNamespace(c.Query("namespace"))); err != nil {
+		c.Status(http.StatusInternalServerError)
+		_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
+		return
+	}
+
+	result := make([]Template, 0, len(templates.Items))
+	for _, template := range templates.Items {
+		result = append(result, Template{
+			Name:        template.Name,
+			Namespace:   template.Namespace,
+			Kind:        template.Spec.Kind,
+			Description: template.Spec.Description,
+			Parameters:  template.Spec.Parameters,
+		})
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// @Summary Get the specified chaos template.
+// @Description Get the specified chaos template.
+// @Tags templates
+// @Produce json
+// @Param namespace path string true "namespace"
+// @Param name path string true "name"
+// @Success 200 {object} v1alpha1.ChaosTemplate
+// @Router /templates/detail/{namespace}/{name} [get]
+// @Failure 404 {object} utils.APIError
+// @Failure 500 {object} utils.APIError
+func (s *Service) getTemplate(c *gin.Context) {
+	template, ok := s.fetchTemplate(c)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, template)
+}
+
+// @Summary Instantiate the specified chaos template into a chaos experiment.
+// @Description Instantiate the specified chaos template with the values of its parameters, and create the chaos experiment.
+// @Tags templates
+// @Produce json
+// @Param namespace path string true "namespace"
+// @Param name path string true "name"
+// @Param request body InstantiateRequest true "Request body"
+// @Success 200 {object} Instance
+// @Router /templates/instantiate/{namespace}/{name} [post]
+// @Failure 400 {object} utils.APIError
+// @Failure 404 {object} utils.APIError
+// @Failure 409 {object} utils.APIError
+// @Failure 500 {object} utils.APIError
+func (s *Service) instantiateTemplate(c *gin.Context) {
+	req := &InstantiateRequest{}
+	if err := c.ShouldBindJSON(req); err != nil {
+		c.Status(http.StatusBadRequest)
+		_ = c.Error(utils.ErrInvalidRequest.WrapWithNoMessage(err))
+		return
+	}
+
+	template, ok := s.fetchTemplate(c)
+	if !ok {
+		return
+	}
+
+	namespace := req.Namespace
+	if namespace == "" {
+		namespace = template.Namespace
+	}
+
+	chaos, err := template.Instantiate(req.Name, namespace, req.Parameters)
+	if err != nil {
+		c.Status(http.StatusBadRequest)
+		_ = c.Error(utils.ErrInvalidRequest.WrapWithNoMessage(err))
+		return
+	}
+
+	if err := s.kubeCli.Create(context.Background(), chaos); err != nil {
+		switch {
+		case apierrors.IsAlreadyExists(err):
+			c.Status(http.StatusConflict)
+			_ = c.Error(utils.ErrInvalidRequest.WrapWithNoMessage(err))
+		case apierrors.IsForbidden(err), apierrors.IsInvalid(err):
+			// the chaos is denied by the admission webhooks or the validation of its spec
+			c.Status(http.StatusBadRequest)
+			_ = c.Error(utils.ErrInvalidRequest.WrapWithNoMessage(err))
+		default:
+			c.Status(http.StatusInternalServerError)
+			_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, Instance{
+		Name:      req.Name,
+		Namespace: namespace,
+		Kind:      template.Spec.Kind,
+	})
+}
+
+// fetchTemplate gets the template in the path, and writes the error into the context if it fails
+func (s *Service) fetchTemplate(c *gin.Context) (*v1alpha1.ChaosTemplate, bool) {
+	var template v1alpha1.ChaosTemplate
+	key := types.NamespacedName{Namespace: c.Param("namespace"), Name: c.Param("name")}
+	if err := s.kubeCli.Get(context.Background(), key, &template); err != nil {
+		if apierrors.IsNotFound(err) {
+			c.Status(http.StatusNotFound)
+			_ = c.Error(utils.ErrNotFound.New("the template is not found"))
+		} else {
+			c.Status(http.StatusInternalServerError)
+			_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
+		}
+		return nil, false
+	}
+
+	return &template, true
+}
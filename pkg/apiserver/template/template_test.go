@@ -0,0 +1,215 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package template
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/gomega"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/apiserver/utils"
+)
+
+// createErrorClient returns the error on creating objects
+type createErrorClient struct {
+	client.Client
+	err error
+}
+
+func (c *createErrorClient) Create(context.Context, runtime.Object, ...client.CreateOption) error {
+	return c.err
+}
+
+func newTemplateClient(g *WithT, objects ...runtime.Object) client.Client {
+	scheme := runtime.NewScheme()
+	g.Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+	g.Expect(v1alpha1.AddToScheme(scheme)).To(Succeed())
+
+	template := &v1alpha1.ChaosTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "pod-failure", Namespace: metav1.NamespaceDefault},
+		Spec: v1alpha1.ChaosTemplateSpec{
+			Kind:        v1alpha1.KindPodChaos,
+			Description: "make a pod unavailable",
+			Parameters:  []v1alpha1.TemplateParameter{{Name: "duration", Type: v1alpha1.DurationParameterType}},
+			Template: runtime.RawExtension{Raw: []byte(`{
+				"action": "pod-failure",
+				"mode": "one",
+				"selector": {"namespaces": ["default"]},
+				"duration": "$(duration)"
+			}`)},
+		},
+	}
+	return fake.NewFakeClientWithScheme(scheme, append(objects, template)...)
+}
+
+func serve(cli client.Client, method, path, body string) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(utils.MWHandleErrors())
+	Register(r.Group("/api"), NewService(cli))
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestListTemplates(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	w := serve(newTemplateClient(g), http.MethodGet, "/api/templates?namespace=default", "")
+	g.Expect(w.Code).To(Equal(http.StatusOK))
+
+	var templates []Template
+	g.Expect(json.Unmarshal(w.Body.Bytes(), &templates)).To(Succeed())
+	g.Expect(templates).To(HaveLen(1))
+	g.Expect(templates[0].Name).To(Equal("pod-failure"))
+	g.Expect(templates[0].Kind).To(Equal(v1alpha1.KindPodChaos))
+	g.Expect(templates[0].Description).To(Equal("make a pod unavailable"))
+}
+
+func TestGetTemplate(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	cli := newTemplateClient(g)
+	w := serve(cli, http.MethodGet, "/api/templates/detail/default/pod-failure", "")
+	g.Expect(w.Code).To(Equal(http.StatusOK))
+
+	w = serve(cli, http.MethodGet, "/api/templates/detail/default/not-found", "")
+	g.Expect(w.Code).To(Equal(http.StatusNotFound))
+}
+
+func TestInstantiateTemplate(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	const path = "/api/templates/instantiate/default/pod-failure"
+	const body = `{"name": "failure", "parameters": {"duration": "30s"}}`
+	gr := schema.GroupResource{Group: v1alpha1.GroupVersion.Group, Resource: "podchaos"}
+
+	type TestCase struct {
+		name     string
+		cli      client.Client
+		path     string
+		body     string
+		expected int
+		code     string
+	}
+
+	tcs := []TestCase{
+		{
+			name:     "instantiate the template",
+			cli:      newTemplateClient(g),
+			path:     path,
+			body:     body,
+			expected: http.StatusOK,
+		},
+		{
+			name:     "template not found",
+			cli:      newTemplateClient(g),
+			path:     "/api/templates/instantiate/default/not-found",
+			body:     body,
+			expected: http.StatusNotFound,
+			code:     "error.api.resource_not_found",
+		},
+		{
+			name:     "missing parameters",
+			cli:      newTemplateClient(g),
+			path:     path,
+			body:     `{"name": "failure"}`,
+			expected: http.StatusBadRequest,
+			code:     "error.api.invalid_request",
+		},
+		{
+			name: "chaos already exists",
+			cli: newTemplateClient(g, &v1alpha1.PodChaos{
+				ObjectMeta: metav1.ObjectMeta{Name: "failure", Namespace: metav1.NamespaceDefault},
+			}),
+			path:     path,
+			body:     body,
+			expected: http.StatusConflict,
+			code:     "error.api.invalid_request",
+		},
+		{
+			name: "chaos denied by the webhook",
+			cli: &createErrorClient{
+				Client: newTemplateClient(g),
+				err:    apierrors.NewForbidden(gr, "failure", nil),
+			},
+			path:     path,
+			body:     body,
+			expected: http.StatusBadRequest,
+			code:     "error.api.invalid_request",
+		},
+		{
+			name: "invalid chaos",
+			cli: &createErrorClient{
+				Client: newTemplateClient(g),
+				err: apierrors.NewInvalid(schema.GroupKind{Group: gr.Group, Kind: v1alpha1.KindPodChaos}, "failure",
+					field.ErrorList{field.Invalid(field.NewPath("spec", "mode"), "", "mode is required")}),
+			},
+			path:     path,
+			body:     body,
+			expected: http.StatusBadRequest,
+			code:     "error.api.invalid_request",
+		},
+		{
+			name: "internal error",
+			cli: &createErrorClient{
+				Client: newTemplateClient(g),
+				err:    apierrors.NewInternalError(errors.New("etcd is unavailable")),
+			},
+			path:     path,
+			body:     body,
+			expected: http.StatusInternalServerError,
+			code:     "error.api.internal_server_error",
+		},
+	}
+
+	for _, tc := range tcs {
+		w := serve(tc.cli, http.MethodPost, tc.path, tc.body)
+		g.Expect(w.Code).To(Equal(tc.expected), tc.name)
+		if tc.code == "" {
+			continue
+		}
+
+		var apiErr utils.APIError
+		g.Expect(json.Unmarshal(w.Body.Bytes(), &apiErr)).To(Succeed(), tc.name)
+		g.Expect(apiErr.Code).To(Equal(tc.code), tc.name)
+	}
+
+	// the instantiated chaos is created in the namespace of the template
+	cli := newTemplateClient(g)
+	g.Expect(serve(cli, http.MethodPost, path, body).Code).To(Equal(http.StatusOK))
+	var chaos v1alpha1.PodChaos
+	g.Expect(cli.Get(context.Background(), client.ObjectKey{Namespace: metav1.NamespaceDefault, Name: "failure"}, &chaos)).To(Succeed())
+	g.Expect(chaos.Spec.Action).To(Equal(v1alpha1.PodFailureAction))
+}
@@ -30,7 +30,7 @@ var _ = Describe("container kill", func() {
 	defer mock.With("MockContainerdClient", &MockClient{})()
 	c, _ := CreateContainerRuntimeInfoClient(containerRuntimeContainerd)
 	m := bpm.NewBackgroundProcessManager()
-	s := &daemonServer{c, m}
+	s := &daemonServer{crClient: c, backgroundProcessManager: m}
 
 	Context("ContainerKill", func() {
 		It("should work", func() {
@@ -0,0 +1,148 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/bpm"
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+const (
+	diskChaosDirPrefix = "chaos-mesh-diskchaos-"
+	diskFillFileName   = "fill"
+	noSpaceErr         = "No space left on device"
+
+	// inodesPerCommand limits the count of files created by one `touch`, so that
+	// the arguments will never exceed the limit of the system
+	inodesPerCommand = 1000
+
+	mib = 1 << 20
+)
+
+func (s *daemonServer) ApplyDiskChaos(ctx context.Context, req *pb.DiskChaosRequest) (*empty.Empty, error) {
+	log.Info("applying disk chaos", "request", req)
+
+	pid, err := s.crClient.GetPidFromContainerID(ctx, req.ContainerId)
+	if err != nil {
+		log.Error(err, "error while getting PID")
+		return nil, err
+	}
+
+	dir := diskChaosDir(req.Path, req.Instance)
+	if err := runInMountNS(ctx, pid, "mkdir", "-p", dir); err != nil {
+		return nil, err
+	}
+
+	switch req.Action {
+	case pb.DiskChaosRequest_FILL:
+		err = fillDisk(ctx, pid, filepath.Join(dir, diskFillFileName), req.Size)
+	case pb.DiskChaosRequest_INODE_EXHAUSTION:
+		err = exhaustInodes(ctx, pid, dir, req.Inodes)
+	default:
+		err = fmt.Errorf("unknown disk chaos action %v", req.Action)
+	}
+	if err != nil {
+		// release the space which has been allocated, or nobody will clean it up
+		if rerr := runInMountNS(ctx, pid, "rm", "-rf", dir); rerr != nil {
+			log.Error(rerr, "fail to release the allocated space", "dir", dir)
+		}
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (s *daemonServer) RecoverDiskChaos(ctx context.Context, req *pb.DiskChaosRequest) (*empty.Empty, error) {
+	log.Info("recovering disk chaos", "request", req)
+
+	pid, err := s.crClient.GetPidFromContainerID(ctx, req.ContainerId)
+	if err != nil {
+		log.Error(err, "error while getting PID")
+		return nil, err
+	}
+
+	if err := runInMountNS(ctx, pid, "rm", "-rf", diskChaosDir(req.Path, req.Instance)); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// diskChaosDir returns the directory holding all files allocated by a disk chaos instance
+func diskChaosDir(path string, instance string) string {
+	return filepath.Join(path, diskChaosDirPrefix+instance)
+}
+
+// fillDisk allocates a file with the given size. It falls back to `dd` when `fallocate`
+// is unavailable in the container or unsupported by the filesystem.
+func fillDisk(ctx context.Context, pid uint32, file string, size uint64) error {
+	err := runInMountNS(ctx, pid, "fallocate", "-l", strconv.FormatUint(size, 10), file)
+	if err == nil || strings.Contains(err.Error(), noSpaceErr) {
+		return err
+	}
+	log.Error(err, "fallocate failed, fall back to dd", "file", file)
+
+	// the size is rounded up to MiB, as dd writes the file block by block
+	count := (size + mib - 1) / mib
+	return runInMountNS(ctx, pid, "dd", "if=/dev/zero", "of="+file, "bs=1M", "count="+strconv.FormatUint(count, 10))
+}
+
+// exhaustInodes creates the given count of empty files in the directory
+func exhaustInodes(ctx context.Context, pid uint32, dir string, inodes uint64) error {
+	for created := uint64(0); created < inodes; {
+		n := inodes - created
+		if n > inodesPerCommand {
+			n = inodesPerCommand
+		}
+
+		files := make([]string, 0, n)
+		for i := uint64(0); i < n; i++ {
+			files = append(files, filepath.Join(dir, strconv.FormatUint(created+i, 10)))
+		}
+		if err := runInMountNS(ctx, pid, "touch", files...); err != nil {
+			return err
+		}
+
+		created += n
+	}
+
+	return nil
+}
+
+// runInMountNS runs the command in the mount namespace of the process, so
+// that the paths are resolved inside the container
+func runInMountNS(ctx context.Context, pid uint32, name string, args ...string) error {
+	cmd := bpm.DefaultProcessBuilder(name, args...).
+		SetNS(pid, bpm.MountNS).
+		SetContext(ctx).
+		Build()
+
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		output := strings.TrimSpace(string(out))
+		log.Error(err, "command failed in mount namespace", "command", name, "output", output)
+		return errors.Wrapf(err, "%s: %s", name, output)
+	}
+
+	return nil
+}
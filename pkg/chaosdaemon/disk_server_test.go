@@ -0,0 +1,136 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"context"
+	"os/exec"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/bpm"
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	"github.com/chaos-mesh/chaos-mesh/pkg/mock"
+)
+
+var _ = Describe("disk server", func() {
+	defer mock.With("MockContainerdClient", &MockClient{})()
+	c, _ := CreateContainerRuntimeInfoClient(containerRuntimeContainerd)
+	m := bpm.NewBackgroundProcessManager()
+	s := &daemonServer{crClient: c, backgroundProcessManager: m}
+
+	Context("ApplyDiskChaos", func() {
+		It("should fill disk", func() {
+			var commands [][]string
+			defer mock.With("pid", 9527)()
+			defer mock.With("MockProcessBuild", func(ctx context.Context, cmd string, args ...string) *exec.Cmd {
+				Expect(cmd).To(Equal("nsenter"))
+				Expect(args[0]).To(Equal("-m/proc/9527/ns/mnt"))
+				Expect(args[1]).To(Equal("--"))
+				commands = append(commands, args[2:])
+				return exec.Command("echo", "mock command")
+			})()
+			_, err := s.ApplyDiskChaos(context.TODO(), &pb.DiskChaosRequest{
+				Action:      pb.DiskChaosRequest_FILL,
+				ContainerId: "containerd://container-id",
+				Path:        "/data",
+				Size:        1024,
+				Instance:    "uid",
+			})
+			Expect(err).To(BeNil())
+			Expect(commands).To(Equal([][]string{
+				{"mkdir", "-p", "/data/chaos-mesh-diskchaos-uid"},
+				{"fallocate", "-l", "1024", "/data/chaos-mesh-diskchaos-uid/fill"},
+			}))
+		})
+
+		It("should fall back to dd", func() {
+			var commands [][]string
+			defer mock.With("MockProcessBuild", func(ctx context.Context, cmd string, args ...string) *exec.Cmd {
+				commands = append(commands, args[2:])
+				if args[2] == "fallocate" {
+					return exec.Command("sh", "-c", "echo fallocate failed; exit 1")
+				}
+				return exec.Command("echo", "mock command")
+			})()
+			_, err := s.ApplyDiskChaos(context.TODO(), &pb.DiskChaosRequest{
+				Action:      pb.DiskChaosRequest_FILL,
+				ContainerId: "containerd://container-id",
+				Path:        "/data",
+				Size:        1024,
+				Instance:    "uid",
+			})
+			Expect(err).To(BeNil())
+			Expect(commands[2]).To(Equal([]string{"dd", "if=/dev/zero", "of=/data/chaos-mesh-diskchaos-uid/fill", "bs=1M", "count=1"}))
+		})
+
+		It("should release the space on failure", func() {
+			var commands [][]string
+			defer mock.With("MockProcessBuild", func(ctx context.Context, cmd string, args ...string) *exec.Cmd {
+				commands = append(commands, args[2:])
+				if args[2] == "fallocate" {
+					return exec.Command("sh", "-c", "echo "+noSpaceErr+"; exit 1")
+				}
+				return exec.Command("echo", "mock command")
+			})()
+			_, err := s.ApplyDiskChaos(context.TODO(), &pb.DiskChaosRequest{
+				Action:      pb.DiskChaosRequest_FILL,
+				ContainerId: "containerd://container-id",
+				Path:        "/data",
+				Size:        1024,
+				Instance:    "uid",
+			})
+			Expect(err).NotTo(BeNil())
+			Expect(err.Error()).To(ContainSubstring(noSpaceErr))
+			Expect(commands).To(HaveLen(3))
+			Expect(commands[2]).To(Equal([]string{"rm", "-rf", "/data/chaos-mesh-diskchaos-uid"}))
+		})
+
+		It("should exhaust inodes", func() {
+			files := 0
+			defer mock.With("MockProcessBuild", func(ctx context.Context, cmd string, args ...string) *exec.Cmd {
+				if args[2] == "touch" {
+					Expect(len(args[3:])).To(BeNumerically("<=", inodesPerCommand))
+					files += len(args[3:])
+				}
+				return exec.Command("echo", "mock command")
+			})()
+			_, err := s.ApplyDiskChaos(context.TODO(), &pb.DiskChaosRequest{
+				Action:      pb.DiskChaosRequest_INODE_EXHAUSTION,
+				ContainerId: "containerd://container-id",
+				Path:        "/data",
+				Inodes:      2500,
+				Instance:    "uid",
+			})
+			Expect(err).To(BeNil())
+			Expect(files).To(Equal(2500))
+		})
+	})
+
+	Context("RecoverDiskChaos", func() {
+		It("should remove the files", func() {
+			defer mock.With("MockProcessBuild", func(ctx context.Context, cmd string, args ...string) *exec.Cmd {
+				Expect(args[2:]).To(Equal([]string{"rm", "-rf", "/data/chaos-mesh-diskchaos-uid"}))
+				return exec.Command("echo", "mock command")
+			})()
+			_, err := s.RecoverDiskChaos(context.TODO(), &pb.DiskChaosRequest{
+				ContainerId: "containerd://container-id",
+				Path:        "/data",
+				Instance:    "uid",
+			})
+			Expect(err).To(BeNil())
+		})
+	})
+})
@@ -52,9 +52,28 @@ func (s *daemonServer) SetIptablesChains(ctx context.Context, req *pb.IptablesCh
 		return nil, err
 	}
 
+	s.grantIptablesLease(req)
+
 	return &empty.Empty{}, nil
 }
 
+// grantIptablesLease makes chaos-daemon flush the iptables chains by itself once
+// the lease deadline passes without renewal
+func (s *daemonServer) grantIptablesLease(req *pb.IptablesChainsRequest) {
+	if len(req.Chains) == 0 {
+		s.leases.Revoke(req.ContainerId, iptablesLease)
+		return
+	}
+
+	containerID := req.ContainerId
+	s.leases.Grant(containerID, iptablesLease, req.LeaseDeadline, func() {
+		_, err := s.SetIptablesChains(context.Background(), &pb.IptablesChainsRequest{ContainerId: containerID})
+		if err != nil {
+			log.Error(err, "fail to recover iptables chains with expired lease", "container", containerID)
+		}
+	})
+}
+
 type iptablesClient struct {
 	ctx context.Context
 	pid uint32
@@ -0,0 +1,57 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+// hostPid is the pid of the init process of the host, chaos-daemon shares
+// the pid namespace with the host
+const hostPid = 1
+
+func (s *daemonServer) StopKubelet(ctx context.Context, req *pb.KubeletRequest) (*empty.Empty, error) {
+	log.Info("stopping kubelet", "request", req)
+
+	// the service is checked again, so that the daemon never runs systemctl on other units
+	if err := v1alpha1.ValidateKubeletService(req.Service); err != nil {
+		return nil, err
+	}
+
+	if err := runInMountNS(ctx, hostPid, "systemctl", "stop", req.Service); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (s *daemonServer) StartKubelet(ctx context.Context, req *pb.KubeletRequest) (*empty.Empty, error) {
+	log.Info("starting kubelet", "request", req)
+
+	// the service is checked again, so that the daemon never runs systemctl on other units
+	if err := v1alpha1.ValidateKubeletService(req.Service); err != nil {
+		return nil, err
+	}
+
+	if err := runInMountNS(ctx, hostPid, "systemctl", "start", req.Service); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
@@ -0,0 +1,79 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"context"
+	"os/exec"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	"github.com/chaos-mesh/chaos-mesh/pkg/mock"
+)
+
+var _ = Describe("kubelet server", func() {
+	s := &daemonServer{}
+
+	Context("StopKubelet", func() {
+		It("should stop kubelet on the host", func() {
+			defer mock.With("MockProcessBuild", func(ctx context.Context, cmd string, args ...string) *exec.Cmd {
+				Expect(cmd).To(Equal("nsenter"))
+				Expect(args).To(Equal([]string{"-m/proc/1/ns/mnt", "--", "systemctl", "stop", "kubelet"}))
+				return exec.Command("echo", "mock command")
+			})()
+			_, err := s.StopKubelet(context.TODO(), &pb.KubeletRequest{
+				Service: "kubelet",
+			})
+			Expect(err).To(BeNil())
+		})
+
+		It("should return error", func() {
+			defer mock.With("MockProcessBuild", func(ctx context.Context, cmd string, args ...string) *exec.Cmd {
+				return exec.Command("sh", "-c", "echo unit not found; exit 5")
+			})()
+			_, err := s.StopKubelet(context.TODO(), &pb.KubeletRequest{
+				Service: "kubelet",
+			})
+			Expect(err).NotTo(BeNil())
+		})
+
+		It("should reject the services other than kubelet", func() {
+			defer mock.With("MockProcessBuild", func(ctx context.Context, cmd string, args ...string) *exec.Cmd {
+				Fail("systemctl should not be called")
+				return nil
+			})()
+			for _, service := range []string{"containerd", "sshd.service", "--force", ""} {
+				_, err := s.StopKubelet(context.TODO(), &pb.KubeletRequest{
+					Service: service,
+				})
+				Expect(err).NotTo(BeNil(), service)
+			}
+		})
+	})
+
+	Context("StartKubelet", func() {
+		It("should start kubelet on the host", func() {
+			defer mock.With("MockProcessBuild", func(ctx context.Context, cmd string, args ...string) *exec.Cmd {
+				Expect(args).To(Equal([]string{"-m/proc/1/ns/mnt", "--", "systemctl", "start", "kubelet.service"}))
+				return exec.Command("echo", "mock command")
+			})()
+			_, err := s.StartKubelet(context.TODO(), &pb.KubeletRequest{
+				Service: "kubelet.service",
+			})
+			Expect(err).To(BeNil())
+		})
+	})
+})
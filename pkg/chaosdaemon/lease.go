@@ -0,0 +1,155 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"context"
+	"sync"
+	gotime "time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+// leaseKind is the kind of injection which is guarded by a lease
+type leaseKind string
+
+const (
+	tcLease       leaseKind = "tc"
+	iptablesLease leaseKind = "iptables"
+	timeLease     leaseKind = "time"
+)
+
+type leaseKey struct {
+	containerID string
+	kind        leaseKind
+}
+
+// lease recovers an injection once its deadline passes without renewal, so that
+// the injection will not stay in place forever if the controller-manager is down
+type lease struct {
+	deadline gotime.Time
+	timer    *gotime.Timer
+	recover  func()
+}
+
+// leaseManager manages all leases, indexed by container id and kind of injection
+type leaseManager struct {
+	sync.Mutex
+	leases map[leaseKey]*lease
+}
+
+// Grant replaces the lease of the injection on the container. A zero deadline
+// means that the injection isn't guarded by a lease.
+func (m *leaseManager) Grant(containerID string, kind leaseKind, deadline int64, recover func()) {
+	m.Lock()
+	defer m.Unlock()
+
+	key := leaseKey{containerID: containerID, kind: kind}
+	m.revoke(key)
+	if deadline == 0 {
+		return
+	}
+
+	if m.leases == nil {
+		m.leases = make(map[leaseKey]*lease)
+	}
+	l := &lease{
+		deadline: gotime.Unix(deadline, 0),
+		recover:  recover,
+	}
+	l.timer = gotime.AfterFunc(gotime.Until(l.deadline), func() {
+		m.expire(key, l)
+	})
+	m.leases[key] = l
+}
+
+// Revoke removes the lease of the injection on the container, it's called when
+// the injection is recovered by the controller-manager
+func (m *leaseManager) Revoke(containerID string, kind leaseKind) {
+	m.Lock()
+	defer m.Unlock()
+
+	m.revoke(leaseKey{containerID: containerID, kind: kind})
+}
+
+// Renew extends all leases of the container to the deadline, and returns the
+// number of leases which are renewed
+func (m *leaseManager) Renew(containerID string, deadline int64) int {
+	m.Lock()
+	defer m.Unlock()
+
+	renewed := 0
+	for key, l := range m.leases {
+		if key.containerID != containerID {
+			continue
+		}
+		// the lease has already expired and is being recovered
+		if !l.timer.Stop() {
+			continue
+		}
+
+		l.deadline = gotime.Unix(deadline, 0)
+		l.timer.Reset(gotime.Until(l.deadline))
+		renewed++
+	}
+
+	return renewed
+}
+
+// Deadline returns the deadline of the lease, and whether the lease exists
+func (m *leaseManager) Deadline(containerID string, kind leaseKind) (gotime.Time, bool) {
+	m.Lock()
+	defer m.Unlock()
+
+	l, ok := m.leases[leaseKey{containerID: containerID, kind: kind}]
+	if !ok {
+		return gotime.Time{}, false
+	}
+	return l.deadline, true
+}
+
+func (m *leaseManager) revoke(key leaseKey) {
+	if l, ok := m.leases[key]; ok {
+		l.timer.Stop()
+		delete(m.leases, key)
+	}
+}
+
+func (m *leaseManager) expire(key leaseKey, l *lease) {
+	m.Lock()
+	if m.leases[key] != l {
+		// the lease has been replaced or revoked
+		m.Unlock()
+		return
+	}
+	delete(m.leases, key)
+	m.Unlock()
+
+	log.Info("lease is expired, recover the injection", "container", key.containerID, "kind", key.kind, "deadline", l.deadline)
+	l.recover()
+}
+
+func (s *daemonServer) RenewLease(ctx context.Context, req *pb.LeaseRequest) (*empty.Empty, error) {
+	log.Info("Renew lease", "request", req)
+
+	if s.leases.Renew(req.ContainerId, req.LeaseDeadline) == 0 {
+		return nil, status.Errorf(codes.NotFound, "no lease is held by container %s", req.ContainerId)
+	}
+
+	return &empty.Empty{}, nil
+}
@@ -0,0 +1,92 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"context"
+	gotime "time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+var _ = Describe("lease manager", func() {
+	Context("Grant", func() {
+		It("should recover the injection once the lease is expired", func() {
+			m := &leaseManager{}
+			recovered := make(chan struct{})
+			m.Grant("containerd://expired", tcLease, gotime.Now().Unix()-1, func() {
+				close(recovered)
+			})
+
+			Eventually(recovered).Should(BeClosed())
+			_, ok := m.Deadline("containerd://expired", tcLease)
+			Expect(ok).To(BeFalse())
+		})
+
+		It("should not hold a lease without deadline", func() {
+			m := &leaseManager{}
+			m.Grant("containerd://container-id", timeLease, 0, func() {})
+
+			_, ok := m.Deadline("containerd://container-id", timeLease)
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Context("Renew", func() {
+		It("should extend all leases of the container", func() {
+			m := &leaseManager{}
+			deadline := gotime.Now().Add(gotime.Hour).Unix()
+			m.Grant("containerd://container-id", tcLease, deadline, func() {})
+			m.Grant("containerd://container-id", iptablesLease, deadline, func() {})
+			m.Grant("containerd://other-id", tcLease, deadline, func() {})
+
+			renewed := deadline + 3600
+			Expect(m.Renew("containerd://container-id", renewed)).To(Equal(2))
+
+			for _, kind := range []leaseKind{tcLease, iptablesLease} {
+				d, ok := m.Deadline("containerd://container-id", kind)
+				Expect(ok).To(BeTrue())
+				Expect(d.Unix()).To(Equal(renewed))
+			}
+			d, _ := m.Deadline("containerd://other-id", tcLease)
+			Expect(d.Unix()).To(Equal(deadline))
+		})
+
+		It("should not renew a revoked lease", func() {
+			m := &leaseManager{}
+			m.Grant("containerd://container-id", timeLease, gotime.Now().Add(gotime.Hour).Unix(), func() {
+				Fail("revoked lease should not recover the injection")
+			})
+			m.Revoke("containerd://container-id", timeLease)
+
+			Expect(m.Renew("containerd://container-id", gotime.Now().Add(gotime.Hour).Unix())).To(Equal(0))
+		})
+	})
+
+	Context("RenewLease", func() {
+		It("should fail without lease", func() {
+			s := &daemonServer{}
+			_, err := s.RenewLease(context.TODO(), &pb.LeaseRequest{
+				ContainerId:   "containerd://container-id",
+				LeaseDeadline: gotime.Now().Add(gotime.Hour).Unix(),
+			})
+			Expect(status.Code(err)).To(Equal(codes.NotFound))
+		})
+	})
+})
@@ -48,7 +48,7 @@ func (x Chain_Direction) String() string {
 	return proto.EnumName(Chain_Direction_name, int32(x))
 }
 func (Chain_Direction) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_d32cf42dab093cba, []int{16, 0}
+	return fileDescriptor_chaosdaemon_ab6d4f7b9d2a0f14, []int{16, 0}
 }
 
 type ContainerAction_Action int32
@@ -71,7 +71,7 @@ func (x ContainerAction_Action) String() string {
 	return proto.EnumName(ContainerAction_Action_name, int32(x))
 }
 func (ContainerAction_Action) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_d32cf42dab093cba, []int{18, 0}
+	return fileDescriptor_chaosdaemon_ab6d4f7b9d2a0f14, []int{18, 0}
 }
 
 type ExecStressRequest_Scope int32
@@ -94,7 +94,30 @@ func (x ExecStressRequest_Scope) String() string {
 	return proto.EnumName(ExecStressRequest_Scope_name, int32(x))
 }
 func (ExecStressRequest_Scope) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_d32cf42dab093cba, []int{19, 0}
+	return fileDescriptor_chaosdaemon_ab6d4f7b9d2a0f14, []int{19, 0}
+}
+
+type DiskChaosRequest_Action int32
+
+const (
+	DiskChaosRequest_FILL             DiskChaosRequest_Action = 0
+	DiskChaosRequest_INODE_EXHAUSTION DiskChaosRequest_Action = 1
+)
+
+var DiskChaosRequest_Action_name = map[int32]string{
+	0: "FILL",
+	1: "INODE_EXHAUSTION",
+}
+var DiskChaosRequest_Action_value = map[string]int32{
+	"FILL":             0,
+	"INODE_EXHAUSTION": 1,
+}
+
+func (x DiskChaosRequest_Action) String() string {
+	return proto.EnumName(DiskChaosRequest_Action_name, int32(x))
+}
+func (DiskChaosRequest_Action) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_chaosdaemon_ab6d4f7b9d2a0f14, []int{24, 0}
 }
 
 type Tc_Type int32
@@ -117,7 +140,7 @@ func (x Tc_Type) String() string {
 	return proto.EnumName(Tc_Type_name, int32(x))
 }
 func (Tc_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_d32cf42dab093cba, []int{25, 0}
+	return fileDescriptor_chaosdaemon_ab6d4f7b9d2a0f14, []int{27, 0}
 }
 
 type TcHandle struct {
@@ -132,7 +155,7 @@ func (m *TcHandle) Reset()         { *m = TcHandle{} }
 func (m *TcHandle) String() string { return proto.CompactTextString(m) }
 func (*TcHandle) ProtoMessage()    {}
 func (*TcHandle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_d32cf42dab093cba, []int{0}
+	return fileDescriptor_chaosdaemon_ab6d4f7b9d2a0f14, []int{0}
 }
 func (m *TcHandle) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TcHandle.Unmarshal(m, b)
@@ -178,7 +201,7 @@ func (m *ContainerRequest) Reset()         { *m = ContainerRequest{} }
 func (m *ContainerRequest) String() string { return proto.CompactTextString(m) }
 func (*ContainerRequest) ProtoMessage()    {}
 func (*ContainerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_d32cf42dab093cba, []int{1}
+	return fileDescriptor_chaosdaemon_ab6d4f7b9d2a0f14, []int{1}
 }
 func (m *ContainerRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ContainerRequest.Unmarshal(m, b)
@@ -223,7 +246,7 @@ func (m *ContainerResponse) Reset()         { *m = ContainerResponse{} }
 func (m *ContainerResponse) String() string { return proto.CompactTextString(m) }
 func (*ContainerResponse) ProtoMessage()    {}
 func (*ContainerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_d32cf42dab093cba, []int{2}
+	return fileDescriptor_chaosdaemon_ab6d4f7b9d2a0f14, []int{2}
 }
 func (m *ContainerResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ContainerResponse.Unmarshal(m, b)
@@ -264,7 +287,7 @@ func (m *NetemRequest) Reset()         { *m = NetemRequest{} }
 func (m *NetemRequest) String() string { return proto.CompactTextString(m) }
 func (*NetemRequest) ProtoMessage()    {}
 func (*NetemRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_d32cf42dab093cba, []int{3}
+	return fileDescriptor_chaosdaemon_ab6d4f7b9d2a0f14, []int{3}
 }
 func (m *NetemRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NetemRequest.Unmarshal(m, b)
@@ -337,7 +360,7 @@ func (m *Netem) Reset()         { *m = Netem{} }
 func (m *Netem) String() string { return proto.CompactTextString(m) }
 func (*Netem) ProtoMessage()    {}
 func (*Netem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_d32cf42dab093cba, []int{4}
+	return fileDescriptor_chaosdaemon_ab6d4f7b9d2a0f14, []int{4}
 }
 func (m *Netem) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Netem.Unmarshal(m, b)
@@ -474,7 +497,7 @@ func (m *TbfRequest) Reset()         { *m = TbfRequest{} }
 func (m *TbfRequest) String() string { return proto.CompactTextString(m) }
 func (*TbfRequest) ProtoMessage()    {}
 func (*TbfRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_d32cf42dab093cba, []int{5}
+	return fileDescriptor_chaosdaemon_ab6d4f7b9d2a0f14, []int{5}
 }
 func (m *TbfRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TbfRequest.Unmarshal(m, b)
@@ -523,7 +546,7 @@ func (m *Tbf) Reset()         { *m = Tbf{} }
 func (m *Tbf) String() string { return proto.CompactTextString(m) }
 func (*Tbf) ProtoMessage()    {}
 func (*Tbf) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_d32cf42dab093cba, []int{6}
+	return fileDescriptor_chaosdaemon_ab6d4f7b9d2a0f14, []int{6}
 }
 func (m *Tbf) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Tbf.Unmarshal(m, b)
@@ -590,7 +613,7 @@ func (m *QdiscRequest) Reset()         { *m = QdiscRequest{} }
 func (m *QdiscRequest) String() string { return proto.CompactTextString(m) }
 func (*QdiscRequest) ProtoMessage()    {}
 func (*QdiscRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_d32cf42dab093cba, []int{7}
+	return fileDescriptor_chaosdaemon_ab6d4f7b9d2a0f14, []int{7}
 }
 func (m *QdiscRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QdiscRequest.Unmarshal(m, b)
@@ -638,7 +661,7 @@ func (m *Qdisc) Reset()         { *m = Qdisc{} }
 func (m *Qdisc) String() string { return proto.CompactTextString(m) }
 func (*Qdisc) ProtoMessage()    {}
 func (*Qdisc) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_d32cf42dab093cba, []int{8}
+	return fileDescriptor_chaosdaemon_ab6d4f7b9d2a0f14, []int{8}
 }
 func (m *Qdisc) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Qdisc.Unmarshal(m, b)
@@ -698,7 +721,7 @@ func (m *EmatchFilterRequest) Reset()         { *m = EmatchFilterRequest{} }
 func (m *EmatchFilterRequest) String() string { return proto.CompactTextString(m) }
 func (*EmatchFilterRequest) ProtoMessage()    {}
 func (*EmatchFilterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_d32cf42dab093cba, []int{9}
+	return fileDescriptor_chaosdaemon_ab6d4f7b9d2a0f14, []int{9}
 }
 func (m *EmatchFilterRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EmatchFilterRequest.Unmarshal(m, b)
@@ -745,7 +768,7 @@ func (m *EmatchFilter) Reset()         { *m = EmatchFilter{} }
 func (m *EmatchFilter) String() string { return proto.CompactTextString(m) }
 func (*EmatchFilter) ProtoMessage()    {}
 func (*EmatchFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_d32cf42dab093cba, []int{10}
+	return fileDescriptor_chaosdaemon_ab6d4f7b9d2a0f14, []int{10}
 }
 func (m *EmatchFilter) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EmatchFilter.Unmarshal(m, b)
@@ -798,7 +821,7 @@ func (m *TcFilterRequest) Reset()         { *m = TcFilterRequest{} }
 func (m *TcFilterRequest) String() string { return proto.CompactTextString(m) }
 func (*TcFilterRequest) ProtoMessage()    {}
 func (*TcFilterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_d32cf42dab093cba, []int{11}
+	return fileDescriptor_chaosdaemon_ab6d4f7b9d2a0f14, []int{11}
 }
 func (m *TcFilterRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TcFilterRequest.Unmarshal(m, b)
@@ -843,7 +866,7 @@ func (m *TcFilter) Reset()         { *m = TcFilter{} }
 func (m *TcFilter) String() string { return proto.CompactTextString(m) }
 func (*TcFilter) ProtoMessage()    {}
 func (*TcFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_d32cf42dab093cba, []int{12}
+	return fileDescriptor_chaosdaemon_ab6d4f7b9d2a0f14, []int{12}
 }
 func (m *TcFilter) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TcFilter.Unmarshal(m, b)
@@ -882,7 +905,7 @@ func (m *IPSetsRequest) Reset()         { *m = IPSetsRequest{} }
 func (m *IPSetsRequest) String() string { return proto.CompactTextString(m) }
 func (*IPSetsRequest) ProtoMessage()    {}
 func (*IPSetsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_d32cf42dab093cba, []int{13}
+	return fileDescriptor_chaosdaemon_ab6d4f7b9d2a0f14, []int{13}
 }
 func (m *IPSetsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IPSetsRequest.Unmarshal(m, b)
@@ -928,7 +951,7 @@ func (m *IPSet) Reset()         { *m = IPSet{} }
 func (m *IPSet) String() string { return proto.CompactTextString(m) }
 func (*IPSet) ProtoMessage()    {}
 func (*IPSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_d32cf42dab093cba, []int{14}
+	return fileDescriptor_chaosdaemon_ab6d4f7b9d2a0f14, []int{14}
 }
 func (m *IPSet) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IPSet.Unmarshal(m, b)
@@ -965,6 +988,7 @@ func (m *IPSet) GetCidrs() []string {
 type IptablesChainsRequest struct {
 	Chains               []*Chain `protobuf:"bytes,1,rep,name=chains,proto3" json:"chains,omitempty"`
 	ContainerId          string   `protobuf:"bytes,2,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	LeaseDeadline        int64    `protobuf:"varint,3,opt,name=lease_deadline,json=leaseDeadline,proto3" json:"lease_deadline,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -974,7 +998,7 @@ func (m *IptablesChainsRequest) Reset()         { *m = IptablesChainsRequest{} }
 func (m *IptablesChainsRequest) String() string { return proto.CompactTextString(m) }
 func (*IptablesChainsRequest) ProtoMessage()    {}
 func (*IptablesChainsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_d32cf42dab093cba, []int{15}
+	return fileDescriptor_chaosdaemon_ab6d4f7b9d2a0f14, []int{15}
 }
 func (m *IptablesChainsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IptablesChainsRequest.Unmarshal(m, b)
@@ -1008,6 +1032,13 @@ func (m *IptablesChainsRequest) GetContainerId() string {
 	return ""
 }
 
+func (m *IptablesChainsRequest) GetLeaseDeadline() int64 {
+	if m != nil {
+		return m.LeaseDeadline
+	}
+	return 0
+}
+
 type Chain struct {
 	Name                 string          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Direction            Chain_Direction `protobuf:"varint,2,opt,name=direction,proto3,enum=pb.Chain_Direction" json:"direction,omitempty"`
@@ -1022,7 +1053,7 @@ func (m *Chain) Reset()         { *m = Chain{} }
 func (m *Chain) String() string { return proto.CompactTextString(m) }
 func (*Chain) ProtoMessage()    {}
 func (*Chain) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_d32cf42dab093cba, []int{16}
+	return fileDescriptor_chaosdaemon_ab6d4f7b9d2a0f14, []int{16}
 }
 func (m *Chain) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Chain.Unmarshal(m, b)
@@ -1075,6 +1106,7 @@ type TimeRequest struct {
 	Sec                  int64    `protobuf:"varint,2,opt,name=sec,proto3" json:"sec,omitempty"`
 	Nsec                 int64    `protobuf:"varint,3,opt,name=nsec,proto3" json:"nsec,omitempty"`
 	ClkIdsMask           uint64   `protobuf:"varint,4,opt,name=clk_ids_mask,json=clkIdsMask,proto3" json:"clk_ids_mask,omitempty"`
+	LeaseDeadline        int64    `protobuf:"varint,5,opt,name=lease_deadline,json=leaseDeadline,proto3" json:"lease_deadline,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1084,7 +1116,7 @@ func (m *TimeRequest) Reset()         { *m = TimeRequest{} }
 func (m *TimeRequest) String() string { return proto.CompactTextString(m) }
 func (*TimeRequest) ProtoMessage()    {}
 func (*TimeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_d32cf42dab093cba, []int{17}
+	return fileDescriptor_chaosdaemon_ab6d4f7b9d2a0f14, []int{17}
 }
 func (m *TimeRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TimeRequest.Unmarshal(m, b)
@@ -1132,6 +1164,13 @@ func (m *TimeRequest) GetClkIdsMask() uint64 {
 	return 0
 }
 
+func (m *TimeRequest) GetLeaseDeadline() int64 {
+	if m != nil {
+		return m.LeaseDeadline
+	}
+	return 0
+}
+
 type ContainerAction struct {
 	Action               ContainerAction_Action `protobuf:"varint,1,opt,name=action,proto3,enum=pb.ContainerAction_Action" json:"action,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
@@ -1143,7 +1182,7 @@ func (m *ContainerAction) Reset()         { *m = ContainerAction{} }
 func (m *ContainerAction) String() string { return proto.CompactTextString(m) }
 func (*ContainerAction) ProtoMessage()    {}
 func (*ContainerAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_d32cf42dab093cba, []int{18}
+	return fileDescriptor_chaosdaemon_ab6d4f7b9d2a0f14, []int{18}
 }
 func (m *ContainerAction) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ContainerAction.Unmarshal(m, b)
@@ -1174,6 +1213,8 @@ type ExecStressRequest struct {
 	Scope                ExecStressRequest_Scope `protobuf:"varint,1,opt,name=scope,proto3,enum=pb.ExecStressRequest_Scope" json:"scope,omitempty"`
 	Target               string                  `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
 	Stressors            string                  `protobuf:"bytes,3,opt,name=stressors,proto3" json:"stressors,omitempty"`
+	MemoryHoldSize       uint64                  `protobuf:"varint,4,opt,name=memory_hold_size,json=memoryHoldSize,proto3" json:"memory_hold_size,omitempty"`
+	MemoryRampRate       uint64                  `protobuf:"varint,5,opt,name=memory_ramp_rate,json=memoryRampRate,proto3" json:"memory_ramp_rate,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
 	XXX_unrecognized     []byte                  `json:"-"`
 	XXX_sizecache        int32                   `json:"-"`
@@ -1183,7 +1224,7 @@ func (m *ExecStressRequest) Reset()         { *m = ExecStressRequest{} }
 func (m *ExecStressRequest) String() string { return proto.CompactTextString(m) }
 func (*ExecStressRequest) ProtoMessage()    {}
 func (*ExecStressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_d32cf42dab093cba, []int{19}
+	return fileDescriptor_chaosdaemon_ab6d4f7b9d2a0f14, []int{19}
 }
 func (m *ExecStressRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ExecStressRequest.Unmarshal(m, b)
@@ -1224,9 +1265,25 @@ func (m *ExecStressRequest) GetStressors() string {
 	return ""
 }
 
+func (m *ExecStressRequest) GetMemoryHoldSize() uint64 {
+	if m != nil {
+		return m.MemoryHoldSize
+	}
+	return 0
+}
+
+func (m *ExecStressRequest) GetMemoryRampRate() uint64 {
+	if m != nil {
+		return m.MemoryRampRate
+	}
+	return 0
+}
+
 type ExecStressResponse struct {
 	Instance             string   `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
 	StartTime            int64    `protobuf:"varint,2,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	MemoryInstance       string   `protobuf:"bytes,3,opt,name=memory_instance,json=memoryInstance,proto3" json:"memory_instance,omitempty"`
+	MemoryStartTime      int64    `protobuf:"varint,4,opt,name=memory_start_time,json=memoryStartTime,proto3" json:"memory_start_time,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1236,7 +1293,7 @@ func (m *ExecStressResponse) Reset()         { *m = ExecStressResponse{} }
 func (m *ExecStressResponse) String() string { return proto.CompactTextString(m) }
 func (*ExecStressResponse) ProtoMessage()    {}
 func (*ExecStressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_d32cf42dab093cba, []int{20}
+	return fileDescriptor_chaosdaemon_ab6d4f7b9d2a0f14, []int{20}
 }
 func (m *ExecStressResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ExecStressResponse.Unmarshal(m, b)
@@ -1270,9 +1327,25 @@ func (m *ExecStressResponse) GetStartTime() int64 {
 	return 0
 }
 
+func (m *ExecStressResponse) GetMemoryInstance() string {
+	if m != nil {
+		return m.MemoryInstance
+	}
+	return ""
+}
+
+func (m *ExecStressResponse) GetMemoryStartTime() int64 {
+	if m != nil {
+		return m.MemoryStartTime
+	}
+	return 0
+}
+
 type CancelStressRequest struct {
 	Instance             string   `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
 	StartTime            int64    `protobuf:"varint,2,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	MemoryInstance       string   `protobuf:"bytes,3,opt,name=memory_instance,json=memoryInstance,proto3" json:"memory_instance,omitempty"`
+	MemoryStartTime      int64    `protobuf:"varint,4,opt,name=memory_start_time,json=memoryStartTime,proto3" json:"memory_start_time,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1282,7 +1355,7 @@ func (m *CancelStressRequest) Reset()         { *m = CancelStressRequest{} }
 func (m *CancelStressRequest) String() string { return proto.CompactTextString(m) }
 func (*CancelStressRequest) ProtoMessage()    {}
 func (*CancelStressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_d32cf42dab093cba, []int{21}
+	return fileDescriptor_chaosdaemon_ab6d4f7b9d2a0f14, []int{21}
 }
 func (m *CancelStressRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CancelStressRequest.Unmarshal(m, b)
@@ -1316,6 +1389,20 @@ func (m *CancelStressRequest) GetStartTime() int64 {
 	return 0
 }
 
+func (m *CancelStressRequest) GetMemoryInstance() string {
+	if m != nil {
+		return m.MemoryInstance
+	}
+	return ""
+}
+
+func (m *CancelStressRequest) GetMemoryStartTime() int64 {
+	if m != nil {
+		return m.MemoryStartTime
+	}
+	return 0
+}
+
 type ApplyIoChaosRequest struct {
 	Actions              string   `protobuf:"bytes,1,opt,name=actions,proto3" json:"actions,omitempty"`
 	Volume               string   `protobuf:"bytes,2,opt,name=volume,proto3" json:"volume,omitempty"`
@@ -1331,7 +1418,7 @@ func (m *ApplyIoChaosRequest) Reset()         { *m = ApplyIoChaosRequest{} }
 func (m *ApplyIoChaosRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplyIoChaosRequest) ProtoMessage()    {}
 func (*ApplyIoChaosRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_d32cf42dab093cba, []int{22}
+	return fileDescriptor_chaosdaemon_ab6d4f7b9d2a0f14, []int{22}
 }
 func (m *ApplyIoChaosRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ApplyIoChaosRequest.Unmarshal(m, b)
@@ -1398,7 +1485,7 @@ func (m *ApplyIoChaosResponse) Reset()         { *m = ApplyIoChaosResponse{} }
 func (m *ApplyIoChaosResponse) String() string { return proto.CompactTextString(m) }
 func (*ApplyIoChaosResponse) ProtoMessage()    {}
 func (*ApplyIoChaosResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_d32cf42dab093cba, []int{23}
+	return fileDescriptor_chaosdaemon_ab6d4f7b9d2a0f14, []int{23}
 }
 func (m *ApplyIoChaosResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ApplyIoChaosResponse.Unmarshal(m, b)
@@ -1432,9 +1519,126 @@ func (m *ApplyIoChaosResponse) GetStartTime() int64 {
 	return 0
 }
 
+type DiskChaosRequest struct {
+	Action               DiskChaosRequest_Action `protobuf:"varint,1,opt,name=action,proto3,enum=pb.DiskChaosRequest_Action" json:"action,omitempty"`
+	ContainerId          string                  `protobuf:"bytes,2,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	Path                 string                  `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	Size                 uint64                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	Inodes               uint64                  `protobuf:"varint,5,opt,name=inodes,proto3" json:"inodes,omitempty"`
+	Instance             string                  `protobuf:"bytes,6,opt,name=instance,proto3" json:"instance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *DiskChaosRequest) Reset()         { *m = DiskChaosRequest{} }
+func (m *DiskChaosRequest) String() string { return proto.CompactTextString(m) }
+func (*DiskChaosRequest) ProtoMessage()    {}
+func (*DiskChaosRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_chaosdaemon_ab6d4f7b9d2a0f14, []int{24}
+}
+func (m *DiskChaosRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiskChaosRequest.Unmarshal(m, b)
+}
+func (m *DiskChaosRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiskChaosRequest.Marshal(b, m, deterministic)
+}
+func (dst *DiskChaosRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiskChaosRequest.Merge(dst, src)
+}
+func (m *DiskChaosRequest) XXX_Size() int {
+	return xxx_messageInfo_DiskChaosRequest.Size(m)
+}
+func (m *DiskChaosRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiskChaosRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiskChaosRequest proto.InternalMessageInfo
+
+func (m *DiskChaosRequest) GetAction() DiskChaosRequest_Action {
+	if m != nil {
+		return m.Action
+	}
+	return DiskChaosRequest_FILL
+}
+
+func (m *DiskChaosRequest) GetContainerId() string {
+	if m != nil {
+		return m.ContainerId
+	}
+	return ""
+}
+
+func (m *DiskChaosRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *DiskChaosRequest) GetSize() uint64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *DiskChaosRequest) GetInodes() uint64 {
+	if m != nil {
+		return m.Inodes
+	}
+	return 0
+}
+
+func (m *DiskChaosRequest) GetInstance() string {
+	if m != nil {
+		return m.Instance
+	}
+	return ""
+}
+
+type KubeletRequest struct {
+	Service              string   `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *KubeletRequest) Reset()         { *m = KubeletRequest{} }
+func (m *KubeletRequest) String() string { return proto.CompactTextString(m) }
+func (*KubeletRequest) ProtoMessage()    {}
+func (*KubeletRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_chaosdaemon_ab6d4f7b9d2a0f14, []int{25}
+}
+func (m *KubeletRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KubeletRequest.Unmarshal(m, b)
+}
+func (m *KubeletRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KubeletRequest.Marshal(b, m, deterministic)
+}
+func (dst *KubeletRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KubeletRequest.Merge(dst, src)
+}
+func (m *KubeletRequest) XXX_Size() int {
+	return xxx_messageInfo_KubeletRequest.Size(m)
+}
+func (m *KubeletRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_KubeletRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KubeletRequest proto.InternalMessageInfo
+
+func (m *KubeletRequest) GetService() string {
+	if m != nil {
+		return m.Service
+	}
+	return ""
+}
+
 type TcsRequest struct {
 	Tcs                  []*Tc    `protobuf:"bytes,1,rep,name=tcs,proto3" json:"tcs,omitempty"`
 	ContainerId          string   `protobuf:"bytes,2,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	LeaseDeadline        int64    `protobuf:"varint,3,opt,name=lease_deadline,json=leaseDeadline,proto3" json:"lease_deadline,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1444,7 +1648,7 @@ func (m *TcsRequest) Reset()         { *m = TcsRequest{} }
 func (m *TcsRequest) String() string { return proto.CompactTextString(m) }
 func (*TcsRequest) ProtoMessage()    {}
 func (*TcsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_d32cf42dab093cba, []int{24}
+	return fileDescriptor_chaosdaemon_ab6d4f7b9d2a0f14, []int{26}
 }
 func (m *TcsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TcsRequest.Unmarshal(m, b)
@@ -1478,6 +1682,13 @@ func (m *TcsRequest) GetContainerId() string {
 	return ""
 }
 
+func (m *TcsRequest) GetLeaseDeadline() int64 {
+	if m != nil {
+		return m.LeaseDeadline
+	}
+	return 0
+}
+
 type Tc struct {
 	Type                 Tc_Type  `protobuf:"varint,1,opt,name=type,proto3,enum=pb.Tc_Type" json:"type,omitempty"`
 	Netem                *Netem   `protobuf:"bytes,2,opt,name=netem,proto3" json:"netem,omitempty"`
@@ -1492,7 +1703,7 @@ func (m *Tc) Reset()         { *m = Tc{} }
 func (m *Tc) String() string { return proto.CompactTextString(m) }
 func (*Tc) ProtoMessage()    {}
 func (*Tc) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_d32cf42dab093cba, []int{25}
+	return fileDescriptor_chaosdaemon_ab6d4f7b9d2a0f14, []int{27}
 }
 func (m *Tc) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Tc.Unmarshal(m, b)
@@ -1540,6 +1751,55 @@ func (m *Tc) GetIpset() string {
 	return ""
 }
 
+// LeaseRequest extends the lease deadline of all the injections in the container.
+// The deadline is a unix timestamp in seconds, and the chaos-daemon recovers the
+// injection by itself once the deadline passes without renewal.
+type LeaseRequest struct {
+	ContainerId          string   `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	LeaseDeadline        int64    `protobuf:"varint,2,opt,name=lease_deadline,json=leaseDeadline,proto3" json:"lease_deadline,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LeaseRequest) Reset()         { *m = LeaseRequest{} }
+func (m *LeaseRequest) String() string { return proto.CompactTextString(m) }
+func (*LeaseRequest) ProtoMessage()    {}
+func (*LeaseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_chaosdaemon_ab6d4f7b9d2a0f14, []int{28}
+}
+func (m *LeaseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LeaseRequest.Unmarshal(m, b)
+}
+func (m *LeaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LeaseRequest.Marshal(b, m, deterministic)
+}
+func (dst *LeaseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaseRequest.Merge(dst, src)
+}
+func (m *LeaseRequest) XXX_Size() int {
+	return xxx_messageInfo_LeaseRequest.Size(m)
+}
+func (m *LeaseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LeaseRequest proto.InternalMessageInfo
+
+func (m *LeaseRequest) GetContainerId() string {
+	if m != nil {
+		return m.ContainerId
+	}
+	return ""
+}
+
+func (m *LeaseRequest) GetLeaseDeadline() int64 {
+	if m != nil {
+		return m.LeaseDeadline
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*TcHandle)(nil), "pb.TcHandle")
 	proto.RegisterType((*ContainerRequest)(nil), "pb.ContainerRequest")
@@ -1565,11 +1825,15 @@ func init() {
 	proto.RegisterType((*CancelStressRequest)(nil), "pb.CancelStressRequest")
 	proto.RegisterType((*ApplyIoChaosRequest)(nil), "pb.ApplyIoChaosRequest")
 	proto.RegisterType((*ApplyIoChaosResponse)(nil), "pb.ApplyIoChaosResponse")
+	proto.RegisterType((*DiskChaosRequest)(nil), "pb.DiskChaosRequest")
+	proto.RegisterType((*KubeletRequest)(nil), "pb.KubeletRequest")
 	proto.RegisterType((*TcsRequest)(nil), "pb.TcsRequest")
 	proto.RegisterType((*Tc)(nil), "pb.Tc")
+	proto.RegisterType((*LeaseRequest)(nil), "pb.LeaseRequest")
 	proto.RegisterEnum("pb.Chain_Direction", Chain_Direction_name, Chain_Direction_value)
 	proto.RegisterEnum("pb.ContainerAction_Action", ContainerAction_Action_name, ContainerAction_Action_value)
 	proto.RegisterEnum("pb.ExecStressRequest_Scope", ExecStressRequest_Scope_name, ExecStressRequest_Scope_value)
+	proto.RegisterEnum("pb.DiskChaosRequest_Action", DiskChaosRequest_Action_name, DiskChaosRequest_Action_value)
 	proto.RegisterEnum("pb.Tc_Type", Tc_Type_name, Tc_Type_value)
 }
 
@@ -1595,6 +1859,11 @@ type ChaosDaemonClient interface {
 	ExecStressors(ctx context.Context, in *ExecStressRequest, opts ...grpc.CallOption) (*ExecStressResponse, error)
 	CancelStressors(ctx context.Context, in *CancelStressRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	ApplyIoChaos(ctx context.Context, in *ApplyIoChaosRequest, opts ...grpc.CallOption) (*ApplyIoChaosResponse, error)
+	ApplyDiskChaos(ctx context.Context, in *DiskChaosRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	RecoverDiskChaos(ctx context.Context, in *DiskChaosRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	StopKubelet(ctx context.Context, in *KubeletRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	StartKubelet(ctx context.Context, in *KubeletRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	RenewLease(ctx context.Context, in *LeaseRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 }
 
 type chaosDaemonClient struct {
@@ -1695,6 +1964,51 @@ func (c *chaosDaemonClient) ApplyIoChaos(ctx context.Context, in *ApplyIoChaosRe
 	return out, nil
 }
 
+func (c *chaosDaemonClient) ApplyDiskChaos(ctx context.Context, in *DiskChaosRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/pb.ChaosDaemon/ApplyDiskChaos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) RecoverDiskChaos(ctx context.Context, in *DiskChaosRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/pb.ChaosDaemon/RecoverDiskChaos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) StopKubelet(ctx context.Context, in *KubeletRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/pb.ChaosDaemon/StopKubelet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) StartKubelet(ctx context.Context, in *KubeletRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/pb.ChaosDaemon/StartKubelet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) RenewLease(ctx context.Context, in *LeaseRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/pb.ChaosDaemon/RenewLease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ChaosDaemonServer is the server API for ChaosDaemon service.
 type ChaosDaemonServer interface {
 	SetTcs(context.Context, *TcsRequest) (*empty.Empty, error)
@@ -1707,6 +2021,11 @@ type ChaosDaemonServer interface {
 	ExecStressors(context.Context, *ExecStressRequest) (*ExecStressResponse, error)
 	CancelStressors(context.Context, *CancelStressRequest) (*empty.Empty, error)
 	ApplyIoChaos(context.Context, *ApplyIoChaosRequest) (*ApplyIoChaosResponse, error)
+	ApplyDiskChaos(context.Context, *DiskChaosRequest) (*empty.Empty, error)
+	RecoverDiskChaos(context.Context, *DiskChaosRequest) (*empty.Empty, error)
+	StopKubelet(context.Context, *KubeletRequest) (*empty.Empty, error)
+	StartKubelet(context.Context, *KubeletRequest) (*empty.Empty, error)
+	RenewLease(context.Context, *LeaseRequest) (*empty.Empty, error)
 }
 
 func RegisterChaosDaemonServer(s *grpc.Server, srv ChaosDaemonServer) {
@@ -1893,6 +2212,96 @@ func _ChaosDaemon_ApplyIoChaos_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChaosDaemon_ApplyDiskChaos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiskChaosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaosDaemonServer).ApplyDiskChaos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ChaosDaemon/ApplyDiskChaos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosDaemonServer).ApplyDiskChaos(ctx, req.(*DiskChaosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChaosDaemon_RecoverDiskChaos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiskChaosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaosDaemonServer).RecoverDiskChaos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ChaosDaemon/RecoverDiskChaos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosDaemonServer).RecoverDiskChaos(ctx, req.(*DiskChaosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChaosDaemon_StopKubelet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KubeletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaosDaemonServer).StopKubelet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ChaosDaemon/StopKubelet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosDaemonServer).StopKubelet(ctx, req.(*KubeletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChaosDaemon_StartKubelet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KubeletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaosDaemonServer).StartKubelet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ChaosDaemon/StartKubelet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosDaemonServer).StartKubelet(ctx, req.(*KubeletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChaosDaemon_RenewLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaosDaemonServer).RenewLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ChaosDaemon/RenewLease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosDaemonServer).RenewLease(ctx, req.(*LeaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ChaosDaemon_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "pb.ChaosDaemon",
 	HandlerType: (*ChaosDaemonServer)(nil),
@@ -1937,99 +2346,136 @@ var _ChaosDaemon_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ApplyIoChaos",
 			Handler:    _ChaosDaemon_ApplyIoChaos_Handler,
 		},
+		{
+			MethodName: "ApplyDiskChaos",
+			Handler:    _ChaosDaemon_ApplyDiskChaos_Handler,
+		},
+		{
+			MethodName: "RecoverDiskChaos",
+			Handler:    _ChaosDaemon_RecoverDiskChaos_Handler,
+		},
+		{
+			MethodName: "StopKubelet",
+			Handler:    _ChaosDaemon_StopKubelet_Handler,
+		},
+		{
+			MethodName: "StartKubelet",
+			Handler:    _ChaosDaemon_StartKubelet_Handler,
+		},
+		{
+			MethodName: "RenewLease",
+			Handler:    _ChaosDaemon_RenewLease_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "chaosdaemon.proto",
 }
 
-func init() { proto.RegisterFile("chaosdaemon.proto", fileDescriptor_chaosdaemon_d32cf42dab093cba) }
-
-var fileDescriptor_chaosdaemon_d32cf42dab093cba = []byte{
-	// 1364 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcd, 0x6e, 0xdb, 0xc6,
-	0x16, 0x36, 0x45, 0x49, 0x16, 0x8f, 0x24, 0x5b, 0x1e, 0x3b, 0xbe, 0x8c, 0x7d, 0xef, 0x8d, 0x43,
-	0x24, 0x45, 0x80, 0x02, 0x4a, 0xe3, 0x02, 0x5d, 0x74, 0xd1, 0xd6, 0x91, 0x95, 0x44, 0x4d, 0x22,
-	0xbb, 0x63, 0x06, 0x05, 0x0a, 0x14, 0x06, 0x7f, 0x46, 0x36, 0x63, 0x8a, 0x64, 0x38, 0xa3, 0x20,
-	0x46, 0x57, 0x7d, 0x86, 0xae, 0xba, 0x68, 0x17, 0x45, 0x9f, 0xa1, 0xcf, 0x57, 0xcc, 0x99, 0xa1,
-	0x44, 0xd9, 0xb2, 0xab, 0x66, 0xc5, 0x39, 0x7f, 0xdf, 0x9c, 0x9f, 0xe1, 0x37, 0x03, 0x1b, 0xc1,
-	0xb9, 0x97, 0xf2, 0xd0, 0x63, 0xe3, 0x34, 0xe9, 0x66, 0x79, 0x2a, 0x52, 0x52, 0xc9, 0xfc, 0x9d,
-	0xdd, 0xb3, 0x34, 0x3d, 0x8b, 0xd9, 0x63, 0xd4, 0xf8, 0x93, 0xd1, 0x63, 0x36, 0xce, 0xc4, 0xa5,
-	0x72, 0x70, 0xbe, 0x80, 0x86, 0x1b, 0xbc, 0xf0, 0x92, 0x30, 0x66, 0x64, 0x0b, 0x6a, 0x63, 0xef,
-	0x6d, 0x9a, 0xdb, 0xc6, 0x9e, 0xf1, 0xa8, 0x4d, 0x95, 0x80, 0xda, 0x28, 0x49, 0x73, 0xbb, 0xa2,
-	0xb5, 0x52, 0x70, 0x7c, 0xe8, 0xf4, 0xd2, 0x44, 0x78, 0x51, 0xc2, 0x72, 0xca, 0xde, 0x4d, 0x18,
-	0x17, 0xe4, 0x53, 0xa8, 0x7b, 0x81, 0x88, 0xd2, 0x04, 0x01, 0x9a, 0xfb, 0x9b, 0xdd, 0xcc, 0xef,
-	0x4e, 0xbd, 0x0e, 0xd0, 0x44, 0xb5, 0x0b, 0xb9, 0x0f, 0xad, 0xa0, 0x30, 0x9d, 0x46, 0x21, 0xa2,
-	0x5b, 0xb4, 0x39, 0xd5, 0x0d, 0x42, 0xe7, 0x21, 0x6c, 0x94, 0xf6, 0xe0, 0x59, 0x9a, 0x70, 0x46,
-	0x3a, 0x60, 0x66, 0x51, 0xa8, 0x53, 0x94, 0x4b, 0xe7, 0x77, 0x03, 0x5a, 0x43, 0x26, 0xd8, 0xb8,
-	0xc8, 0xe3, 0x1e, 0xd4, 0x12, 0x29, 0xeb, 0x34, 0x2c, 0x99, 0x86, 0x72, 0x50, 0xfa, 0x25, 0xf6,
-	0x26, 0x0f, 0xa0, 0x7e, 0x8e, 0x5d, 0xb1, 0x4d, 0x04, 0x69, 0x49, 0x90, 0xa2, 0x53, 0x54, 0xdb,
-	0xa4, 0x57, 0xe6, 0xe5, 0x2c, 0x11, 0x76, 0x75, 0x91, 0x97, 0xb2, 0x39, 0x7f, 0x99, 0x50, 0xc3,
-	0xfd, 0x09, 0x81, 0xaa, 0x88, 0xc6, 0x4c, 0x67, 0x8f, 0x6b, 0xb2, 0x0d, 0xf5, 0xb7, 0x91, 0x10,
-	0xac, 0x68, 0xb0, 0x96, 0xc8, 0xff, 0x00, 0x42, 0x16, 0x7b, 0x97, 0xa7, 0x41, 0x9a, 0xe7, 0x98,
-	0x45, 0x85, 0x5a, 0xa8, 0xe9, 0xa5, 0x39, 0x8e, 0x25, 0x8e, 0xc6, 0x91, 0xda, 0xb9, 0x4d, 0x95,
-	0x20, 0x37, 0x88, 0x53, 0xce, 0xed, 0x1a, 0xba, 0xe3, 0x9a, 0xec, 0x82, 0x25, 0xbf, 0x0a, 0xa7,
-	0x8e, 0x86, 0x86, 0x54, 0x20, 0x4c, 0x07, 0xcc, 0x33, 0x2f, 0xb3, 0x57, 0x55, 0x3b, 0xcf, 0xbc,
-	0x8c, 0xfc, 0x17, 0xac, 0x70, 0x92, 0xc5, 0x51, 0xe0, 0x09, 0x66, 0x37, 0xf4, 0xb6, 0x85, 0x82,
-	0x3c, 0x84, 0xb5, 0xa9, 0xa0, 0x10, 0x2d, 0x74, 0x69, 0x4f, 0xb5, 0x08, 0x6b, 0xc3, 0x6a, 0xce,
-	0xd2, 0x3c, 0x64, 0xb9, 0x0d, 0x68, 0x2f, 0x44, 0xd9, 0x7b, 0xbd, 0x54, 0xe1, 0x4d, 0x34, 0x37,
-	0xb5, 0xae, 0x08, 0x96, 0xa6, 0x49, 0x26, 0xec, 0x96, 0x0a, 0xd6, 0xa2, 0x1a, 0x1c, 0x2e, 0x55,
-	0x70, 0x5b, 0x05, 0x6b, 0x1d, 0x06, 0xcf, 0x46, 0xb2, 0x76, 0xf3, 0x48, 0x4a, 0xe3, 0x5d, 0xbf,
-	0x79, 0xbc, 0xce, 0xb7, 0x00, 0xae, 0x3f, 0x2a, 0x8e, 0xd5, 0x5d, 0x30, 0x85, 0x3f, 0xd2, 0x87,
-	0x6a, 0x15, 0x03, 0xfc, 0x11, 0x95, 0xba, 0x65, 0x0e, 0xf3, 0xcf, 0x06, 0x98, 0xae, 0x3f, 0x92,
-	0x13, 0xca, 0x65, 0x67, 0x25, 0x4c, 0x95, 0xe2, 0x7a, 0x36, 0xcb, 0x4a, 0x79, 0x96, 0xdb, 0x50,
-	0xf7, 0x27, 0xa3, 0x11, 0x53, 0xc3, 0x6f, 0x53, 0x2d, 0xc9, 0x79, 0x66, 0xcc, 0xbb, 0x38, 0x45,
-	0x98, 0x2a, 0xc2, 0x34, 0xa4, 0x82, 0x4a, 0xa8, 0x5d, 0xb0, 0xc6, 0x51, 0x72, 0xea, 0x4f, 0x72,
-	0x2e, 0xf0, 0x14, 0xb4, 0x69, 0x63, 0x1c, 0x25, 0x4f, 0xa5, 0xec, 0x50, 0x68, 0x7d, 0x17, 0x46,
-	0x3c, 0x28, 0xfd, 0x28, 0xef, 0xa4, 0x5c, 0xfe, 0x51, 0x94, 0x83, 0xd2, 0x2f, 0x53, 0xd7, 0x4f,
-	0x50, 0xc3, 0x90, 0x52, 0xe3, 0x8d, 0xa5, 0x1a, 0x5f, 0xb9, 0xe5, 0xbf, 0x92, 0xff, 0xc9, 0x65,
-	0xa6, 0xfe, 0x3d, 0x8b, 0xe2, 0x5a, 0xea, 0xbc, 0xfc, 0x8c, 0xdb, 0xd5, 0x3d, 0x53, 0xea, 0xe4,
-	0xda, 0xf1, 0x61, 0xb3, 0x3f, 0xf6, 0x44, 0x70, 0xfe, 0x2c, 0x8a, 0xc5, 0x8c, 0x88, 0x1e, 0x41,
-	0x7d, 0x84, 0x0a, 0x9d, 0x4a, 0x47, 0x6e, 0x32, 0xe7, 0xa8, 0xed, 0xcb, 0x14, 0x98, 0x43, 0xab,
-	0x1c, 0xaa, 0x58, 0x52, 0x04, 0xe7, 0x88, 0x6d, 0x51, 0x25, 0x94, 0xaa, 0xaf, 0xdc, 0x52, 0xfd,
-	0x27, 0xb0, 0x1a, 0xc4, 0x1e, 0xe7, 0x51, 0xb8, 0x90, 0x56, 0x0a, 0xa3, 0xf3, 0x03, 0xac, 0xbb,
-	0xc1, 0x7c, 0x4d, 0x0f, 0xae, 0xd4, 0xa4, 0x23, 0xff, 0x7d, 0x3d, 0x9f, 0x49, 0xc6, 0xd7, 0xb5,
-	0x2c, 0x35, 0x33, 0xe7, 0x0d, 0xb4, 0x07, 0xc7, 0x27, 0x4c, 0xf0, 0x22, 0x97, 0xfb, 0x50, 0x8f,
-	0x32, 0xce, 0x04, 0xb7, 0x8d, 0x3d, 0xb3, 0x38, 0x38, 0xe8, 0x42, 0xb5, 0x61, 0x99, 0x44, 0x9e,
-	0x40, 0x0d, 0x63, 0xe4, 0x64, 0x13, 0x4f, 0xb3, 0xa2, 0x45, 0x71, 0x2d, 0xbb, 0x1c, 0x44, 0x61,
-	0xce, 0xed, 0x0a, 0x8e, 0x5b, 0x09, 0xce, 0x8f, 0x70, 0x67, 0x90, 0x09, 0xcf, 0x8f, 0x19, 0xef,
-	0x9d, 0x7b, 0x51, 0x52, 0xce, 0x28, 0x40, 0x45, 0x39, 0x23, 0x74, 0xa1, 0xda, 0xb0, 0x4c, 0x46,
-	0x7f, 0x18, 0x50, 0xc3, 0xa0, 0x85, 0x29, 0x3d, 0x01, 0x2b, 0x8c, 0x72, 0xa6, 0x6e, 0x38, 0x19,
-	0xbd, 0xa6, 0x6f, 0x38, 0x19, 0xd1, 0x3d, 0x2c, 0x4c, 0x74, 0xe6, 0x25, 0x7f, 0x61, 0xdd, 0x28,
-	0x13, 0xcb, 0x28, 0xba, 0xb3, 0x0d, 0x75, 0xe1, 0xe5, 0x67, 0x4c, 0xb1, 0xb7, 0x45, 0xb5, 0xe4,
-	0x38, 0x60, 0x4d, 0x71, 0x88, 0x05, 0xb5, 0xc1, 0xf0, 0xf8, 0x8d, 0xdb, 0x59, 0x21, 0x00, 0xf5,
-	0xa3, 0x37, 0xae, 0x5c, 0x1b, 0xce, 0x07, 0x68, 0xba, 0xd1, 0x98, 0xcd, 0x2a, 0x9f, 0x2f, 0xcb,
-	0xb8, 0x7e, 0x97, 0x75, 0xc0, 0xe4, 0x2c, 0xc0, 0x94, 0x4d, 0x2a, 0x97, 0x58, 0x9e, 0x54, 0x99,
-	0xa8, 0xc2, 0x35, 0xd9, 0x83, 0x56, 0x10, 0x5f, 0x9c, 0x46, 0x21, 0x3f, 0x1d, 0x7b, 0xfc, 0x42,
-	0x33, 0x0b, 0x04, 0xf1, 0xc5, 0x20, 0xe4, 0xaf, 0x3d, 0x7e, 0xe1, 0x30, 0x58, 0xbf, 0x72, 0x9b,
-	0x93, 0xfd, 0xb9, 0x2b, 0x7f, 0x6d, 0x7f, 0x67, 0xc1, 0x95, 0xdf, 0x9d, 0xbf, 0xf9, 0x9d, 0xff,
-	0x43, 0x5d, 0x47, 0x37, 0xa0, 0xfa, 0x72, 0xf0, 0xea, 0x95, 0x2a, 0xf0, 0x79, 0xdf, 0x3d, 0x1e,
-	0x1c, 0x76, 0x0c, 0xe7, 0x37, 0x03, 0x36, 0xfa, 0x1f, 0x58, 0x70, 0x22, 0x72, 0xc6, 0xa7, 0x13,
-	0x7e, 0x02, 0x35, 0x1e, 0xa4, 0x19, 0xd3, 0x1b, 0xed, 0xe2, 0x2f, 0x7d, 0xd5, 0xab, 0x7b, 0x22,
-	0x5d, 0xa8, 0xf2, 0x2c, 0x75, 0xb9, 0x52, 0xee, 0xb2, 0xbc, 0xe1, 0x38, 0x46, 0xa5, 0x39, 0xd7,
-	0x14, 0x33, 0x53, 0x38, 0xf7, 0xa0, 0x86, 0x28, 0xa4, 0x0d, 0x56, 0xef, 0x68, 0xe8, 0x1e, 0x0c,
-	0x86, 0x7d, 0xda, 0x59, 0x21, 0xab, 0x60, 0x1e, 0x1f, 0xc9, 0xfc, 0x86, 0x40, 0xca, 0x1b, 0xeb,
-	0x77, 0xc9, 0x0e, 0x34, 0xa2, 0x84, 0x0b, 0x2f, 0x09, 0x8a, 0x53, 0x33, 0x95, 0xd5, 0x86, 0x5e,
-	0x2e, 0xe4, 0xdc, 0xf4, 0x18, 0x66, 0x0a, 0xe7, 0x08, 0x36, 0x7b, 0xd2, 0x2d, 0x9e, 0x2f, 0xf8,
-	0xe3, 0x01, 0xff, 0x34, 0x60, 0xf3, 0x20, 0xcb, 0xe2, 0xcb, 0x41, 0xda, 0x93, 0x2f, 0xc2, 0x02,
-	0xd1, 0x86, 0x55, 0x35, 0x02, 0xae, 0x01, 0x0b, 0x51, 0x76, 0xea, 0x7d, 0x1a, 0x4f, 0x34, 0x98,
-	0x45, 0xb5, 0x74, 0xed, 0x70, 0x99, 0xd7, 0x0f, 0x57, 0x39, 0xcd, 0x2a, 0x66, 0x72, 0x43, 0x9a,
-	0xb5, 0xab, 0x69, 0x1e, 0xc3, 0xd6, 0x7c, 0x96, 0x37, 0x74, 0xd2, 0x5c, 0xba, 0xf0, 0x01, 0x80,
-	0x1b, 0x94, 0xca, 0x35, 0x45, 0x50, 0x10, 0x42, 0x5d, 0x31, 0x1b, 0x95, 0xaa, 0x65, 0xa8, 0xe0,
-	0x57, 0x03, 0x2a, 0x6e, 0x40, 0xee, 0xe9, 0x8b, 0x48, 0x1d, 0xba, 0xa6, 0x02, 0xe9, 0xba, 0x97,
-	0x19, 0xd3, 0xb7, 0xd2, 0xf4, 0xad, 0x59, 0xb9, 0xe1, 0xad, 0xa9, 0x5f, 0x0d, 0xe6, 0x82, 0x57,
-	0xc3, 0x16, 0xd4, 0x90, 0x0f, 0x34, 0x09, 0x28, 0xc1, 0xd9, 0x83, 0xaa, 0xc4, 0x97, 0xbf, 0xff,
-	0xb0, 0xef, 0xf6, 0x5f, 0x77, 0x56, 0xe4, 0x49, 0x7c, 0x7a, 0x30, 0x3c, 0xfc, 0x7e, 0x70, 0xe8,
-	0xbe, 0xe8, 0x18, 0xfb, 0xbf, 0xd4, 0xa0, 0x89, 0x2d, 0x3b, 0xc4, 0xa7, 0xbe, 0xfc, 0x09, 0x4f,
-	0x98, 0x70, 0x03, 0x4e, 0xd6, 0x54, 0x82, 0x45, 0x0b, 0x76, 0xb6, 0xbb, 0xea, 0xed, 0xdf, 0x2d,
-	0xde, 0xfe, 0xdd, 0xbe, 0x7c, 0xfb, 0x3b, 0x2b, 0xe4, 0x4b, 0x68, 0x3e, 0x8b, 0x27, 0xfc, 0x5c,
-	0x11, 0x3b, 0xd9, 0x98, 0x32, 0xf8, 0x12, 0xb1, 0x2f, 0x60, 0xe3, 0x84, 0x89, 0x79, 0x22, 0x26,
-	0x77, 0x11, 0x61, 0x11, 0x39, 0xdf, 0x9a, 0x45, 0x5b, 0x66, 0x1e, 0x8d, 0xd9, 0xd1, 0x68, 0xc4,
-	0x99, 0x20, 0xeb, 0x58, 0xc0, 0x8c, 0xde, 0x6e, 0x89, 0xfd, 0x0a, 0x36, 0x28, 0x0b, 0xd2, 0xf7,
-	0x2c, 0xff, 0xb8, 0xf8, 0xaf, 0xa1, 0x3d, 0x25, 0xaa, 0x97, 0x51, 0x1c, 0x93, 0xad, 0x39, 0xee,
-	0xfa, 0x67, 0x80, 0x6f, 0x4a, 0x74, 0xf8, 0x9c, 0x89, 0xe3, 0x28, 0xbc, 0x01, 0xe2, 0xce, 0x15,
-	0xad, 0x3a, 0xe7, 0x88, 0xd0, 0x9e, 0x31, 0x49, 0x9a, 0x73, 0x72, 0x67, 0x21, 0xab, 0xed, 0x6c,
-	0x5f, 0x55, 0x4f, 0x11, 0x0e, 0x61, 0xbd, 0xcc, 0x1d, 0x12, 0xe3, 0x3f, 0xb8, 0xdb, 0x75, 0x42,
-	0xb9, 0xa5, 0x92, 0x1e, 0xb4, 0xca, 0x7f, 0xa2, 0x82, 0x58, 0xc0, 0x20, 0x3b, 0xf6, 0x75, 0x43,
-	0x91, 0x8a, 0x5f, 0x47, 0xd8, 0xcf, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x00, 0x06, 0x71, 0x93,
-	0x86, 0x0e, 0x00, 0x00,
+func init() { proto.RegisterFile("chaosdaemon.proto", fileDescriptor_chaosdaemon_ab6d4f7b9d2a0f14) }
+
+var fileDescriptor_chaosdaemon_ab6d4f7b9d2a0f14 = []byte{
+	// 1640 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x17, 0xdd, 0x6e, 0x1b, 0x4d,
+	0x35, 0xeb, 0xb5, 0x1d, 0xef, 0xf1, 0x4f, 0x9c, 0x69, 0x1a, 0xdc, 0x04, 0x68, 0xba, 0x6a, 0x21,
+	0x2a, 0x92, 0x4b, 0x53, 0x09, 0x21, 0x40, 0x40, 0x1a, 0xbb, 0x8d, 0x69, 0xeb, 0x84, 0xf5, 0x46,
+	0x54, 0xdc, 0x58, 0xeb, 0xdd, 0x71, 0xbc, 0xcd, 0xfe, 0x75, 0x67, 0x5c, 0x48, 0xb9, 0x42, 0xe2,
+	0x25, 0xb8, 0x01, 0x09, 0x78, 0x06, 0x1e, 0x83, 0x67, 0x81, 0x37, 0x40, 0x73, 0x66, 0xd6, 0x5e,
+	0x3b, 0x4e, 0x70, 0x2b, 0x2e, 0xbe, 0xab, 0x9d, 0xf3, 0x3b, 0xe7, 0x6f, 0xce, 0x39, 0x0b, 0xdb,
+	0xee, 0xc4, 0x89, 0x99, 0xe7, 0xd0, 0x30, 0x8e, 0xda, 0x49, 0x1a, 0xf3, 0x98, 0x14, 0x92, 0xd1,
+	0xde, 0xfe, 0x65, 0x1c, 0x5f, 0x06, 0xf4, 0x19, 0x62, 0x46, 0xd3, 0xf1, 0x33, 0x1a, 0x26, 0xfc,
+	0x5a, 0x32, 0x98, 0x3f, 0x82, 0x8a, 0xed, 0x9e, 0x3a, 0x91, 0x17, 0x50, 0xb2, 0x03, 0xa5, 0xd0,
+	0xf9, 0x10, 0xa7, 0x2d, 0xed, 0x40, 0x3b, 0xac, 0x5b, 0x12, 0x40, 0xac, 0x1f, 0xc5, 0x69, 0xab,
+	0xa0, 0xb0, 0x02, 0x30, 0x47, 0xd0, 0x3c, 0x89, 0x23, 0xee, 0xf8, 0x11, 0x4d, 0x2d, 0xfa, 0x71,
+	0x4a, 0x19, 0x27, 0x3f, 0x80, 0xb2, 0xe3, 0x72, 0x3f, 0x8e, 0x50, 0x41, 0xf5, 0xe8, 0x5e, 0x3b,
+	0x19, 0xb5, 0x67, 0x5c, 0xc7, 0x48, 0xb2, 0x14, 0x0b, 0x79, 0x04, 0x35, 0x37, 0x23, 0x0d, 0x7d,
+	0x0f, 0xb5, 0x1b, 0x56, 0x75, 0x86, 0xeb, 0x79, 0xe6, 0x13, 0xd8, 0xce, 0xdd, 0xc1, 0x92, 0x38,
+	0x62, 0x94, 0x34, 0x41, 0x4f, 0x7c, 0x4f, 0x99, 0x28, 0x8e, 0xe6, 0x5f, 0x34, 0xa8, 0xf5, 0x29,
+	0xa7, 0x61, 0x66, 0xc7, 0x43, 0x28, 0x45, 0x02, 0x56, 0x66, 0x18, 0xc2, 0x0c, 0xc9, 0x20, 0xf1,
+	0x6b, 0xdc, 0x4d, 0x1e, 0x43, 0x79, 0x82, 0x51, 0x69, 0xe9, 0xa8, 0xa4, 0x26, 0x94, 0x64, 0x91,
+	0xb2, 0x14, 0x4d, 0x70, 0x25, 0x4e, 0x4a, 0x23, 0xde, 0x2a, 0xae, 0xe2, 0x92, 0x34, 0xf3, 0x9f,
+	0x3a, 0x94, 0xf0, 0x7e, 0x42, 0xa0, 0xc8, 0xfd, 0x90, 0x2a, 0xeb, 0xf1, 0x4c, 0x76, 0xa1, 0xfc,
+	0xc1, 0xe7, 0x9c, 0x66, 0x01, 0x56, 0x10, 0xf9, 0x0e, 0x80, 0x47, 0x03, 0xe7, 0x7a, 0xe8, 0xc6,
+	0x69, 0x8a, 0x56, 0x14, 0x2c, 0x03, 0x31, 0x27, 0x71, 0x8a, 0x69, 0x09, 0xfc, 0xd0, 0x97, 0x37,
+	0xd7, 0x2d, 0x09, 0x88, 0x0b, 0x82, 0x98, 0xb1, 0x56, 0x09, 0xd9, 0xf1, 0x4c, 0xf6, 0xc1, 0x10,
+	0x5f, 0xa9, 0xa7, 0x8c, 0x84, 0x8a, 0x40, 0xa0, 0x9a, 0x26, 0xe8, 0x97, 0x4e, 0xd2, 0xda, 0x94,
+	0xe1, 0xbc, 0x74, 0x12, 0xf2, 0x6d, 0x30, 0xbc, 0x69, 0x12, 0xf8, 0xae, 0xc3, 0x69, 0xab, 0xa2,
+	0xae, 0xcd, 0x10, 0xe4, 0x09, 0x34, 0x66, 0x80, 0xd4, 0x68, 0x20, 0x4b, 0x7d, 0x86, 0x45, 0xb5,
+	0x2d, 0xd8, 0x4c, 0x69, 0x9c, 0x7a, 0x34, 0x6d, 0x01, 0xd2, 0x33, 0x50, 0xc4, 0x5e, 0x1d, 0xa5,
+	0x78, 0x15, 0xc9, 0x55, 0x85, 0xcb, 0x84, 0x05, 0x69, 0x9a, 0xf0, 0x56, 0x4d, 0x0a, 0x2b, 0x50,
+	0x26, 0x0e, 0x8f, 0x52, 0xb8, 0x2e, 0x85, 0x15, 0x0e, 0x85, 0xe7, 0x29, 0x69, 0xdc, 0x9e, 0x92,
+	0x5c, 0x7a, 0xb7, 0x6e, 0x4f, 0xaf, 0xf9, 0x2b, 0x00, 0x7b, 0x34, 0xce, 0xca, 0xea, 0x01, 0xe8,
+	0x7c, 0x34, 0x56, 0x45, 0xb5, 0x89, 0x02, 0xa3, 0xb1, 0x25, 0x70, 0xeb, 0x14, 0xf3, 0x1f, 0x35,
+	0xd0, 0xed, 0xd1, 0x58, 0x64, 0x28, 0x15, 0x91, 0x15, 0x6a, 0x8a, 0x16, 0x9e, 0xe7, 0xb9, 0x2c,
+	0xe4, 0x73, 0xb9, 0x0b, 0xe5, 0xd1, 0x74, 0x3c, 0xa6, 0x32, 0xf9, 0x75, 0x4b, 0x41, 0x22, 0x9f,
+	0x09, 0x75, 0xae, 0x86, 0xa8, 0xa6, 0x88, 0x6a, 0x2a, 0x02, 0x61, 0x09, 0x55, 0xfb, 0x60, 0x84,
+	0x7e, 0x34, 0x1c, 0x4d, 0x53, 0xc6, 0xb1, 0x0a, 0xea, 0x56, 0x25, 0xf4, 0xa3, 0x97, 0x02, 0x36,
+	0x2d, 0xa8, 0xfd, 0xda, 0xf3, 0x99, 0x9b, 0x7b, 0x28, 0x1f, 0x05, 0x9c, 0x7f, 0x28, 0x92, 0x41,
+	0xe2, 0xd7, 0xf1, 0xeb, 0x0f, 0x50, 0x42, 0x91, 0x5c, 0xe0, 0xb5, 0xb5, 0x02, 0x5f, 0xb8, 0xe3,
+	0x5d, 0x89, 0x77, 0x72, 0x9d, 0xc8, 0xb7, 0x67, 0x58, 0x78, 0x16, 0x38, 0x27, 0xbd, 0x64, 0xad,
+	0xe2, 0x81, 0x2e, 0x70, 0xe2, 0x6c, 0x8e, 0xe0, 0x5e, 0x37, 0x74, 0xb8, 0x3b, 0x79, 0xe5, 0x07,
+	0x7c, 0xde, 0x88, 0x0e, 0xa1, 0x3c, 0x46, 0x84, 0x32, 0xa5, 0x29, 0x2e, 0x59, 0x60, 0x54, 0xf4,
+	0x75, 0x1c, 0x4c, 0xa1, 0x96, 0x17, 0x95, 0x5d, 0x92, 0xbb, 0x13, 0xd4, 0x6d, 0x58, 0x12, 0xc8,
+	0x79, 0x5f, 0xb8, 0xc3, 0xfb, 0xef, 0xc1, 0xa6, 0x1b, 0x38, 0x8c, 0xf9, 0xde, 0xca, 0xb6, 0x92,
+	0x11, 0xcd, 0xdf, 0xc2, 0x96, 0xed, 0x2e, 0xfa, 0xf4, 0x78, 0xc9, 0x27, 0x25, 0xf9, 0xe5, 0xfe,
+	0xfc, 0x10, 0x2a, 0x99, 0xd8, 0x7a, 0x39, 0x33, 0x2f, 0xa0, 0xde, 0x3b, 0x1f, 0x50, 0xce, 0x32,
+	0x5b, 0x1e, 0x41, 0xd9, 0x4f, 0x18, 0xe5, 0xac, 0xa5, 0x1d, 0xe8, 0x59, 0xe1, 0x20, 0x8b, 0xa5,
+	0x08, 0xeb, 0x18, 0xf2, 0x1c, 0x4a, 0x28, 0x23, 0x32, 0x1b, 0x39, 0xaa, 0x2b, 0x1a, 0x16, 0x9e,
+	0x45, 0x94, 0x5d, 0xdf, 0x4b, 0x59, 0xab, 0x80, 0xe9, 0x96, 0x80, 0xf9, 0x27, 0x0d, 0xee, 0xf7,
+	0x12, 0xee, 0x8c, 0x02, 0xca, 0x4e, 0x26, 0x8e, 0x1f, 0xe5, 0x4d, 0x72, 0x11, 0x91, 0x37, 0x09,
+	0x59, 0x2c, 0x45, 0x58, 0xa7, 0xeb, 0x3f, 0x81, 0x46, 0x40, 0x1d, 0x46, 0x87, 0x1e, 0x75, 0xbc,
+	0xc0, 0x8f, 0x64, 0x05, 0xea, 0x56, 0x1d, 0xb1, 0x1d, 0x85, 0x34, 0xff, 0xa6, 0x41, 0x09, 0x75,
+	0xaf, 0x34, 0xfd, 0x39, 0x18, 0x9e, 0x9f, 0x52, 0x39, 0x09, 0xc5, 0x25, 0x0d, 0x35, 0x09, 0x85,
+	0x44, 0xbb, 0x93, 0x91, 0xac, 0x39, 0x97, 0x78, 0xea, 0x2a, 0xa0, 0x3a, 0xba, 0xab, 0x20, 0x81,
+	0xe7, 0x4e, 0x7a, 0x49, 0x65, 0x97, 0x37, 0x2c, 0x05, 0x99, 0x26, 0x18, 0x33, 0x3d, 0xc4, 0x80,
+	0x52, 0xaf, 0x7f, 0x7e, 0x61, 0x37, 0x37, 0x08, 0x40, 0xf9, 0xec, 0xc2, 0x16, 0x67, 0xcd, 0xfc,
+	0xab, 0x06, 0x55, 0xdb, 0x0f, 0xe9, 0x3c, 0x42, 0x8b, 0xee, 0x6b, 0x37, 0xdd, 0x6f, 0x82, 0xce,
+	0xa8, 0x8b, 0x36, 0xeb, 0x96, 0x38, 0xa2, 0x7f, 0x02, 0x25, 0xc3, 0x80, 0x67, 0x72, 0x00, 0x35,
+	0x37, 0xb8, 0x1a, 0xfa, 0x1e, 0x1b, 0x86, 0x0e, 0xbb, 0x52, 0x2d, 0x08, 0xdc, 0xe0, 0xaa, 0xe7,
+	0xb1, 0x77, 0x0e, 0xbb, 0x5a, 0x11, 0xc6, 0xd2, 0xaa, 0x30, 0x52, 0xd8, 0x5a, 0xda, 0x0e, 0xc8,
+	0xd1, 0xc2, 0x0a, 0xd1, 0x38, 0xda, 0x5b, 0xb1, 0x42, 0xb4, 0x17, 0x37, 0x09, 0xf3, 0xbb, 0x50,
+	0x56, 0xd2, 0x15, 0x28, 0xbe, 0xe9, 0xbd, 0x7d, 0x2b, 0x03, 0xf1, 0xba, 0x6b, 0x9f, 0xf7, 0x3a,
+	0x4d, 0xcd, 0xfc, 0x8f, 0x06, 0xdb, 0xdd, 0xdf, 0x53, 0x77, 0xc0, 0x53, 0xca, 0x66, 0x05, 0xf3,
+	0x1c, 0x4a, 0xcc, 0x8d, 0x13, 0xaa, 0x2e, 0xda, 0xc7, 0x16, 0xb1, 0xcc, 0xd5, 0x1e, 0x08, 0x16,
+	0x4b, 0x72, 0xe6, 0xb2, 0x51, 0xc8, 0x67, 0x43, 0x4c, 0x4c, 0x86, 0x52, 0x71, 0xca, 0x54, 0xcb,
+	0x9a, 0x23, 0xc8, 0x21, 0x34, 0x43, 0x1a, 0xc6, 0xe9, 0xf5, 0x70, 0x12, 0x07, 0xde, 0x90, 0xf9,
+	0x9f, 0xb3, 0xae, 0xdd, 0x90, 0xf8, 0xd3, 0x38, 0xf0, 0x06, 0xfe, 0x67, 0x9a, 0xe3, 0x4c, 0x9d,
+	0x30, 0x91, 0xfd, 0xbd, 0x94, 0xe7, 0xb4, 0x9c, 0x30, 0x11, 0x5d, 0xde, 0x7c, 0x08, 0x25, 0xb4,
+	0x8c, 0xd4, 0xc1, 0x38, 0x39, 0xeb, 0xdb, 0xc7, 0xbd, 0x7e, 0xd7, 0x6a, 0x6e, 0x90, 0x4d, 0xd0,
+	0xcf, 0xcf, 0x84, 0xcf, 0x7f, 0xd7, 0x80, 0xe4, 0xbd, 0x51, 0xcb, 0xd3, 0x1e, 0x54, 0xfc, 0x88,
+	0x71, 0x27, 0x72, 0xb3, 0x92, 0x9d, 0xc1, 0xd2, 0x0b, 0x27, 0xe5, 0xa2, 0x66, 0x54, 0x09, 0xcc,
+	0x11, 0xe4, 0xfb, 0xb0, 0xa5, 0x6c, 0x9b, 0x29, 0x90, 0x9e, 0x2a, 0xd3, 0x7a, 0x99, 0x9a, 0xa7,
+	0xb0, 0xad, 0x18, 0x51, 0x78, 0x88, 0xfb, 0x4e, 0x11, 0xd5, 0x29, 0x0d, 0x83, 0x4c, 0xa9, 0xf9,
+	0x0f, 0x0d, 0xee, 0x9d, 0x08, 0xa9, 0x60, 0x31, 0x37, 0xdf, 0x40, 0x33, 0x8f, 0x93, 0x24, 0xb8,
+	0xee, 0xc5, 0x27, 0x62, 0xc3, 0xce, 0xcc, 0x6c, 0xc1, 0xa6, 0x2c, 0x41, 0xa6, 0xac, 0xcc, 0x40,
+	0x51, 0x29, 0x9f, 0xe2, 0x60, 0xaa, 0x2c, 0x34, 0x2c, 0x05, 0xdd, 0x78, 0x83, 0xfa, 0xcd, 0x37,
+	0x98, 0xf7, 0x5d, 0xda, 0x73, 0x8b, 0xef, 0xa5, 0x25, 0xdf, 0xcd, 0x73, 0xd8, 0x59, 0xb4, 0xf2,
+	0x96, 0xa4, 0xeb, 0xeb, 0x46, 0xd3, 0xfc, 0xb7, 0x06, 0xcd, 0x8e, 0xcf, 0xae, 0x16, 0xbc, 0x7e,
+	0xb1, 0xf4, 0x44, 0xf1, 0xe5, 0x2c, 0x73, 0xb5, 0xbf, 0x78, 0xdb, 0x17, 0xad, 0x26, 0x71, 0xf8,
+	0x24, 0x9b, 0xf9, 0xe2, 0x2c, 0x70, 0xb9, 0xf7, 0x82, 0x67, 0xec, 0x95, 0x51, 0xec, 0x51, 0xa6,
+	0xde, 0x86, 0x82, 0x16, 0xdc, 0x2c, 0x2f, 0x16, 0x8d, 0x79, 0x98, 0x6f, 0x11, 0xaf, 0x64, 0x8b,
+	0xd8, 0x81, 0x66, 0xaf, 0x7f, 0xd6, 0xe9, 0x0e, 0xbb, 0xef, 0x4f, 0x8f, 0x2f, 0x06, 0x76, 0xef,
+	0xac, 0xdf, 0xd4, 0xcc, 0xa7, 0xd0, 0x78, 0x33, 0x1d, 0xd1, 0x80, 0xf2, 0x5c, 0x96, 0x19, 0x4d,
+	0x3f, 0xf9, 0xb3, 0x5a, 0xcc, 0x40, 0x33, 0x01, 0xb0, 0xdd, 0x5c, 0x35, 0xe8, 0xdc, 0xcd, 0xc6,
+	0x4f, 0x59, 0x0e, 0x52, 0x4b, 0xa0, 0xfe, 0x8f, 0x83, 0xe7, 0xcf, 0x1a, 0x14, 0x6c, 0x97, 0x3c,
+	0x54, 0xeb, 0x91, 0x4c, 0x40, 0x55, 0xde, 0xd5, 0xb6, 0xaf, 0x13, 0xaa, 0x76, 0xa5, 0xd9, 0x1f,
+	0x50, 0xe1, 0x96, 0x3f, 0x20, 0xb5, 0xcb, 0xea, 0x2b, 0x76, 0xd9, 0x1d, 0x28, 0xe1, 0xf4, 0x51,
+	0x23, 0x47, 0x02, 0xe6, 0x01, 0x14, 0x85, 0x7e, 0x31, 0x6c, 0xfa, 0x5d, 0xbb, 0xfb, 0xae, 0xb9,
+	0x21, 0x7a, 0xcf, 0xcb, 0xe3, 0x7e, 0xe7, 0x37, 0xbd, 0x8e, 0x7d, 0xda, 0xd4, 0xcc, 0xf7, 0x50,
+	0x7b, 0x2b, 0x8c, 0xfd, 0x82, 0x79, 0x73, 0xd3, 0xeb, 0xc2, 0x0a, 0xaf, 0x8f, 0xfe, 0xb5, 0x09,
+	0x55, 0x2c, 0xae, 0x0e, 0xfe, 0xda, 0x8a, 0x21, 0x31, 0xa0, 0xdc, 0x76, 0x19, 0x69, 0x48, 0xd7,
+	0xb3, 0x1c, 0xec, 0xed, 0xb6, 0xe5, 0xbf, 0x6e, 0x3b, 0xfb, 0xd7, 0x6d, 0x77, 0xc5, 0xbf, 0xae,
+	0xb9, 0x41, 0x7e, 0x02, 0xd5, 0x57, 0xc1, 0x94, 0x4d, 0xe4, 0x22, 0x43, 0xb6, 0x67, 0x1b, 0xcb,
+	0x1a, 0xb2, 0xa7, 0xb0, 0x3d, 0xa0, 0x7c, 0x71, 0xef, 0x20, 0x0f, 0x50, 0xc3, 0xaa, 0x5d, 0xe4,
+	0x4e, 0x2b, 0xea, 0xc2, 0x72, 0x3f, 0xa4, 0x67, 0xe3, 0x31, 0xa3, 0x9c, 0x6c, 0xa1, 0x03, 0xf3,
+	0x29, 0x7d, 0x87, 0xec, 0xcf, 0x61, 0xdb, 0xa2, 0x6e, 0xfc, 0x89, 0xa6, 0x5f, 0x27, 0xff, 0x0b,
+	0xa8, 0xcf, 0x06, 0xe9, 0x1b, 0x3f, 0x08, 0xc8, 0xce, 0xc2, 0x6c, 0xfd, 0xdf, 0x0a, 0x7e, 0x99,
+	0x1b, 0xd7, 0xaf, 0x29, 0x3f, 0xf7, 0xbd, 0x5b, 0x54, 0xdc, 0x5f, 0xc2, 0xca, 0x3e, 0x84, 0x1a,
+	0xea, 0xf3, 0xa1, 0x24, 0x66, 0xe3, 0xfd, 0x95, 0x53, 0x77, 0x6f, 0x77, 0x19, 0x3d, 0xd3, 0xd0,
+	0x81, 0xad, 0xfc, 0xc0, 0x10, 0x3a, 0xbe, 0x85, 0xb7, 0xdd, 0x9c, 0x22, 0x77, 0x78, 0x72, 0x02,
+	0xb5, 0x7c, 0xa7, 0x94, 0x2a, 0x56, 0x74, 0xf8, 0xbd, 0xd6, 0x4d, 0x42, 0xce, 0x99, 0x06, 0x52,
+	0x66, 0xad, 0x4f, 0x46, 0x63, 0xb9, 0x13, 0xde, 0x61, 0xc6, 0x4b, 0x68, 0xaa, 0x8c, 0x7e, 0xbd,
+	0x8e, 0x9f, 0x42, 0x75, 0xc0, 0xe3, 0x44, 0xf5, 0x2c, 0x42, 0x84, 0xf8, 0x62, 0x03, 0xbb, 0x43,
+	0xf8, 0x67, 0x50, 0xc3, 0x29, 0xf7, 0x75, 0xd2, 0x3f, 0x06, 0xb0, 0x68, 0x44, 0x7f, 0x87, 0xaf,
+	0x9e, 0xe0, 0x3f, 0x56, 0xbe, 0x01, 0xdc, 0x2e, 0x39, 0x2a, 0x23, 0xe6, 0xc5, 0x7f, 0x07, 0x00,
+	0x72, 0xb9, 0x9e, 0xf5, 0xb1, 0x12, 0x00, 0x00,
 }
@@ -58,6 +58,8 @@ func (c *Config) GrpcAddr() string {
 type daemonServer struct {
 	crClient                 ContainerRuntimeInfoClient
 	backgroundProcessManager bpm.BackgroundProcessManager
+	timeWatchers             timeWatcherManager
+	leases                   leaseManager
 }
 
 func newDaemonServer(containerRuntime string) (*daemonServer, error) {
@@ -34,6 +34,10 @@ import (
 	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
 )
 
+const (
+	memStressBin = "/usr/local/bin/memstress"
+)
+
 var (
 	// Possible cgroup subsystems
 	cgroupSubsys = []string{"cpu", "memory", "systemd", "net_cls",
@@ -65,35 +69,69 @@ func (s *daemonServer) ExecStressors(ctx context.Context,
 		return nil, err
 	}
 
-	cmd := bpm.DefaultProcessBuilder("stress-ng", strings.Fields(req.Stressors)...).
-		EnablePause().
-		EnableSuicide().
-		SetNS(pid, bpm.PidNS).
-		Build()
+	resp := &pb.ExecStressResponse{}
+	if len(strings.TrimSpace(req.Stressors)) != 0 {
+		cmd := bpm.DefaultProcessBuilder("stress-ng", strings.Fields(req.Stressors)...).
+			EnablePause().
+			EnableSuicide().
+			SetNS(pid, bpm.PidNS).
+			SetNS(pid, bpm.NetNS).
+			Build()
+
+		resp.Instance, resp.StartTime, err = s.startStressProcess(cmd, control)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if req.MemoryHoldSize != 0 {
+		cmd := bpm.DefaultProcessBuilder(memStressBin,
+			strconv.FormatUint(req.MemoryHoldSize, 10),
+			strconv.FormatUint(req.MemoryRampRate, 10)).
+			EnablePause().
+			EnableSuicide().
+			Build()
 
-	err = s.backgroundProcessManager.StartProcess(cmd)
+		resp.MemoryInstance, resp.MemoryStartTime, err = s.startStressProcess(cmd, control)
+		if err != nil {
+			if len(resp.Instance) != 0 {
+				if kerr := s.cancelStressProcess(ctx, resp.Instance, resp.StartTime); kerr != nil {
+					log.Error(kerr, "kill stressors failed", "request", req)
+				}
+			}
+			return nil, err
+		}
+	}
+
+	return resp, nil
+}
+
+// startStressProcess starts the paused process, and resumes it after it's added into the cgroup,
+// so that all resources consumed by it are accounted to the cgroup
+func (s *daemonServer) startStressProcess(cmd *bpm.ManagedProcess, control cgroups.Cgroup) (string, int64, error) {
+	err := s.backgroundProcessManager.StartProcess(cmd)
 	if err != nil {
-		return nil, err
+		return "", 0, err
 	}
 	log.Info("Start process successfully")
 
 	procState, err := process.NewProcess(int32(cmd.Process.Pid))
 	if err != nil {
-		return nil, err
+		return "", 0, err
 	}
 	ct, err := procState.CreateTime()
 
 	if err = control.Add(cgroups.Process{Pid: cmd.Process.Pid}); err != nil {
 		if kerr := cmd.Process.Kill(); kerr != nil {
-			log.Error(kerr, "kill stressors failed", "request", req)
+			log.Error(kerr, "kill stressors failed", "pid", cmd.Process.Pid)
 		}
-		return nil, err
+		return "", 0, err
 	}
 
 	for {
 		// TODO: find a better way to resume pause process
 		if err := cmd.Process.Signal(syscall.SIGCONT); err != nil {
-			return nil, err
+			return "", 0, err
 		}
 
 		log.Info("send signal to resume process")
@@ -101,7 +139,7 @@ func (s *daemonServer) ExecStressors(ctx context.Context,
 
 		comm, err := ReadCommName(cmd.Process.Pid)
 		if err != nil {
-			return nil, err
+			return "", 0, err
 		}
 		if comm != "pause\n" {
 			log.Info("pause has been resumed", "comm", comm)
@@ -110,30 +148,39 @@ func (s *daemonServer) ExecStressors(ctx context.Context,
 		log.Info("the process hasn't resumed, step into the following loop", "comm", comm)
 	}
 
-	return &pb.ExecStressResponse{
-		Instance:  strconv.Itoa(cmd.Process.Pid),
-		StartTime: ct,
-	}, nil
+	return strconv.Itoa(cmd.Process.Pid), ct, nil
 }
 
 var errFinished = "os: process already finished"
 
 func (s *daemonServer) CancelStressors(ctx context.Context,
 	req *pb.CancelStressRequest) (*empty.Empty, error) {
-	pid, err := strconv.Atoi(req.Instance)
-	if err != nil {
-		return nil, err
-	}
 	log.Info("Canceling stressors", "request", req)
 
-	err = s.backgroundProcessManager.KillBackgroundProcess(ctx, pid, req.StartTime)
-	if err != nil {
-		return nil, err
+	if len(req.Instance) != 0 {
+		if err := s.cancelStressProcess(ctx, req.Instance, req.StartTime); err != nil {
+			return nil, err
+		}
 	}
+	if len(req.MemoryInstance) != 0 {
+		if err := s.cancelStressProcess(ctx, req.MemoryInstance, req.MemoryStartTime); err != nil {
+			return nil, err
+		}
+	}
+
 	log.Info("killing stressor successfully")
 	return &empty.Empty{}, nil
 }
 
+func (s *daemonServer) cancelStressProcess(ctx context.Context, instance string, startTime int64) error {
+	pid, err := strconv.Atoi(instance)
+	if err != nil {
+		return err
+	}
+
+	return s.backgroundProcessManager.KillBackgroundProcess(ctx, pid, startTime)
+}
+
 func findValidCgroup(path cgroups.Path, target string) (string, error) {
 	for _, subsys := range cgroupSubsys {
 		p, err := path(cgroups.Name(subsys))
@@ -184,9 +184,28 @@ func (s *daemonServer) SetTcs(ctx context.Context, in *pb.TcsRequest) (*empty.Em
 		return &empty.Empty{}, err
 	}
 
+	s.grantTcLease(in)
+
 	return &empty.Empty{}, nil
 }
 
+// grantTcLease makes chaos-daemon flush the tc rules by itself once the lease
+// deadline passes without renewal
+func (s *daemonServer) grantTcLease(in *pb.TcsRequest) {
+	if len(in.Tcs) == 0 {
+		s.leases.Revoke(in.ContainerId, tcLease)
+		return
+	}
+
+	containerID := in.ContainerId
+	s.leases.Grant(containerID, tcLease, in.LeaseDeadline, func() {
+		_, err := s.SetTcs(context.Background(), &pb.TcsRequest{ContainerId: containerID})
+		if err != nil {
+			log.Error(err, "fail to recover tc rules with expired lease", "container", containerID)
+		}
+	})
+}
+
 type tcClient struct {
 	ctx context.Context
 	pid uint32
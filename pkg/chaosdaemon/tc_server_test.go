@@ -30,7 +30,7 @@ func commonTcTest(t *testing.T, fpname, errString string, tcFunc func(s *daemonS
 	defer mock.With("MockContainerdClient", &MockClient{})()
 	c, _ := CreateContainerRuntimeInfoClient(containerRuntimeContainerd)
 	m := bpm.NewBackgroundProcessManager()
-	s := &daemonServer{c, m}
+	s := &daemonServer{crClient: c, backgroundProcessManager: m}
 
 	if errString == "" {
 		defer mock.With(fpname, true)()
@@ -15,14 +15,100 @@ package chaosdaemon
 
 import (
 	"context"
-
-	"github.com/chaos-mesh/chaos-mesh/pkg/time"
+	"sync"
+	gotime "time"
 
 	"github.com/golang/protobuf/ptypes/empty"
 
 	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	"github.com/chaos-mesh/chaos-mesh/pkg/mapreader"
+	"github.com/chaos-mesh/chaos-mesh/pkg/time"
 )
 
+// timeWatchInterval is the interval to look for new processes in a container
+// with time chaos
+const timeWatchInterval = gotime.Second
+
+// timeWatcher keeps injecting time chaos into new processes of a container
+type timeWatcher struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// timeWatcherManager manages all time watchers, indexed by container id
+type timeWatcherManager struct {
+	sync.Mutex
+	watchers map[string]*timeWatcher
+}
+
+// Start stops the existing watcher of the container (if any) and starts a new one
+func (m *timeWatcherManager) Start(containerID string, watch func(ctx context.Context)) {
+	m.Lock()
+	defer m.Unlock()
+
+	if m.watchers == nil {
+		m.watchers = make(map[string]*timeWatcher)
+	}
+	if watcher, ok := m.watchers[containerID]; ok {
+		watcher.stop()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	watcher := &timeWatcher{
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+	m.watchers[containerID] = watcher
+
+	go func() {
+		defer close(watcher.done)
+		watch(ctx)
+	}()
+}
+
+// Stop stops the watcher of the container and waits for it to exit
+func (m *timeWatcherManager) Stop(containerID string) {
+	m.Lock()
+	defer m.Unlock()
+
+	if watcher, ok := m.watchers[containerID]; ok {
+		watcher.stop()
+		delete(m.watchers, containerID)
+	}
+}
+
+// IsWatching returns whether the container is being watched
+func (m *timeWatcherManager) IsWatching(containerID string) bool {
+	m.Lock()
+	defer m.Unlock()
+
+	_, ok := m.watchers[containerID]
+	return ok
+}
+
+func (w *timeWatcher) stop() {
+	w.cancel()
+	<-w.done
+}
+
+// readVdsoAddress returns the start address of [vdso] of the process. As the
+// vDSO is mapped again on every `execve`, a changed address means that the
+// process has lost the injected time chaos.
+func readVdsoAddress(pid uint32) (uint64, error) {
+	entries, err := mapreader.Read(int(pid))
+	if err != nil {
+		return 0, err
+	}
+
+	for _, entry := range entries {
+		if entry.Path == "[vdso]" {
+			return entry.StartAddress, nil
+		}
+	}
+
+	return 0, nil
+}
+
 func (s *daemonServer) SetTimeOffset(ctx context.Context, req *pb.TimeRequest) (*empty.Empty, error) {
 	log.Info("Shift time", "Request", req)
 
@@ -32,6 +118,9 @@ func (s *daemonServer) SetTimeOffset(ctx context.Context, req *pb.TimeRequest) (
 		return nil, err
 	}
 
+	// stop the previous watcher to avoid injecting with an outdated offset
+	s.timeWatchers.Stop(req.ContainerId)
+
 	childPids, err := GetChildProcesses(pid)
 	if err != nil {
 		log.Error(err, "fail to get child processes")
@@ -39,20 +128,94 @@ func (s *daemonServer) SetTimeOffset(ctx context.Context, req *pb.TimeRequest) (
 	allPids := append(childPids, pid)
 	log.Info("all related processes found", "pids", allPids)
 
+	injected := make(map[uint32]uint64)
 	for _, pid := range allPids {
 		err = time.ModifyTime(int(pid), req.Sec, req.Nsec, req.ClkIdsMask)
 		if err != nil {
 			log.Error(err, "error while modifying time", "pid", pid)
 			return nil, err
 		}
+
+		vdsoAddr, err := readVdsoAddress(pid)
+		if err != nil {
+			log.Error(err, "fail to read vdso address", "pid", pid)
+		}
+		injected[pid] = vdsoAddr
 	}
 
+	s.timeWatchers.Start(req.ContainerId, func(ctx context.Context) {
+		watchNewProcesses(ctx, pid, req, injected)
+	})
+
+	containerID := req.ContainerId
+	s.leases.Grant(containerID, timeLease, req.LeaseDeadline, func() {
+		_, err := s.RecoverTimeOffset(context.Background(), &pb.TimeRequest{ContainerId: containerID})
+		if err != nil {
+			log.Error(err, "fail to recover time offset with expired lease", "container", containerID)
+		}
+	})
+
 	return &empty.Empty{}, nil
 }
 
+// watchNewProcesses injects time chaos into processes which are created or
+// executed after SetTimeOffset, until the context is canceled
+func watchNewProcesses(ctx context.Context, pid uint32, req *pb.TimeRequest, injected map[uint32]uint64) {
+	ticker := gotime.NewTicker(timeWatchInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		injected = injectNewProcesses(pid, req, injected)
+	}
+}
+
+// injectNewProcesses injects time chaos into processes of the container which
+// are not in `injected`, and returns the processes which are injected now
+func injectNewProcesses(pid uint32, req *pb.TimeRequest, injected map[uint32]uint64) map[uint32]uint64 {
+	childPids, err := GetChildProcesses(pid)
+	if err != nil {
+		log.Error(err, "fail to get child processes")
+	}
+	allPids := append(childPids, pid)
+
+	current := make(map[uint32]uint64)
+	for _, pid := range allPids {
+		vdsoAddr, err := readVdsoAddress(pid)
+		if err != nil {
+			// the process may have exited
+			continue
+		}
+
+		if addr, ok := injected[pid]; ok && addr == vdsoAddr {
+			current[pid] = addr
+			continue
+		}
+
+		log.Info("inject time chaos into new process", "pid", pid, "container", req.ContainerId)
+		err = time.ModifyTime(int(pid), req.Sec, req.Nsec, req.ClkIdsMask)
+		if err != nil {
+			log.Error(err, "error while modifying time", "pid", pid)
+			continue
+		}
+		current[pid] = vdsoAddr
+	}
+
+	return current
+}
+
 func (s *daemonServer) RecoverTimeOffset(ctx context.Context, req *pb.TimeRequest) (*empty.Empty, error) {
 	log.Info("Recover time", "Request", req)
 
+	// stop watching before recovering, or new processes may be injected again
+	s.timeWatchers.Stop(req.ContainerId)
+	s.leases.Revoke(req.ContainerId, timeLease)
+
 	pid, err := s.crClient.GetPidFromContainerID(ctx, req.ContainerId)
 	if err != nil {
 		log.Error(err, "error while getting PID")
@@ -29,7 +29,7 @@ var _ = Describe("time server", func() {
 	defer mock.With("MockContainerdClient", &MockClient{})()
 	c, _ := CreateContainerRuntimeInfoClient(containerRuntimeContainerd)
 	m := bpm.NewBackgroundProcessManager()
-	s := &daemonServer{c, m}
+	s := &daemonServer{crClient: c, backgroundProcessManager: m}
 
 	Context("SetTimeOffset", func() {
 		It("should work", func() {
@@ -43,6 +43,24 @@ var _ = Describe("time server", func() {
 			Expect(err).To(BeNil())
 		})
 
+		It("should watch the container until recover", func() {
+			// Inject nil error to ignore any error
+			const ignore = true
+			defer mock.With("ModifyTimeError", ignore)()
+
+			_, err := s.SetTimeOffset(context.TODO(), &pb.TimeRequest{
+				ContainerId: "containerd://watched-container-id",
+			})
+			Expect(err).To(BeNil())
+			Expect(s.timeWatchers.IsWatching("containerd://watched-container-id")).To(BeTrue())
+
+			_, err = s.RecoverTimeOffset(context.TODO(), &pb.TimeRequest{
+				ContainerId: "containerd://watched-container-id",
+			})
+			Expect(err).To(BeNil())
+			Expect(s.timeWatchers.IsWatching("containerd://watched-container-id")).To(BeFalse())
+		})
+
 		It("should fail on get pid", func() {
 			const errorStr = "mock error on load container"
 			defer mock.With("LoadContainerError", errors.New(errorStr))()
@@ -212,6 +212,10 @@ func (r *ChaosCollector) setUnarchivedExperiment(req ctrl.Request, obj v1alpha1.
 		archive.Action = string(chaos.Spec.Action)
 	case *v1alpha1.IoChaos:
 		archive.Action = string(chaos.Spec.Action)
+	case *v1alpha1.DiskChaos:
+		archive.Action = string(chaos.Spec.Action)
+	case *v1alpha1.NodeChaos:
+		archive.Action = string(chaos.Spec.Action)
 	case *v1alpha1.TimeChaos, *v1alpha1.KernelChaos, *v1alpha1.StressChaos:
 		archive.Action = ""
 	default:
@@ -25,6 +25,10 @@ import (
 type ChaosControllerConfig struct {
 	// ChaosDaemonPort is the port which grpc server listens on
 	ChaosDaemonPort int `envconfig:"CHAOS_DAEMON_PORT" default:"31767"`
+	// ChaosDaemonLeaseDuration is the duration of the lease on injections made by chaos-daemon,
+	// chaos-daemon recovers the injections by itself if the lease isn't renewed in time,
+	// e.g. the controller manager is down. Zero means that the injections are never expired
+	ChaosDaemonLeaseDuration time.Duration `envconfig:"CHAOS_DAEMON_LEASE_DURATION" default:"10m"`
 	// BPFKIPort is the port which BFFKI grpc server listens on
 	BPFKIPort int `envconfig:"BPFKI_PORT" default:"50051"`
 	// MetricsAddr is the address the metric endpoint binds to
@@ -40,6 +44,9 @@ type ChaosControllerConfig struct {
 	AllowedNamespaces string `envconfig:"ALLOWED_NAMESPACES" default:""`
 	// AllowedNamespaces is a regular expression, and the chaos task will be ignored by a matching namespace
 	IgnoredNamespaces string `envconfig:"IGNORED_NAMESPACES" default:""`
+	// EnableFilterNamespace means only the namespaces annotated with `chaos-mesh.org/inject=enabled`
+	// allow the chaos task to be performed, the annotation is checked every time when selecting objects
+	EnableFilterNamespace bool `envconfig:"ENABLE_FILTER_NAMESPACE" default:"false"`
 	// RPCTimeout is timeout of RPC between controllers and chaos-operator
 	RPCTimeout    time.Duration `envconfig:"RPC_TIMEOUT" default:"1m"`
 	WatcherConfig *watcher.Config
@@ -103,7 +103,7 @@ type ExperimentInfo struct {
 // ScopeInfo defines the scope of the Experiment.
 type ScopeInfo struct {
 	SelectorInfo
-	Mode  string `json:"mode" binding:"oneof='' 'one' 'all' 'fixed' 'fixed-percent' 'random-max-percent'"`
+	Mode  string `json:"mode" binding:"oneof='' 'one' 'all' 'fixed' 'fixed-percent' 'random-max-percent' 'one-per-zone' 'all-in-one-zone' 'fixed-spread-nodes'"`
 	Value string `json:"value" binding:"ValueValid"`
 }
 
@@ -174,7 +174,7 @@ type SchedulerInfo struct {
 
 // PodChaosInfo defines the basic information of pod chaos for creating a new PodChaos.
 type PodChaosInfo struct {
-	Action        string `json:"action" binding:"oneof='' 'pod-kill' 'pod-failure' 'container-kill'"`
+	Action        string `json:"action" binding:"oneof='' 'pod-kill' 'pod-failure' 'container-kill' 'scale-to-zero'"`
 	ContainerName string `json:"container_name"`
 }
 
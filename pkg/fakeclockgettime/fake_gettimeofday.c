@@ -0,0 +1,48 @@
+#include <stddef.h>
+#include <inttypes.h>
+
+// sys/time.h is not included because glibc marks `tv` as nonnull, which
+// makes compilers drop the NULL check below.
+struct timeval {
+    int64_t tv_sec;
+    int64_t tv_usec;
+};
+
+int64_t TV_SEC_DELTA = 0;
+int64_t TV_NSEC_DELTA = 0;
+uint64_t CLOCK_IDS_MASK = 0;
+
+int gettimeofday(struct timeval *tv, void *tz) {
+    int ret;
+    asm volatile
+        (
+            "syscall"
+            : "=a" (ret)
+            : "0"(96), "D"(tv), "S"(tz)
+            : "rcx", "r11", "memory"
+        );
+
+    int64_t sec_delta = TV_SEC_DELTA;
+    int64_t usec_delta = TV_NSEC_DELTA / 1000;
+    uint64_t clock_ids_mask = CLOCK_IDS_MASK;
+
+    int64_t million = 1000000;
+
+    // gettimeofday always reads CLOCK_REALTIME, whose id is 0
+    if(tv != NULL && (clock_ids_mask & 1) != 0) {
+        while (usec_delta + tv->tv_usec >= million) {
+            sec_delta += 1;
+            usec_delta -= million;
+        }
+
+        while (usec_delta + tv->tv_usec < 0) {
+            sec_delta -= 1;
+            usec_delta += million;
+        }
+
+        tv->tv_sec += sec_delta;
+        tv->tv_usec += usec_delta;
+    }
+
+    return ret;
+}
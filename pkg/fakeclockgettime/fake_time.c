@@ -0,0 +1,31 @@
+#include <stddef.h>
+#include <time.h>
+#include <inttypes.h>
+
+int64_t TV_SEC_DELTA = 0;
+int64_t TV_NSEC_DELTA = 0;
+uint64_t CLOCK_IDS_MASK = 0;
+
+time_t time(time_t *tloc) {
+    time_t ret;
+    asm volatile
+        (
+            "syscall"
+            : "=a" (ret)
+            : "0"(201), "D"(0)
+            : "rcx", "r11", "memory"
+        );
+
+    int64_t billion = 1000000000;
+
+    // time always reads CLOCK_REALTIME, whose id is 0
+    if((CLOCK_IDS_MASK & 1) != 0) {
+        ret += TV_SEC_DELTA + TV_NSEC_DELTA / billion;
+    }
+
+    if(tloc != NULL) {
+        *tloc = ret;
+    }
+
+    return ret;
+}
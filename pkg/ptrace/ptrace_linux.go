@@ -200,14 +200,14 @@ func (p *TracedProgram) Detach() error {
 	return nil
 }
 
-// Protect will backup regs and rip into fields
+// Protect will backup regs and pc into fields
 func (p *TracedProgram) Protect() error {
-	err := syscall.PtraceGetRegs(p.pid, p.backupRegs)
+	err := getRegs(p.pid, p.backupRegs)
 	if err != nil {
 		return errors.WithStack(err)
 	}
 
-	_, err = syscall.PtracePeekData(p.pid, uintptr(p.backupRegs.Rip), p.backupCode)
+	_, err = syscall.PtracePeekData(p.pid, uintptr(p.backupRegs.PC()), p.backupCode)
 	if err != nil {
 		return errors.WithStack(err)
 	}
@@ -215,14 +215,14 @@ func (p *TracedProgram) Protect() error {
 	return nil
 }
 
-// Restore will restore regs and rip from fields
+// Restore will restore regs and pc from fields
 func (p *TracedProgram) Restore() error {
-	err := syscall.PtraceSetRegs(p.pid, p.backupRegs)
+	err := setRegs(p.pid, p.backupRegs)
 	if err != nil {
 		return errors.WithStack(err)
 	}
 
-	_, err = syscall.PtracePokeData(p.pid, uintptr(p.backupRegs.Rip), p.backupCode)
+	_, err = syscall.PtracePokeData(p.pid, uintptr(p.backupRegs.PC()), p.backupCode)
 	if err != nil {
 		return errors.WithStack(err)
 	}
@@ -245,65 +245,6 @@ func (p *TracedProgram) Step() error {
 	return p.Wait()
 }
 
-// Syscall runs a syscall at main thread of process
-func (p *TracedProgram) Syscall(number uint64, args ...uint64) (uint64, error) {
-	err := p.Protect()
-	if err != nil {
-		return 0, err
-	}
-
-	var regs syscall.PtraceRegs
-
-	err = syscall.PtraceGetRegs(p.pid, &regs)
-	if err != nil {
-		return 0, err
-	}
-	regs.Rax = number
-	for index, arg := range args {
-		// All these registers are hard coded for x86 platform
-		if index == 0 {
-			regs.Rdi = arg
-		} else if index == 1 {
-			regs.Rsi = arg
-		} else if index == 2 {
-			regs.Rdx = arg
-		} else if index == 3 {
-			regs.R10 = arg
-		} else if index == 4 {
-			regs.R8 = arg
-		} else if index == 5 {
-			regs.R9 = arg
-		} else {
-			return 0, fmt.Errorf("too many arguments for a syscall")
-		}
-	}
-	err = syscall.PtraceSetRegs(p.pid, &regs)
-	if err != nil {
-		return 0, err
-	}
-
-	ip := make([]byte, ptrSize)
-
-	// We only support x86-64 platform now, so using hard coded `LittleEndian` here is ok.
-	binary.LittleEndian.PutUint16(ip, 0x050f)
-	_, err = syscall.PtracePokeData(p.pid, uintptr(p.backupRegs.Rip), ip)
-	if err != nil {
-		return 0, err
-	}
-
-	err = p.Step()
-	if err != nil {
-		return 0, err
-	}
-
-	err = syscall.PtraceGetRegs(p.pid, &regs)
-	if err != nil {
-		return 0, err
-	}
-
-	return regs.Rax, p.Restore()
-}
-
 // Mmap runs mmap syscall
 func (p *TracedProgram) Mmap(length uint64, fd uint64) (uint64, error) {
 	return p.Syscall(syscall.SYS_MMAP, 0, length, syscall.PROT_READ|syscall.PROT_WRITE|syscall.PROT_EXEC, syscall.MAP_ANON|syscall.MAP_PRIVATE, fd, 0)
@@ -323,8 +264,7 @@ func (p *TracedProgram) ReadSlice(addr uint64, size uint64) (*[]byte, error) {
 		iov_len:  C.ulong(size),
 	}
 
-	// process_vm_readv syscall number is 310
-	_, _, errno := syscall.Syscall6(310, uintptr(p.pid), uintptr(unsafe.Pointer(&localIov)), uintptr(1), uintptr(unsafe.Pointer(&remoteIov)), uintptr(1), uintptr(0))
+	_, _, errno := syscall.Syscall6(sysProcessVMReadv, uintptr(p.pid), uintptr(unsafe.Pointer(&localIov)), uintptr(1), uintptr(unsafe.Pointer(&remoteIov)), uintptr(1), uintptr(0))
 	if errno != 0 {
 		return nil, errors.WithStack(errno)
 	}
@@ -347,8 +287,7 @@ func (p *TracedProgram) WriteSlice(addr uint64, buffer []byte) error {
 		iov_len:  C.ulong(size),
 	}
 
-	// process_vm_writev syscall number is 311
-	_, _, errno := syscall.Syscall6(311, uintptr(p.pid), uintptr(unsafe.Pointer(&localIov)), uintptr(1), uintptr(unsafe.Pointer(&remoteIov)), uintptr(1), uintptr(0))
+	_, _, errno := syscall.Syscall6(sysProcessVMWritev, uintptr(p.pid), uintptr(unsafe.Pointer(&localIov)), uintptr(1), uintptr(unsafe.Pointer(&remoteIov)), uintptr(1), uintptr(0))
 	if errno != 0 {
 		return errors.WithStack(errno)
 	}
@@ -414,7 +353,10 @@ func (p *TracedProgram) MmapSlice(slice []byte) (*mapreader.Entry, error) {
 		return nil, errors.WithStack(err)
 	}
 
-	err = p.WriteSlice(addr, slice)
+	// PtraceWriteSlice is used here because the slice is usually machine code,
+	// and writing through ptrace keeps the instruction cache coherent on
+	// platforms like arm64.
+	err = p.PtraceWriteSlice(addr, slice)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
@@ -477,18 +419,3 @@ func (p *TracedProgram) WriteUint64ToAddr(addr uint64, value uint64) error {
 
 	return nil
 }
-
-// JumpToFakeFunc writes jmp instruction to jump to fake function
-func (p *TracedProgram) JumpToFakeFunc(originAddr uint64, targetAddr uint64) error {
-	instructions := make([]byte, 16)
-
-	// mov rax, targetAddr;
-	// jmp rax ;
-	instructions[0] = 0x48
-	instructions[1] = 0xb8
-	binary.LittleEndian.PutUint64(instructions[2:10], targetAddr)
-	instructions[10] = 0xff
-	instructions[11] = 0xe0
-
-	return p.PtraceWriteSlice(originAddr, instructions)
-}
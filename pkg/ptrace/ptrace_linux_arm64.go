@@ -0,0 +1,115 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ptrace
+
+import (
+	"encoding/binary"
+	"fmt"
+	"syscall"
+	"unsafe"
+)
+
+const (
+	sysProcessVMReadv  = 270
+	sysProcessVMWritev = 271
+)
+
+// ntPrstatus is the regset of general purpose registers
+const ntPrstatus = 1
+
+// arm64 doesn't support PTRACE_GETREGS and PTRACE_SETREGS, so registers are
+// accessed through PTRACE_GETREGSET and PTRACE_SETREGSET
+func getRegs(pid int, regs *syscall.PtraceRegs) error {
+	return ptraceRegSet(syscall.PTRACE_GETREGSET, pid, regs)
+}
+
+func setRegs(pid int, regs *syscall.PtraceRegs) error {
+	return ptraceRegSet(syscall.PTRACE_SETREGSET, pid, regs)
+}
+
+func ptraceRegSet(request int, pid int, regs *syscall.PtraceRegs) error {
+	iov := syscall.Iovec{
+		Base: (*byte)(unsafe.Pointer(regs)),
+	}
+	iov.SetLen(int(unsafe.Sizeof(*regs)))
+
+	_, _, errno := syscall.Syscall6(syscall.SYS_PTRACE, uintptr(request), uintptr(pid), ntPrstatus, uintptr(unsafe.Pointer(&iov)), 0, 0)
+	if errno != 0 {
+		return errno
+	}
+
+	return nil
+}
+
+// Syscall runs a syscall at main thread of process
+func (p *TracedProgram) Syscall(number uint64, args ...uint64) (uint64, error) {
+	err := p.Protect()
+	if err != nil {
+		return 0, err
+	}
+
+	var regs syscall.PtraceRegs
+
+	err = getRegs(p.pid, &regs)
+	if err != nil {
+		return 0, err
+	}
+	// syscall number is passed through x8, and arguments through x0 - x5
+	regs.Regs[8] = number
+	for index, arg := range args {
+		if index > 5 {
+			return 0, fmt.Errorf("too many arguments for a syscall")
+		}
+		regs.Regs[index] = arg
+	}
+	err = setRegs(p.pid, &regs)
+	if err != nil {
+		return 0, err
+	}
+
+	ip := make([]byte, ptrSize)
+
+	// svc #0
+	binary.LittleEndian.PutUint32(ip, 0xd4000001)
+	_, err = syscall.PtracePokeData(p.pid, uintptr(p.backupRegs.Pc), ip)
+	if err != nil {
+		return 0, err
+	}
+
+	err = p.Step()
+	if err != nil {
+		return 0, err
+	}
+
+	err = getRegs(p.pid, &regs)
+	if err != nil {
+		return 0, err
+	}
+
+	return regs.Regs[0], p.Restore()
+}
+
+// JumpToFakeFunc writes jmp instruction to jump to fake function
+func (p *TracedProgram) JumpToFakeFunc(originAddr uint64, targetAddr uint64) error {
+	instructions := make([]byte, 16)
+
+	// ldr x9, #8 ;
+	// br x9 ;
+	// .quad targetAddr ;
+	binary.LittleEndian.PutUint32(instructions[0:4], 0x58000049)
+	binary.LittleEndian.PutUint32(instructions[4:8], 0xd61f0120)
+	binary.LittleEndian.PutUint64(instructions[8:16], targetAddr)
+
+	return p.PtraceWriteSlice(originAddr, instructions)
+}
@@ -0,0 +1,176 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package router
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	end "github.com/chaos-mesh/chaos-mesh/pkg/router/endpoint"
+	"github.com/chaos-mesh/chaos-mesh/pkg/utils"
+)
+
+// inDryRun returns whether the chaos is only previewed. The dry run only takes effect
+// before the chaos is applied, so that the injected targets are still recovered as usual.
+func inDryRun(chaos v1alpha1.InnerObject) bool {
+	return chaos.IsDryRun() &&
+		len(chaos.(metav1.Object).GetFinalizers()) == 0 &&
+		chaos.GetStatus().Experiment.Phase != v1alpha1.ExperimentPhaseRunning
+}
+
+// dryRun runs the validation, the ChaosPolicy checks and the selection of the chaos, and records
+// the targets and the rules in the status instead of applying them.
+// The dry run is done again only if the spec is changed.
+func (r *Reconciler) dryRun(req ctrl.Request, endpoint end.Endpoint, checker v1alpha1.ChaosPolicyChecker, chaos v1alpha1.InnerObject) error {
+	spec, err := chaosSpec(chaos)
+	if err != nil {
+		return err
+	}
+	hash, err := specHash(spec)
+	if err != nil {
+		return err
+	}
+
+	status := chaos.GetStatus()
+	if status.DryRun != nil && status.DryRun.SpecHash == hash {
+		return nil
+	}
+
+	r.Log.Info("Running in dry run mode")
+	// the endpoints may change the chaos while selecting, e.g. adding the finalizers, so a copy is used
+	preview := chaos.DeepCopyObject().(v1alpha1.InnerObject)
+	targets, previewErr := r.preview(req, endpoint, checker, preview, spec)
+	status.DryRun = &v1alpha1.DryRunStatus{
+		SpecHash: hash,
+		Time:     metav1.Now(),
+		Targets:  targets,
+	}
+	if previewErr != nil {
+		status.DryRun.Error = previewErr.Error()
+	}
+
+	if err := r.Client.Update(context.Background(), chaos); err != nil {
+		r.Log.Error(err, "unable to update chaos status")
+		return err
+	}
+
+	if previewErr != nil {
+		r.Event(chaos, v1.EventTypeWarning, utils.EventChaosDryRun, previewErr.Error())
+	} else {
+		r.Event(chaos, v1.EventTypeNormal, utils.EventChaosDryRun, fmt.Sprintf("chaos would be injected into %d targets", len(targets)))
+	}
+	return nil
+}
+
+// preview returns the targets which would be injected, or an error describing why the chaos can't be applied
+func (r *Reconciler) preview(req ctrl.Request, endpoint end.Endpoint, checker v1alpha1.ChaosPolicyChecker,
+	chaos v1alpha1.InnerObject, spec map[string]interface{}) ([]v1alpha1.DryRunTarget, error) {
+	ctx := context.Background()
+
+	if validator, ok := chaos.(v1alpha1.ChaosValidator); ok {
+		if err := validator.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := checker.Check(ctx, chaos, true); err != nil {
+		return nil, err
+	}
+
+	if dryRunner, ok := endpoint.(end.DryRunner); ok {
+		return dryRunner.DryRun(ctx, req, chaos)
+	}
+
+	selectable, ok := chaos.(v1alpha1.SelectableObject)
+	if !ok {
+		return nil, nil
+	}
+	// the targets are selected randomly if the seed is not set, which may be different from the applied ones
+	var seed *int64
+	if s, ok := spec["seed"].(int64); ok {
+		seed = &s
+	}
+	return r.selectTargets(ctx, chaos.GetChaos().Kind, selectable.GetSelectSpec(), utils.NewSeed(seed))
+}
+
+// selectTargets selects the targets like applying the chaos
+func (r *Reconciler) selectTargets(ctx context.Context, kind string, spec v1alpha1.SelectSpec, seed int64) ([]v1alpha1.DryRunTarget, error) {
+	var targets []v1alpha1.DryRunTarget
+	switch kind {
+	case v1alpha1.KindPersistentVolumeChaos:
+		volumes, err := utils.SelectAndFilterPV(ctx, r.Client, r.Reader, spec, seed)
+		if err != nil {
+			return nil, err
+		}
+		for _, volume := range volumes {
+			targets = append(targets, v1alpha1.DryRunTarget{Kind: "PersistentVolume", Name: volume.Name})
+		}
+	case v1alpha1.KindPersistentVolumeClaimChaos:
+		claims, err := utils.SelectAndFilterPVC(ctx, r.Client, r.Reader, spec, seed)
+		if err != nil {
+			return nil, err
+		}
+		for _, claim := range claims {
+			targets = append(targets, v1alpha1.DryRunTarget{Kind: "PersistentVolumeClaim", Namespace: claim.Namespace, Name: claim.Name})
+		}
+	case v1alpha1.KindNodeChaos:
+		nodes, err := utils.SelectAndFilterNodes(ctx, r.Client, spec, seed)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			targets = append(targets, v1alpha1.DryRunTarget{Kind: "Node", Name: node.Name})
+		}
+	default:
+		pods, err := utils.SelectAndFilterPods(ctx, r.Client, r.Reader, spec, seed)
+		if err != nil {
+			return nil, err
+		}
+		for _, pod := range pods {
+			targets = append(targets, v1alpha1.DryRunTarget{Kind: "Pod", Namespace: pod.Namespace, Name: pod.Name})
+		}
+	}
+	return targets, nil
+}
+
+// chaosSpec returns the spec of the chaos as an unstructured map
+func chaosSpec(chaos runtime.Object) (map[string]interface{}, error) {
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(chaos)
+	if err != nil {
+		return nil, err
+	}
+	spec, _, err := unstructured.NestedMap(obj, "spec")
+	return spec, err
+}
+
+// specHash returns the hash of the spec, the status can't be compared by the generation
+// because the generation is also increased by updating the status
+func specHash(spec map[string]interface{}) (string, error) {
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return "", err
+	}
+	hasher := fnv.New64a()
+	_, _ = hasher.Write(data)
+	return fmt.Sprintf("%x", hasher.Sum64()), nil
+}
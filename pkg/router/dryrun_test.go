@@ -0,0 +1,192 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package router
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/chaospolicy"
+	ctx "github.com/chaos-mesh/chaos-mesh/pkg/router/context"
+	end "github.com/chaos-mesh/chaos-mesh/pkg/router/endpoint"
+)
+
+type dryRunEndpoint struct {
+	testEndpoint
+	applied int
+}
+
+func (e *dryRunEndpoint) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1.InnerObject) error {
+	e.applied++
+	return nil
+}
+
+type previewEndpoint struct {
+	dryRunEndpoint
+}
+
+func (e *previewEndpoint) DryRun(ctx context.Context, req ctrl.Request, chaos v1alpha1.InnerObject) ([]v1alpha1.DryRunTarget, error) {
+	return []v1alpha1.DryRunTarget{{Kind: "Pod", Namespace: metav1.NamespaceDefault, Name: "from-endpoint"}}, nil
+}
+
+var _ = Describe("Reconciler in dry run mode", func() {
+	newReconciler := func(objects ...runtime.Object) *Reconciler {
+		scheme := runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+		Expect(v1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		c := fake.NewFakeClientWithScheme(scheme, objects...)
+		return &Reconciler{
+			Name:   "podchaos",
+			Object: &v1alpha1.PodChaos{},
+			Context: ctx.Context{
+				Client:        c,
+				Reader:        c,
+				EventRecorder: record.NewFakeRecorder(10),
+				Log:           ctrl.Log.WithName("router"),
+			},
+		}
+	}
+
+	newPod := func(name string) *v1.Pod {
+		return &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: metav1.NamespaceDefault,
+				Labels:    map[string]string{"app": "foo"},
+			},
+			Status: v1.PodStatus{Phase: v1.PodRunning},
+		}
+	}
+
+	newChaos := func() *v1alpha1.PodChaos {
+		return &v1alpha1.PodChaos{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "dry-run",
+				Namespace:   metav1.NamespaceDefault,
+				Annotations: map[string]string{v1alpha1.DryRunAnnotationKey: "true"},
+			},
+			Spec: v1alpha1.PodChaosSpec{
+				Action:    v1alpha1.PodKillAction,
+				Mode:      v1alpha1.AllPodMode,
+				Scheduler: &v1alpha1.SchedulerSpec{Cron: "@every 1m"},
+				Selector: v1alpha1.SelectorSpec{
+					Namespaces:     []string{metav1.NamespaceDefault},
+					LabelSelectors: map[string]string{"app": "foo"},
+				},
+			},
+		}
+	}
+
+	dryRun := func(r *Reconciler, endpoint end.Endpoint, chaos *v1alpha1.PodChaos) *v1alpha1.PodChaos {
+		checker := &chaospolicy.Checker{Client: r.Client, Reader: r.Reader, Log: r.Log}
+		req := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: chaos.Namespace, Name: chaos.Name}}
+		Expect(r.dryRun(req, endpoint, checker, chaos)).To(Succeed())
+
+		var updated v1alpha1.PodChaos
+		Expect(r.Client.Get(context.Background(), req.NamespacedName, &updated)).To(Succeed())
+		return &updated
+	}
+
+	It("should only preview the chaos before it is applied", func() {
+		chaos := newChaos()
+		Expect(inDryRun(chaos)).To(BeTrue())
+
+		chaos.Finalizers = []string{"default/a"}
+		Expect(inDryRun(chaos)).To(BeFalse())
+
+		chaos = newChaos()
+		chaos.Status.Experiment.Phase = v1alpha1.ExperimentPhaseRunning
+		Expect(inDryRun(chaos)).To(BeFalse())
+
+		chaos = newChaos()
+		chaos.Annotations = nil
+		Expect(inDryRun(chaos)).To(BeFalse())
+	})
+
+	It("should record the selected targets without applying the chaos", func() {
+		chaos := newChaos()
+		r := newReconciler(chaos, newPod("a"), newPod("b"))
+		endpoint := &dryRunEndpoint{}
+
+		// the generic selection is used if the endpoint doesn't implement the DryRunner
+		updated := dryRun(r, endpoint, chaos)
+		Expect(endpoint.applied).To(Equal(0))
+		Expect(updated.Finalizers).To(BeEmpty())
+		Expect(updated.Status.DryRun).ToNot(BeNil())
+		Expect(updated.Status.DryRun.Error).To(BeEmpty())
+		Expect(updated.Status.DryRun.Targets).To(ConsistOf(
+			v1alpha1.DryRunTarget{Kind: "Pod", Namespace: metav1.NamespaceDefault, Name: "a"},
+			v1alpha1.DryRunTarget{Kind: "Pod", Namespace: metav1.NamespaceDefault, Name: "b"},
+		))
+
+		// the dry run is not done again for the same spec
+		hash := updated.Status.DryRun.SpecHash
+		time := updated.Status.DryRun.Time
+		updated = dryRun(r, endpoint, updated)
+		Expect(updated.Status.DryRun.Time).To(Equal(time))
+
+		updated.Spec.Mode = v1alpha1.OnePodMode
+		updated = dryRun(r, endpoint, updated)
+		Expect(updated.Status.DryRun.SpecHash).ToNot(Equal(hash))
+		Expect(updated.Status.DryRun.Targets).To(HaveLen(1))
+		Expect(endpoint.applied).To(Equal(0))
+	})
+
+	It("should record the validation error", func() {
+		chaos := newChaos()
+		chaos.Spec.Scheduler = nil
+		r := newReconciler(chaos, newPod("a"))
+
+		updated := dryRun(r, &dryRunEndpoint{}, chaos)
+		Expect(updated.Status.DryRun.Targets).To(BeEmpty())
+		Expect(updated.Status.DryRun.Error).To(ContainSubstring(v1alpha1.ValidatePodchaosSchedulerError))
+	})
+
+	It("should record the targets from the endpoint", func() {
+		chaos := newChaos()
+		r := newReconciler(chaos, newPod("a"))
+
+		endpoint := &previewEndpoint{}
+		updated := dryRun(r, endpoint, chaos)
+		Expect(endpoint.applied).To(Equal(0))
+		Expect(updated.Status.DryRun.Targets).To(Equal([]v1alpha1.DryRunTarget{
+			{Kind: "Pod", Namespace: metav1.NamespaceDefault, Name: "from-endpoint"},
+		}))
+	})
+
+	It("should record the violated chaos policy", func() {
+		maxPods := int32(1)
+		chaos := newChaos()
+		r := newReconciler(chaos, newPod("a"), newPod("b"), &v1alpha1.ChaosPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "max-pods"},
+			Spec:       v1alpha1.ChaosPolicySpec{MaxPods: &maxPods},
+		})
+
+		updated := dryRun(r, &dryRunEndpoint{}, chaos)
+		Expect(updated.Status.DryRun.Targets).To(BeEmpty())
+		Expect(updated.Status.DryRun.Error).To(ContainSubstring("max-pods"))
+	})
+})
@@ -34,5 +34,11 @@ type Endpoint interface {
 	Object() v1alpha1.InnerObject
 }
 
+// DryRunner is the Endpoint which tells the targets and the rules of the chaos without applying it
+type DryRunner interface {
+	// DryRun returns the targets which would be injected, it mustn't change anything in the cluster
+	DryRun(ctx context.Context, req ctrl.Request, chaos v1alpha1.InnerObject) ([]v1alpha1.DryRunTarget, error)
+}
+
 // NewEndpoint represents a function who creates a new reconciler
 type NewEndpoint func(ctx ctx.Context) Endpoint
@@ -0,0 +1,82 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package router
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	ctx "github.com/chaos-mesh/chaos-mesh/pkg/router/context"
+	end "github.com/chaos-mesh/chaos-mesh/pkg/router/endpoint"
+	"github.com/chaos-mesh/chaos-mesh/pkg/utils"
+)
+
+// failurePolicyEndpoint handles the targets failed to be injected according to the FailurePolicy of the chaos.
+// The failed targets are retried by the endpoints with the RetryFailed policy, so only the remaining failures
+// are handled here.
+type failurePolicyEndpoint struct {
+	end.Endpoint
+	ctx.Context
+}
+
+// Apply applies chaos, and then rolls back the injected targets or continues with them if some targets failed
+func (e *failurePolicyEndpoint) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1.InnerObject) error {
+	err := e.Endpoint.Apply(ctx, req, chaos)
+	if err == nil {
+		return nil
+	}
+
+	var policy *v1alpha1.FailurePolicy
+	if object, ok := chaos.(v1alpha1.FailurePolicyObject); ok {
+		policy = object.GetFailurePolicy()
+	}
+
+	if policy.GetType() == v1alpha1.ContinuePolicy {
+		if injected := injectedTargets(chaos.GetStatus().Experiment.PodRecords); injected > 0 {
+			e.Log.Info("Continue with the injected targets", "injected", injected, "error", err.Error())
+			return nil
+		}
+		return err
+	}
+
+	// nothing needs to be recovered if no finalizer is added
+	if len(chaos.(metav1.Object).GetFinalizers()) == 0 {
+		return err
+	}
+
+	e.Log.Info("Rolling back the injected targets", "error", err.Error())
+	if rollbackErr := e.Endpoint.Recover(ctx, req, chaos); rollbackErr != nil {
+		e.Log.Error(rollbackErr, "failed to roll back the injected targets")
+		return multierror.Append(err, rollbackErr)
+	}
+	e.Event(chaos, v1.EventTypeNormal, utils.EventChaosRolledBack, err.Error())
+
+	return err
+}
+
+// injectedTargets returns the number of the injected targets in the records
+func injectedTargets(records []v1alpha1.PodStatus) int {
+	injected := 0
+	for _, record := range records {
+		if record.State == v1alpha1.TargetInjected {
+			injected++
+		}
+	}
+	return injected
+}
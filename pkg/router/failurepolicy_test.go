@@ -0,0 +1,94 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package router
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	ctx "github.com/chaos-mesh/chaos-mesh/pkg/router/context"
+)
+
+// rollbackEndpoint fails to inject the pod "bad" like partialEndpoint, and recovers all the injected pods
+type rollbackEndpoint struct {
+	partialEndpoint
+}
+
+func (e *rollbackEndpoint) Recover(ctx context.Context, req ctrl.Request, chaos v1alpha1.InnerObject) error {
+	chaos.(*v1alpha1.NetworkChaos).Finalizers = nil
+	return nil
+}
+
+var _ = Describe("Failure policy endpoint", func() {
+	newEndpoint := func() (*failurePolicyEndpoint, *record.FakeRecorder) {
+		recorder := record.NewFakeRecorder(10)
+		return &failurePolicyEndpoint{
+			Endpoint: &statusEndpoint{Endpoint: &rollbackEndpoint{}},
+			Context: ctx.Context{
+				EventRecorder: recorder,
+				Log:           ctrl.Log.WithName("router"),
+			},
+		}, recorder
+	}
+
+	newChaos := func(policy *v1alpha1.FailurePolicy) *v1alpha1.NetworkChaos {
+		return &v1alpha1.NetworkChaos{
+			ObjectMeta: metav1.ObjectMeta{Name: "partial", Namespace: metav1.NamespaceDefault},
+			Spec:       v1alpha1.NetworkChaosSpec{FailurePolicy: policy},
+		}
+	}
+
+	It("should roll back the injected targets by default", func() {
+		chaos := newChaos(nil)
+		e, recorder := newEndpoint()
+
+		Expect(e.Apply(context.Background(), ctrl.Request{}, chaos)).ToNot(Succeed())
+		Expect(chaos.Finalizers).To(BeEmpty())
+		Expect(recorder.Events).To(HaveLen(1))
+
+		records := chaos.Status.Experiment.PodRecords
+		Expect(records[0].State).To(Equal(v1alpha1.TargetRecovered))
+		Expect(records[1].State).To(Equal(v1alpha1.TargetRecovered))
+		Expect(records[2].State).To(Equal(v1alpha1.TargetFailed))
+		Expect(chaos.Status.GetCondition(v1alpha1.ConditionRecovered).Status).To(Equal(v1alpha1.ConditionTrue))
+	})
+
+	It("should continue with the injected targets", func() {
+		chaos := newChaos(&v1alpha1.FailurePolicy{Type: v1alpha1.ContinuePolicy})
+		e, recorder := newEndpoint()
+
+		Expect(e.Apply(context.Background(), ctrl.Request{}, chaos)).To(Succeed())
+		Expect(chaos.Finalizers).To(HaveLen(2))
+		Expect(recorder.Events).To(BeEmpty())
+
+		Expect(chaos.Status.Experiment.PodRecords[0].State).To(Equal(v1alpha1.TargetInjected))
+		Expect(chaos.Status.GetCondition(v1alpha1.ConditionAllInjected).Status).To(Equal(v1alpha1.ConditionFalse))
+	})
+
+	It("should fail if no target is injected", func() {
+		chaos := newChaos(&v1alpha1.FailurePolicy{Type: v1alpha1.ContinuePolicy})
+		e := &failurePolicyEndpoint{
+			Endpoint: &statusEndpoint{Endpoint: &failedEndpoint{}},
+			Context:  ctx.Context{Log: ctrl.Log.WithName("router")},
+		}
+
+		Expect(e.Apply(context.Background(), ctrl.Request{}, chaos)).ToNot(Succeed())
+	})
+})
@@ -16,16 +16,24 @@ package router
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/chaospolicy"
 	"github.com/chaos-mesh/chaos-mesh/controllers/common"
+	"github.com/chaos-mesh/chaos-mesh/controllers/oneshot"
 	"github.com/chaos-mesh/chaos-mesh/controllers/twophase"
 	ctx "github.com/chaos-mesh/chaos-mesh/pkg/router/context"
 	end "github.com/chaos-mesh/chaos-mesh/pkg/router/endpoint"
@@ -82,6 +90,64 @@ func (r *Reconciler) Reconcile(req ctrl.Request) (result ctrl.Result, err error)
 		return ctrl.Result{}, err
 	}
 
+	// the ChaosPolicies may have changed since the admission, so they are checked again before applying
+	checker := &chaospolicy.Checker{
+		Client: r.Client,
+		Reader: r.Reader,
+		Log:    r.Log.WithName("chaospolicy"),
+	}
+
+	if inDryRun(chaos) {
+		if chaos.IsDeleted() {
+			// nothing is injected in the dry run mode, so there is nothing to recover
+			return ctrl.Result{}, nil
+		}
+		if err := r.dryRun(req, controller, checker, chaos); err != nil {
+			r.Log.Error(err, "failed to run in dry run mode")
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, nil
+	}
+
+	controller = &statusEndpoint{Endpoint: controller}
+	// the failed injection is handled after the conditions are set, so that the rollback is recorded too
+	controller = &failurePolicyEndpoint{Endpoint: controller, Context: ctx}
+
+	killSwitch, err := r.enabledKillSwitch()
+	if err != nil {
+		r.Log.Error(err, "unable to get chaos kill switches")
+		return ctrl.Result{}, err
+	}
+	if killSwitch != nil && !chaos.IsDeleted() {
+		// the deleted chaos is recovered as usual
+		if err := r.stop(req, controller, chaos, killSwitch); err != nil {
+			r.Event(chaos, v1.EventTypeWarning, utils.EventChaosRecoverFailed, err.Error())
+			return ctrl.Result{Requeue: true}, nil
+		}
+		return ctrl.Result{}, nil
+	}
+
+	if !chaos.IsDeleted() && !chaos.IsPaused() {
+		if failedPermanently(chaos) {
+			// the chaos is not applied again, but the targets left by it are still recovered
+			return r.cleanUp(req, controller, chaos), nil
+		}
+
+		status := chaos.GetStatus()
+		next := status.Retry.NextRetryTime
+		if status.Experiment.Phase == v1alpha1.ExperimentPhaseFailed && next != nil && time.Now().Before(next.Time) {
+			r.Log.Info("Backing off", "until", next.Time)
+			return ctrl.Result{RequeueAfter: time.Until(next.Time)}, nil
+		}
+	}
+
+	controller = &policyEndpoint{
+		Endpoint: controller,
+		checker:  checker,
+	}
+	retrying := &retryEndpoint{Endpoint: controller}
+	controller = retrying
+
 	var reconciler reconcile.Reconciler
 	if scheduler == nil && duration == nil {
 		reconciler = common.NewReconciler(controller, ctx)
@@ -90,9 +156,8 @@ func (r *Reconciler) Reconcile(req ctrl.Request) (result ctrl.Result, err error)
 		// but PodKill is an expection
 		reconciler = twophase.NewReconciler(controller, ctx)
 	} else {
-		err := errors.Errorf("both scheduler and duration should be nil or not nil")
-		r.Log.Error(err, "fail to construct reconciler", "scheduler", scheduler, "duration", duration)
-		return ctrl.Result{}, err
+		// scheduler == nil && duration != nil
+		reconciler = oneshot.NewReconciler(controller, ctx)
 	}
 
 	result, err = reconciler.Reconcile(req)
@@ -103,9 +168,138 @@ func (r *Reconciler) Reconcile(req ctrl.Request) (result ctrl.Result, err error)
 			r.Event(chaos, v1.EventTypeWarning, utils.EventChaosInjectFailed, err.Error())
 		}
 	}
+
+	if retrying.exceeded {
+		message := "retry limit is exceeded"
+		if err != nil {
+			message = err.Error()
+		}
+		r.Event(chaos, v1.EventTypeWarning, utils.EventChaosFailed, message)
+		// requeue once to clean up the targets left by the failed chaos
+		return ctrl.Result{Requeue: true}, nil
+	}
+	if retrying.retryAfter > 0 {
+		return ctrl.Result{RequeueAfter: retrying.retryAfter}, nil
+	}
 	return result, nil
 }
 
+// cleanUp recovers the targets left by the chaos which failed permanently, the recovery is retried until it succeeds
+func (r *Reconciler) cleanUp(req ctrl.Request, controller end.Endpoint, chaos v1alpha1.InnerSchedulerObject) ctrl.Result {
+	if len(chaos.(metav1.Object).GetFinalizers()) == 0 {
+		return ctrl.Result{}
+	}
+
+	r.Log.Info("Cleaning up the chaos failed permanently")
+	recoverErr := controller.Recover(context.Background(), req, chaos)
+	if recoverErr != nil {
+		r.Log.Error(recoverErr, "failed to clean up chaos")
+		r.Event(chaos, v1.EventTypeWarning, utils.EventChaosRecoverFailed, recoverErr.Error())
+	}
+
+	// the finalizers of the recovered targets are removed even if some targets failed to be recovered
+	if err := r.Client.Update(context.Background(), chaos); err != nil {
+		r.Log.Error(err, "unable to update chaos status")
+		return ctrl.Result{Requeue: true}
+	}
+	return ctrl.Result{Requeue: recoverErr != nil}
+}
+
+// enabledKillSwitch returns the first enabled ChaosKillSwitch, or nil if no kill switch is tripped
+func (r *Reconciler) enabledKillSwitch() (*v1alpha1.ChaosKillSwitch, error) {
+	var killSwitches v1alpha1.ChaosKillSwitchList
+	if err := r.Client.List(context.Background(), &killSwitches); err != nil {
+		return nil, err
+	}
+	return killSwitches.Enabled(), nil
+}
+
+// stop recovers the running chaos and marks it as paused, like pausing it with the annotation.
+// The chaos is resumed as a paused one after all the kill switches are disabled.
+func (r *Reconciler) stop(req ctrl.Request, controller end.Endpoint, chaos v1alpha1.InnerSchedulerObject, killSwitch *v1alpha1.ChaosKillSwitch) error {
+	status := chaos.GetStatus()
+	if status.Experiment.Phase == v1alpha1.ExperimentPhasePaused {
+		return nil
+	}
+
+	r.Log.Info("Stopping by the kill switch", "killSwitch", killSwitch.Name, "reason", killSwitch.Spec.Reason)
+	if status.Experiment.Phase == v1alpha1.ExperimentPhaseRunning {
+		if err := controller.Recover(context.Background(), req, chaos); err != nil {
+			r.Log.Error(err, "failed to stop chaos")
+			return err
+		}
+
+		now := time.Now()
+		status.Experiment.EndTime = &metav1.Time{
+			Time: now,
+		}
+		if status.Experiment.StartTime != nil {
+			status.Experiment.Duration = now.Sub(status.Experiment.StartTime.Time).String()
+		}
+	}
+	status.Experiment.Phase = v1alpha1.ExperimentPhasePaused
+	status.FailedMessage = ""
+
+	if err := r.Client.Update(context.Background(), chaos); err != nil {
+		r.Log.Error(err, "unable to update chaos status")
+		return err
+	}
+
+	r.Event(chaos, v1.EventTypeNormal, utils.EventChaosStopped,
+		fmt.Sprintf("chaos is stopped by the kill switch %s: %s", killSwitch.Name, killSwitch.Spec.Reason))
+	return nil
+}
+
+// requestsForAll returns the requests of all the chaos reconciled by the reconciler
+func (r *Reconciler) requestsForAll(handler.MapObject) []reconcile.Request {
+	chaos, ok := r.Object.(v1alpha1.InnerObject)
+	if !ok {
+		return nil
+	}
+	kind, ok := v1alpha1.AllKinds()[chaos.GetChaos().Kind]
+	if !ok {
+		return nil
+	}
+
+	list := kind.ChaosList.DeepCopyObject()
+	if err := r.Client.List(context.Background(), list); err != nil {
+		r.Log.Error(err, "unable to list chaos")
+		return nil
+	}
+	items, err := apimeta.ExtractList(list)
+	if err != nil {
+		r.Log.Error(err, "unable to extract chaos list")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, item := range items {
+		accessor, err := apimeta.Accessor(item)
+		if err != nil {
+			continue
+		}
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{
+			Namespace: accessor.GetNamespace(),
+			Name:      accessor.GetName(),
+		}})
+	}
+	return requests
+}
+
+// policyEndpoint checks the ChaosPolicies before applying chaos, the recovery is never blocked
+type policyEndpoint struct {
+	end.Endpoint
+	checker v1alpha1.ChaosPolicyChecker
+}
+
+// Apply applies chaos if it is allowed by the ChaosPolicies
+func (e *policyEndpoint) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1.InnerObject) error {
+	if err := e.checker.Check(ctx, chaos, true); err != nil {
+		return err
+	}
+	return e.Endpoint.Apply(ctx, req, chaos)
+}
+
 // NewReconciler creates a new reconciler
 func NewReconciler(name string, object runtime.Object, mgr ctrl.Manager, endpoints []routeEndpoint) *Reconciler {
 	return &Reconciler{
@@ -124,7 +318,11 @@ func NewReconciler(name string, object runtime.Object, mgr ctrl.Manager, endpoin
 
 // SetupWithManager registers controller to manager
 func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// all the chaos are reconciled once a kill switch is changed, so that they are stopped or resumed immediately
 	return ctrl.NewControllerManagedBy(mgr).
 		For(r.Object.DeepCopyObject()).
+		Watches(&source.Kind{Type: &v1alpha1.ChaosKillSwitch{}}, &handler.EnqueueRequestsFromMapFunc{
+			ToRequests: handler.ToRequestsFunc(r.requestsForAll),
+		}).
 		Complete(r)
 }
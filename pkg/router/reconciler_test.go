@@ -0,0 +1,131 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package router
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	ctx "github.com/chaos-mesh/chaos-mesh/pkg/router/context"
+)
+
+type recoverEndpoint struct {
+	testEndpoint
+	recovered int
+}
+
+func (e *recoverEndpoint) Recover(ctx context.Context, req ctrl.Request, chaos v1alpha1.InnerObject) error {
+	e.recovered++
+	return nil
+}
+
+var _ = Describe("Reconciler with kill switch", func() {
+	newReconciler := func(objects ...runtime.Object) *Reconciler {
+		scheme := runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+		Expect(v1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		return &Reconciler{
+			Name:   "networkchaos",
+			Object: &v1alpha1.NetworkChaos{},
+			Context: ctx.Context{
+				Client:        fake.NewFakeClientWithScheme(scheme, objects...),
+				EventRecorder: record.NewFakeRecorder(10),
+				Log:           ctrl.Log.WithName("router"),
+			},
+		}
+	}
+
+	newChaos := func(name string, phase v1alpha1.ExperimentPhase) *v1alpha1.NetworkChaos {
+		return &v1alpha1.NetworkChaos{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: metav1.NamespaceDefault,
+			},
+			Status: v1alpha1.NetworkChaosStatus{
+				ChaosStatus: v1alpha1.ChaosStatus{
+					Experiment: v1alpha1.ExperimentStatus{Phase: phase},
+				},
+			},
+		}
+	}
+
+	It("should find the enabled kill switch", func() {
+		r := newReconciler(&v1alpha1.ChaosKillSwitch{
+			ObjectMeta: metav1.ObjectMeta{Name: "disabled"},
+		})
+		killSwitch, err := r.enabledKillSwitch()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(killSwitch).To(BeNil())
+
+		r = newReconciler(&v1alpha1.ChaosKillSwitch{
+			ObjectMeta: metav1.ObjectMeta{Name: "disabled"},
+		}, &v1alpha1.ChaosKillSwitch{
+			ObjectMeta: metav1.ObjectMeta{Name: "enabled"},
+			Spec:       v1alpha1.ChaosKillSwitchSpec{Enabled: true},
+		})
+		killSwitch, err = r.enabledKillSwitch()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(killSwitch).ToNot(BeNil())
+		Expect(killSwitch.Name).To(Equal("enabled"))
+	})
+
+	It("should recover the running chaos and mark it as paused", func() {
+		killSwitch := &v1alpha1.ChaosKillSwitch{
+			ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.DefaultChaosKillSwitchName},
+			Spec:       v1alpha1.ChaosKillSwitchSpec{Enabled: true, Reason: "incident"},
+		}
+		running := newChaos("running", v1alpha1.ExperimentPhaseRunning)
+		waiting := newChaos("waiting", v1alpha1.ExperimentPhaseWaiting)
+		r := newReconciler(killSwitch, running, waiting)
+
+		endpoint := &recoverEndpoint{}
+		for _, chaos := range []*v1alpha1.NetworkChaos{running, waiting} {
+			req := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: chaos.Namespace, Name: chaos.Name}}
+			Expect(r.stop(req, endpoint, chaos, killSwitch)).To(Succeed())
+
+			var updated v1alpha1.NetworkChaos
+			Expect(r.Client.Get(context.Background(), req.NamespacedName, &updated)).To(Succeed())
+			Expect(updated.Status.Experiment.Phase).To(Equal(v1alpha1.ExperimentPhasePaused))
+		}
+		// only the running chaos is recovered
+		Expect(endpoint.recovered).To(Equal(1))
+
+		// the stopped chaos is not recovered again
+		Expect(r.stop(ctrl.Request{}, endpoint, running, killSwitch)).To(Succeed())
+		Expect(endpoint.recovered).To(Equal(1))
+	})
+
+	It("should enqueue all the chaos when a kill switch changes", func() {
+		r := newReconciler(newChaos("a", v1alpha1.ExperimentPhaseRunning), newChaos("b", v1alpha1.ExperimentPhaseWaiting))
+
+		requests := r.requestsForAll(handler.MapObject{})
+		Expect(requests).To(ConsistOf(
+			reconcile.Request{NamespacedName: types.NamespacedName{Namespace: metav1.NamespaceDefault, Name: "a"}},
+			reconcile.Request{NamespacedName: types.NamespacedName{Namespace: metav1.NamespaceDefault, Name: "b"}},
+		))
+	})
+})
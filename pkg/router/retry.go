@@ -0,0 +1,127 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package router
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	end "github.com/chaos-mesh/chaos-mesh/pkg/router/endpoint"
+	"github.com/chaos-mesh/chaos-mesh/pkg/utils"
+)
+
+// retryEndpoint counts the consecutive failures to apply chaos, and gives up once the retry limit is exceeded
+// if the limit is configured.
+// The recovery is not counted, so that it is always retried.
+type retryEndpoint struct {
+	end.Endpoint
+
+	// retryAfter is the backoff before the next retry, if the chaos failed to be applied
+	retryAfter time.Duration
+	// exceeded is whether the retry limit is exceeded, so that the chaos failed permanently
+	exceeded bool
+}
+
+// Apply applies chaos, and records the failure in the status
+func (e *retryEndpoint) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1.InnerObject) error {
+	status := chaos.GetStatus()
+	generation := chaos.(metav1.Object).GetGeneration()
+	// only the consecutive failures of the same spec are counted
+	if status.Experiment.Phase != v1alpha1.ExperimentPhaseFailed || status.Retry.ObservedGeneration != generation {
+		status.Retry = v1alpha1.RetryStatus{}
+	}
+
+	err := e.Endpoint.Apply(ctx, req, chaos)
+	if err == nil {
+		status.Retry = v1alpha1.RetryStatus{}
+		for _, conditionType := range []v1alpha1.ChaosConditionType{v1alpha1.ConditionFailed, v1alpha1.ConditionQueued} {
+			if status.GetCondition(conditionType) != nil {
+				status.SetCondition(v1alpha1.ChaosCondition{
+					Type:               conditionType,
+					Status:             v1alpha1.ConditionFalse,
+					ObservedGeneration: generation,
+					Reason:             "Applied",
+				})
+			}
+		}
+		return nil
+	}
+
+	now := metav1.Now()
+	if utils.IsTargetQueued(err) {
+		// waiting for the targets leased by other experiments is not counted as a failure
+		e.retryAfter = v1alpha1.DefaultConflictQueueInterval
+		next := metav1.NewTime(now.Add(e.retryAfter))
+		status.Retry.ObservedGeneration = generation
+		status.Retry.NextRetryTime = &next
+		status.SetCondition(v1alpha1.ChaosCondition{
+			Type:               v1alpha1.ConditionQueued,
+			Status:             v1alpha1.ConditionTrue,
+			ObservedGeneration: generation,
+			Reason:             "TargetsLeased",
+			Message:            err.Error(),
+		})
+		return err
+	}
+
+	var policy *v1alpha1.RetryPolicy
+	if object, ok := chaos.(v1alpha1.RetryPolicyObject); ok {
+		policy = object.GetRetryPolicy()
+	}
+
+	status.Retry.Attempts++
+	status.Retry.ObservedGeneration = generation
+	status.Retry.LastFailureTime = &now
+	status.Retry.NextRetryTime = nil
+
+	if limit, ok := policy.GetLimit(); ok && status.Retry.Attempts > limit {
+		e.exceeded = true
+		err = fmt.Errorf("retry limit is exceeded after %d attempts: %v", status.Retry.Attempts, err)
+		status.SetCondition(v1alpha1.ChaosCondition{
+			Type:               v1alpha1.ConditionFailed,
+			Status:             v1alpha1.ConditionTrue,
+			ObservedGeneration: generation,
+			Reason:             "RetryLimitExceeded",
+			Message:            err.Error(),
+		})
+		return err
+	}
+
+	// the backoff is validated by the webhook
+	delay, delayErr := policy.Delay(status.Retry.Attempts)
+	if delayErr != nil {
+		delay = v1alpha1.DefaultRetryBackoff
+	}
+	e.retryAfter = delay
+	next := metav1.NewTime(now.Add(delay))
+	status.Retry.NextRetryTime = &next
+
+	return err
+}
+
+// failedPermanently returns whether the chaos failed permanently with its current spec
+func failedPermanently(chaos v1alpha1.InnerObject) bool {
+	status := chaos.GetStatus()
+	if status.Experiment.Phase != v1alpha1.ExperimentPhaseFailed {
+		return false
+	}
+	failed := status.GetCondition(v1alpha1.ConditionFailed)
+	return failed != nil && failed.Status == v1alpha1.ConditionTrue &&
+		failed.ObservedGeneration == chaos.(metav1.Object).GetGeneration()
+}
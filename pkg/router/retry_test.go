@@ -0,0 +1,195 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package router
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	ctx "github.com/chaos-mesh/chaos-mesh/pkg/router/context"
+	"github.com/chaos-mesh/chaos-mesh/pkg/utils"
+)
+
+var _ = Describe("Retry endpoint", func() {
+	newChaos := func() *v1alpha1.NetworkChaos {
+		limit := int32(2)
+		return &v1alpha1.NetworkChaos{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "failed",
+				Namespace:  metav1.NamespaceDefault,
+				Generation: 1,
+			},
+			Spec: v1alpha1.NetworkChaosSpec{
+				RetryPolicy: &v1alpha1.RetryPolicy{
+					Limit:      &limit,
+					Backoff:    "10s",
+					MaxBackoff: "15s",
+				},
+			},
+		}
+	}
+
+	// apply applies the chaos like a reconciler, which marks the chaos as failed if it failed to be applied
+	apply := func(endpoint *retryEndpoint, chaos *v1alpha1.NetworkChaos) error {
+		err := endpoint.Apply(context.Background(), ctrl.Request{}, chaos)
+		if err != nil {
+			chaos.Status.Experiment.Phase = v1alpha1.ExperimentPhaseFailed
+		} else {
+			chaos.Status.Experiment.Phase = v1alpha1.ExperimentPhaseRunning
+		}
+		return err
+	}
+
+	It("should back off and fail permanently", func() {
+		chaos := newChaos()
+
+		for i, delay := range []time.Duration{10 * time.Second, 15 * time.Second} {
+			e := &retryEndpoint{Endpoint: &failedEndpoint{}}
+			Expect(apply(e, chaos)).ToNot(Succeed())
+			Expect(e.exceeded).To(BeFalse())
+			Expect(e.retryAfter).To(Equal(delay))
+			Expect(chaos.Status.Retry.Attempts).To(Equal(int32(i + 1)))
+			Expect(chaos.Status.Retry.NextRetryTime).ToNot(BeNil())
+			Expect(failedPermanently(chaos)).To(BeFalse())
+		}
+
+		e := &retryEndpoint{Endpoint: &failedEndpoint{}}
+		err := apply(e, chaos)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(Equal("retry limit is exceeded after 3 attempts: no pod is selected"))
+		Expect(e.exceeded).To(BeTrue())
+		Expect(chaos.Status.Retry.NextRetryTime).To(BeNil())
+		Expect(failedPermanently(chaos)).To(BeTrue())
+
+		failed := chaos.Status.GetCondition(v1alpha1.ConditionFailed)
+		Expect(failed.Status).To(Equal(v1alpha1.ConditionTrue))
+		Expect(failed.Reason).To(Equal("RetryLimitExceeded"))
+	})
+
+	It("should back off without limit if the limit is not set", func() {
+		chaos := newChaos()
+		chaos.Spec.RetryPolicy.Limit = nil
+		for i := 0; i < 10; i++ {
+			e := &retryEndpoint{Endpoint: &failedEndpoint{}}
+			Expect(apply(e, chaos)).ToNot(Succeed())
+			Expect(e.exceeded).To(BeFalse())
+			Expect(e.retryAfter).To(BeNumerically("<=", 15*time.Second))
+			Expect(failedPermanently(chaos)).To(BeFalse())
+		}
+		Expect(chaos.Status.Retry.Attempts).To(Equal(int32(10)))
+
+		chaos.Spec.RetryPolicy = nil
+		for i := 0; i < 10; i++ {
+			e := &retryEndpoint{Endpoint: &failedEndpoint{}}
+			Expect(apply(e, chaos)).ToNot(Succeed())
+			Expect(e.exceeded).To(BeFalse())
+			Expect(e.retryAfter).To(BeNumerically("<=", v1alpha1.DefaultRetryMaxBackoff))
+			Expect(failedPermanently(chaos)).To(BeFalse())
+		}
+		Expect(chaos.Status.Retry.Attempts).To(Equal(int32(20)))
+	})
+
+	It("should reset the attempts once the spec is changed or the chaos is applied", func() {
+		chaos := newChaos()
+		for i := 0; i < 3; i++ {
+			Expect(apply(&retryEndpoint{Endpoint: &failedEndpoint{}}, chaos)).ToNot(Succeed())
+		}
+		Expect(failedPermanently(chaos)).To(BeTrue())
+
+		chaos.Generation = 2
+		Expect(failedPermanently(chaos)).To(BeFalse())
+		Expect(apply(&retryEndpoint{Endpoint: &failedEndpoint{}}, chaos)).ToNot(Succeed())
+		Expect(chaos.Status.Retry.Attempts).To(Equal(int32(1)))
+		Expect(chaos.Status.Retry.ObservedGeneration).To(Equal(int64(2)))
+
+		Expect(apply(&retryEndpoint{Endpoint: &testEndpoint{}}, chaos)).To(Succeed())
+		Expect(chaos.Status.Retry).To(Equal(v1alpha1.RetryStatus{}))
+		Expect(chaos.Status.GetCondition(v1alpha1.ConditionFailed).Status).To(Equal(v1alpha1.ConditionFalse))
+	})
+
+	It("should wait for the targets leased by other experiments without counting the attempts", func() {
+		chaos := newChaos()
+		for i := 0; i < 3; i++ {
+			e := &retryEndpoint{Endpoint: &queuedEndpoint{}}
+			Expect(apply(e, chaos)).ToNot(Succeed())
+			Expect(e.exceeded).To(BeFalse())
+			Expect(e.retryAfter).To(Equal(v1alpha1.DefaultConflictQueueInterval))
+		}
+		Expect(chaos.Status.Retry.Attempts).To(BeZero())
+		Expect(chaos.Status.Retry.NextRetryTime).ToNot(BeNil())
+		Expect(failedPermanently(chaos)).To(BeFalse())
+
+		queued := chaos.Status.GetCondition(v1alpha1.ConditionQueued)
+		Expect(queued.Status).To(Equal(v1alpha1.ConditionTrue))
+		Expect(queued.Reason).To(Equal("TargetsLeased"))
+
+		Expect(apply(&retryEndpoint{Endpoint: &testEndpoint{}}, chaos)).To(Succeed())
+		Expect(chaos.Status.GetCondition(v1alpha1.ConditionQueued).Status).To(Equal(v1alpha1.ConditionFalse))
+	})
+
+	It("should clean up the chaos failed permanently", func() {
+		chaos := newChaos()
+		chaos.Finalizers = []string{"default/pod"}
+
+		scheme := runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+		Expect(v1alpha1.AddToScheme(scheme)).To(Succeed())
+		r := &Reconciler{
+			Name:   "networkchaos",
+			Object: &v1alpha1.NetworkChaos{},
+			Context: ctx.Context{
+				Client:        fake.NewFakeClientWithScheme(scheme, chaos.DeepCopy()),
+				EventRecorder: record.NewFakeRecorder(10),
+				Log:           ctrl.Log.WithName("router"),
+			},
+		}
+
+		req := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: chaos.Namespace, Name: chaos.Name}}
+		Expect(r.Client.Get(context.Background(), req.NamespacedName, chaos)).To(Succeed())
+
+		endpoint := &recoverEndpoint{}
+		Expect(r.cleanUp(req, endpoint, chaos)).To(Equal(ctrl.Result{}))
+		Expect(endpoint.recovered).To(Equal(1))
+
+		// nothing is left after the finalizers are removed
+		chaos.Finalizers = nil
+		Expect(r.cleanUp(req, endpoint, chaos)).To(Equal(ctrl.Result{}))
+		Expect(endpoint.recovered).To(Equal(1))
+	})
+})
+
+type queuedEndpoint struct {
+	testEndpoint
+}
+
+func (e *queuedEndpoint) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1.InnerObject) error {
+	return &utils.TargetConflictError{
+		Namespace: metav1.NamespaceDefault,
+		Name:      "pod",
+		Kind:      v1alpha1.KindNetworkChaos,
+		Holders:   []string{"default/other"},
+		Policy:    v1alpha1.QueueConflictPolicy,
+	}
+}
@@ -0,0 +1,169 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package router
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	end "github.com/chaos-mesh/chaos-mesh/pkg/router/endpoint"
+)
+
+// maxReportedFailures is the max number of the failed targets reported in the message of a condition
+const maxReportedFailures = 5
+
+// statusEndpoint sets the conditions of the chaos from the target records after applying or recovering chaos
+type statusEndpoint struct {
+	end.Endpoint
+}
+
+// Apply applies chaos, and sets the Selected, AllInjected and Recovered conditions
+func (e *statusEndpoint) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1.InnerObject) error {
+	status := chaos.GetStatus()
+	// the records of the last experiment are replaced by the endpoint
+	status.Experiment.PodRecords = nil
+
+	err := e.Endpoint.Apply(ctx, req, chaos)
+
+	generation := chaos.(metav1.Object).GetGeneration()
+	records := status.Experiment.PodRecords
+	if len(records) > 0 {
+		status.SetCondition(v1alpha1.ChaosCondition{
+			Type:               v1alpha1.ConditionSelected,
+			Status:             v1alpha1.ConditionTrue,
+			ObservedGeneration: generation,
+			Reason:             "TargetsSelected",
+			Message:            fmt.Sprintf("%d targets are selected", len(records)),
+		})
+	} else {
+		message := "no target is selected"
+		if err != nil {
+			message = err.Error()
+		}
+		status.SetCondition(v1alpha1.ChaosCondition{
+			Type:               v1alpha1.ConditionSelected,
+			Status:             v1alpha1.ConditionFalse,
+			ObservedGeneration: generation,
+			Reason:             "NoTargetSelected",
+			Message:            message,
+		})
+	}
+
+	if err != nil {
+		status.SetCondition(v1alpha1.ChaosCondition{
+			Type:               v1alpha1.ConditionAllInjected,
+			Status:             v1alpha1.ConditionFalse,
+			ObservedGeneration: generation,
+			Reason:             "InjectionFailed",
+			Message:            injectionFailures(records, err),
+		})
+	} else {
+		status.SetCondition(v1alpha1.ChaosCondition{
+			Type:               v1alpha1.ConditionAllInjected,
+			Status:             v1alpha1.ConditionTrue,
+			ObservedGeneration: generation,
+			Reason:             "AllTargetsInjected",
+			Message:            fmt.Sprintf("%d targets are injected", len(records)),
+		})
+	}
+
+	status.SetCondition(v1alpha1.ChaosCondition{
+		Type:               v1alpha1.ConditionRecovered,
+		Status:             v1alpha1.ConditionFalse,
+		ObservedGeneration: generation,
+		Reason:             "Injected",
+	})
+
+	return err
+}
+
+// Recover recovers chaos, and sets the Recovered condition and the states of the recovered targets.
+// The target whose finalizer is kept after a failed recovery is not recovered.
+func (e *statusEndpoint) Recover(ctx context.Context, req ctrl.Request, chaos v1alpha1.InnerObject) error {
+	err := e.Endpoint.Recover(ctx, req, chaos)
+
+	object := chaos.(metav1.Object)
+	finalizers := make(map[string]struct{})
+	for _, finalizer := range object.GetFinalizers() {
+		finalizers[finalizer] = struct{}{}
+	}
+
+	status := chaos.GetStatus()
+	now := metav1.Now()
+	for i := range status.Experiment.PodRecords {
+		record := &status.Experiment.PodRecords[i]
+		if record.State != v1alpha1.TargetInjected {
+			continue
+		}
+		if _, ok := finalizers[recordKey(record)]; ok && err != nil {
+			record.Error = err.Error()
+			continue
+		}
+		record.State = v1alpha1.TargetRecovered
+		record.Error = ""
+		record.RecoveredAt = &now
+	}
+
+	if err != nil {
+		status.SetCondition(v1alpha1.ChaosCondition{
+			Type:               v1alpha1.ConditionRecovered,
+			Status:             v1alpha1.ConditionFalse,
+			ObservedGeneration: object.GetGeneration(),
+			Reason:             "RecoveryFailed",
+			Message:            err.Error(),
+		})
+	} else {
+		status.SetCondition(v1alpha1.ChaosCondition{
+			Type:               v1alpha1.ConditionRecovered,
+			Status:             v1alpha1.ConditionTrue,
+			ObservedGeneration: object.GetGeneration(),
+			Reason:             "AllTargetsRecovered",
+		})
+	}
+
+	return err
+}
+
+// recordKey returns the finalizer key of the target of the record
+func recordKey(record *v1alpha1.PodStatus) string {
+	if record.Namespace == "" {
+		return record.Name
+	}
+	return record.Namespace + "/" + record.Name
+}
+
+// injectionFailures describes the failed targets in the records, or the error if no target is failed
+func injectionFailures(records []v1alpha1.PodStatus, err error) string {
+	var failures []string
+	for i := range records {
+		if records[i].State == v1alpha1.TargetFailed {
+			failures = append(failures, fmt.Sprintf("%s: %s", recordKey(&records[i]), records[i].Error))
+		}
+	}
+	if len(failures) == 0 {
+		return err.Error()
+	}
+
+	message := fmt.Sprintf("%d of %d targets failed to be injected: ", len(failures), len(records))
+	if len(failures) > maxReportedFailures {
+		return message + strings.Join(failures[:maxReportedFailures], "; ") +
+			fmt.Sprintf("; and %d more", len(failures)-maxReportedFailures)
+	}
+	return message + strings.Join(failures, "; ")
+}
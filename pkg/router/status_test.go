@@ -0,0 +1,137 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package router
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/utils"
+)
+
+// partialEndpoint fails to inject the pod "bad", and fails to recover the pod "stuck"
+type partialEndpoint struct {
+	testEndpoint
+}
+
+func (e *partialEndpoint) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1.InnerObject) error {
+	networkchaos := chaos.(*v1alpha1.NetworkChaos)
+
+	var err error
+	results := utils.NewInjectionResults(nil)
+	for _, name := range []string{"good", "stuck", "bad"} {
+		networkchaos.Status.Experiment.PodRecords = append(networkchaos.Status.Experiment.PodRecords, v1alpha1.PodStatus{
+			Namespace: metav1.NamespaceDefault,
+			Name:      name,
+		})
+		if name == "bad" {
+			err = results.Record(metav1.NamespaceDefault, name, errors.New("daemon is unavailable"))
+			continue
+		}
+		networkchaos.Finalizers = append(networkchaos.Finalizers, metav1.NamespaceDefault+"/"+name)
+	}
+	results.Fill(networkchaos.Status.Experiment.PodRecords)
+	return err
+}
+
+func (e *partialEndpoint) Recover(ctx context.Context, req ctrl.Request, chaos v1alpha1.InnerObject) error {
+	networkchaos := chaos.(*v1alpha1.NetworkChaos)
+	networkchaos.Finalizers = utils.RemoveFromFinalizer(networkchaos.Finalizers, metav1.NamespaceDefault+"/good")
+	return errors.New("pod is stuck")
+}
+
+var _ = Describe("Status endpoint", func() {
+	It("should set the conditions and the target states", func() {
+		chaos := &v1alpha1.NetworkChaos{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "partial",
+				Namespace:  metav1.NamespaceDefault,
+				Generation: 2,
+			},
+			Status: v1alpha1.NetworkChaosStatus{
+				ChaosStatus: v1alpha1.ChaosStatus{
+					Experiment: v1alpha1.ExperimentStatus{
+						PodRecords: []v1alpha1.PodStatus{{Namespace: metav1.NamespaceDefault, Name: "stale"}},
+					},
+				},
+			},
+		}
+		e := &statusEndpoint{Endpoint: &partialEndpoint{}}
+
+		err := e.Apply(context.Background(), ctrl.Request{}, chaos)
+		Expect(err).To(HaveOccurred())
+
+		status := chaos.GetStatus()
+		Expect(status.Experiment.PodRecords).To(HaveLen(3))
+		Expect(status.Experiment.PodRecords[0].State).To(Equal(v1alpha1.TargetInjected))
+		Expect(status.Experiment.PodRecords[0].InjectedAt).ToNot(BeNil())
+		Expect(status.Experiment.PodRecords[2].State).To(Equal(v1alpha1.TargetFailed))
+		Expect(status.Experiment.PodRecords[2].Error).To(Equal("daemon is unavailable"))
+
+		selected := status.GetCondition(v1alpha1.ConditionSelected)
+		Expect(selected).ToNot(BeNil())
+		Expect(selected.Status).To(Equal(v1alpha1.ConditionTrue))
+		Expect(selected.ObservedGeneration).To(Equal(int64(2)))
+
+		allInjected := status.GetCondition(v1alpha1.ConditionAllInjected)
+		Expect(allInjected.Status).To(Equal(v1alpha1.ConditionFalse))
+		Expect(allInjected.Message).To(Equal("1 of 3 targets failed to be injected: default/bad: daemon is unavailable"))
+		Expect(status.GetCondition(v1alpha1.ConditionRecovered).Status).To(Equal(v1alpha1.ConditionFalse))
+
+		err = e.Recover(context.Background(), ctrl.Request{}, chaos)
+		Expect(err).To(HaveOccurred())
+
+		Expect(status.Experiment.PodRecords[0].State).To(Equal(v1alpha1.TargetRecovered))
+		Expect(status.Experiment.PodRecords[0].RecoveredAt).ToNot(BeNil())
+		Expect(status.Experiment.PodRecords[1].State).To(Equal(v1alpha1.TargetInjected))
+		Expect(status.Experiment.PodRecords[1].Error).To(Equal("pod is stuck"))
+		Expect(status.Experiment.PodRecords[2].State).To(Equal(v1alpha1.TargetFailed))
+
+		recovered := status.GetCondition(v1alpha1.ConditionRecovered)
+		Expect(recovered.Status).To(Equal(v1alpha1.ConditionFalse))
+		Expect(recovered.Reason).To(Equal("RecoveryFailed"))
+	})
+
+	It("should report the selection failure", func() {
+		chaos := &v1alpha1.NetworkChaos{
+			ObjectMeta: metav1.ObjectMeta{Name: "empty", Namespace: metav1.NamespaceDefault},
+		}
+		e := &statusEndpoint{Endpoint: &failedEndpoint{}}
+
+		Expect(e.Apply(context.Background(), ctrl.Request{}, chaos)).ToNot(Succeed())
+
+		selected := chaos.GetStatus().GetCondition(v1alpha1.ConditionSelected)
+		Expect(selected.Status).To(Equal(v1alpha1.ConditionFalse))
+		Expect(selected.Message).To(Equal("no pod is selected"))
+
+		// the transition time is kept if the status is not changed
+		transition := selected.LastTransitionTime
+		Expect(e.Apply(context.Background(), ctrl.Request{}, chaos)).ToNot(Succeed())
+		Expect(chaos.GetStatus().GetCondition(v1alpha1.ConditionSelected).LastTransitionTime).To(Equal(transition))
+	})
+})
+
+type failedEndpoint struct {
+	testEndpoint
+}
+
+func (e *failedEndpoint) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1.InnerObject) error {
+	return errors.New("no pod is selected")
+}
@@ -15,10 +15,10 @@ package time
 
 import (
 	"bytes"
-	"errors"
 	"runtime"
 
 	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
 
 	"github.com/chaos-mesh/chaos-mesh/pkg/mapreader"
 	"github.com/chaos-mesh/chaos-mesh/pkg/mock"
@@ -34,49 +34,33 @@ func RegisterLogger(logger logr.Logger) {
 	log = logger
 }
 
-// TODO: support more cpu architecture
-// TODO: auto generate these codes
-var fakeImage = []byte{
-	0xb8, 0xe4, 0x00, 0x00, 0x00, //mov    $0xe4,%eax
-	0x0f, 0x05, //syscall
-	0xba, 0x01, 0x00, 0x00, 0x00, //mov    $0x1,%edx
-	0x89, 0xf9, //mov    %edi,%ecx
-	0xd3, 0xe2, //shl    %cl,%edx
-	0x48, 0x8d, 0x0d, 0x74, 0x00, 0x00, 0x00, //lea    0x74(%rip),%rcx        # <CLOCK_IDS_MASK>
-	0x48, 0x63, 0xd2, //movslq %edx,%rdx
-	0x48, 0x85, 0x11, //test   %rdx,(%rcx)
-	0x74, 0x6b, //je     108a <clock_gettime+0x8a>
-	0x48, 0x8d, 0x15, 0x6d, 0x00, 0x00, 0x00, //lea    0x6d(%rip),%rdx        # <TV_SEC_DELTA>
-	0x4c, 0x8b, 0x46, 0x08, //mov    0x8(%rsi),%r8
-	0x48, 0x8b, 0x0a, //mov    (%rdx),%rcx
-	0x48, 0x8d, 0x15, 0x67, 0x00, 0x00, 0x00, //lea    0x67(%rip),%rdx        # <TV_NSEC_DELTA>
-	0x48, 0x8b, 0x3a, //mov    (%rdx),%rdi
-	0x4a, 0x8d, 0x14, 0x07, //lea    (%rdi,%r8,1),%rdx
-	0x48, 0x81, 0xfa, 0x00, 0xca, 0x9a, 0x3b, //cmp    $0x3b9aca00,%rdx
-	0x7e, 0x1c, //jle    <clock_gettime+0x60>
-	0x0f, 0x1f, 0x40, 0x00, //nopl   0x0(%rax)
-	0x48, 0x81, 0xef, 0x00, 0xca, 0x9a, 0x3b, //sub    $0x3b9aca00,%rdi
-	0x48, 0x83, 0xc1, 0x01, //add    $0x1,%rcx
-	0x49, 0x8d, 0x14, 0x38, //lea    (%r8,%rdi,1),%rdx
-	0x48, 0x81, 0xfa, 0x00, 0xca, 0x9a, 0x3b, //cmp    $0x3b9aca00,%rdx
-	0x7f, 0xe8, //jg     <clock_gettime+0x48>
-	0x48, 0x85, 0xd2, //test   %rdx,%rdx
-	0x79, 0x1e, //jns    <clock_gettime+0x83>
-	0x4a, 0x8d, 0xbc, 0x07, 0x00, 0xca, 0x9a, //lea    0x3b9aca00(%rdi,%r8,1),%rdi
-	0x3b,             //
-	0x0f, 0x1f, 0x00, //nopl   (%rax)
-	0x48, 0x89, 0xfa, //mov    %rdi,%rdx
-	0x48, 0x83, 0xe9, 0x01, //sub    $0x1,%rcx
-	0x48, 0x81, 0xc7, 0x00, 0xca, 0x9a, 0x3b, //add    $0x3b9aca00,%rdi
-	0x48, 0x85, 0xd2, //test   %rdx,%rdx
-	0x78, 0xed, //js     <clock_gettime+0x70>
-	0x48, 0x01, 0x0e, //add    %rcx,(%rsi)
-	0x48, 0x89, 0x56, 0x08, //mov    %rdx,0x8(%rsi)
-	0xc3, //retq
-	// constant
-	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, //CLOCK_IDS_MASK
-	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, //TV_SEC_DELTA
-	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, //TV_NSEC_DELTA
+// fakeImage is the machine code of a function which replaces the original one
+// in vDSO. Every image ends with three 8-byte variables: CLOCK_IDS_MASK,
+// TV_SEC_DELTA and TV_NSEC_DELTA. The sources of these images can be found in
+// pkg/fakeclockgettime.
+type fakeImage struct {
+	// symbolName is the name of the replaced function in vDSO
+	symbolName string
+	content    []byte
+}
+
+// 24 = 3 * 8 because we have three variables
+const variablesLen = 24
+
+func (image *fakeImage) constLen() int {
+	return len(image.content) - variablesLen
+}
+
+func (image *fakeImage) clockIdsMaskOffset() uint64 {
+	return uint64(image.constLen())
+}
+
+func (image *fakeImage) tvSecDeltaOffset() uint64 {
+	return uint64(image.constLen() + 8)
+}
+
+func (image *fakeImage) tvNsecDeltaOffset() uint64 {
+	return uint64(image.constLen() + 16)
 }
 
 // ModifyTime modifies time of target process
@@ -119,61 +103,65 @@ func ModifyTime(pid int, deltaSec int64, deltaNsec int64, clockIdsMask uint64) e
 		return errors.New("cannot find [vdso] entry")
 	}
 
+	for _, image := range fakeImages {
+		err = injectFakeImage(program, vdsoEntry, image, deltaSec, deltaNsec, clockIdsMask)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// injectFakeImage injects the fake image into the program (or reuses the
+// injected one), sets its variables and redirects the vDSO function to it.
+func injectFakeImage(program *ptrace.TracedProgram, vdsoEntry *mapreader.Entry, image *fakeImage, deltaSec int64, deltaNsec int64, clockIdsMask uint64) error {
+	originAddr, err := program.FindSymbolInEntry(image.symbolName, vdsoEntry)
+	if err != nil {
+		return errors.Wrapf(err, "find symbol %s", image.symbolName)
+	}
+
 	// minus tailing variable part
-	// 24 = 3 * 8 because we have three variables
-	constImageLen := len(fakeImage) - 24
+	constImageLen := image.constLen()
 	var fakeEntry *mapreader.Entry
 
 	// find injected image to avoid redundant inject (which will lead to memory leak)
 	for _, e := range program.Entries {
 		e := e
 
-		image, err := program.ReadSlice(e.StartAddress, uint64(constImageLen))
+		content, err := program.ReadSlice(e.StartAddress, uint64(constImageLen))
 		if err != nil {
 			continue
 		}
 
-		if bytes.Equal(*image, fakeImage[0:constImageLen]) {
+		if bytes.Equal(*content, image.content[0:constImageLen]) {
 			fakeEntry = &e
-			log.Info("found injected image", "addr", fakeEntry.StartAddress)
+			log.Info("found injected image", "addr", fakeEntry.StartAddress, "symbol", image.symbolName)
 			break
 		}
 	}
 	if fakeEntry == nil {
-		fakeEntry, err = program.MmapSlice(fakeImage)
+		fakeEntry, err = program.MmapSlice(image.content)
 		if err != nil {
 			return err
 		}
 	}
 	fakeAddr := fakeEntry.StartAddress
 
-	// 139 is the index of CLOCK_IDS_MASK in fakeImage
-	err = program.WriteUint64ToAddr(fakeAddr+139, clockIdsMask)
-	if err != nil {
-		return err
-	}
-
-	// 147 is the index of TV_SEC_DELTA in fakeImage
-	err = program.WriteUint64ToAddr(fakeAddr+147, uint64(deltaSec))
+	err = program.WriteUint64ToAddr(fakeAddr+image.clockIdsMaskOffset(), clockIdsMask)
 	if err != nil {
 		return err
 	}
 
-	// 155 is the index of TV_NSEC_DELTA in fakeImage
-	err = program.WriteUint64ToAddr(fakeAddr+155, uint64(deltaNsec))
+	err = program.WriteUint64ToAddr(fakeAddr+image.tvSecDeltaOffset(), uint64(deltaSec))
 	if err != nil {
 		return err
 	}
 
-	originAddr, err := program.FindSymbolInEntry("clock_gettime", vdsoEntry)
+	err = program.WriteUint64ToAddr(fakeAddr+image.tvNsecDeltaOffset(), uint64(deltaNsec))
 	if err != nil {
 		return err
 	}
 
-	err = program.JumpToFakeFunc(originAddr, fakeAddr)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return program.JumpToFakeFunc(originAddr, fakeAddr)
 }
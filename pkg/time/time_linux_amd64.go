@@ -0,0 +1,149 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package time
+
+// TODO: auto generate these codes
+var fakeImages = []*fakeImage{
+	{
+		symbolName: "clock_gettime",
+		content:    fakeClockGettime,
+	},
+	{
+		symbolName: "gettimeofday",
+		content:    fakeGettimeofday,
+	},
+	{
+		symbolName: "time",
+		content:    fakeTime,
+	},
+}
+
+var fakeClockGettime = []byte{
+	0xb8, 0xe4, 0x00, 0x00, 0x00, //mov    $0xe4,%eax
+	0x0f, 0x05, //syscall
+	0xba, 0x01, 0x00, 0x00, 0x00, //mov    $0x1,%edx
+	0x89, 0xf9, //mov    %edi,%ecx
+	0xd3, 0xe2, //shl    %cl,%edx
+	0x48, 0x8d, 0x0d, 0x74, 0x00, 0x00, 0x00, //lea    0x74(%rip),%rcx        # <CLOCK_IDS_MASK>
+	0x48, 0x63, 0xd2, //movslq %edx,%rdx
+	0x48, 0x85, 0x11, //test   %rdx,(%rcx)
+	0x74, 0x6b, //je     108a <clock_gettime+0x8a>
+	0x48, 0x8d, 0x15, 0x6d, 0x00, 0x00, 0x00, //lea    0x6d(%rip),%rdx        # <TV_SEC_DELTA>
+	0x4c, 0x8b, 0x46, 0x08, //mov    0x8(%rsi),%r8
+	0x48, 0x8b, 0x0a, //mov    (%rdx),%rcx
+	0x48, 0x8d, 0x15, 0x67, 0x00, 0x00, 0x00, //lea    0x67(%rip),%rdx        # <TV_NSEC_DELTA>
+	0x48, 0x8b, 0x3a, //mov    (%rdx),%rdi
+	0x4a, 0x8d, 0x14, 0x07, //lea    (%rdi,%r8,1),%rdx
+	0x48, 0x81, 0xfa, 0x00, 0xca, 0x9a, 0x3b, //cmp    $0x3b9aca00,%rdx
+	0x7e, 0x1c, //jle    <clock_gettime+0x60>
+	0x0f, 0x1f, 0x40, 0x00, //nopl   0x0(%rax)
+	0x48, 0x81, 0xef, 0x00, 0xca, 0x9a, 0x3b, //sub    $0x3b9aca00,%rdi
+	0x48, 0x83, 0xc1, 0x01, //add    $0x1,%rcx
+	0x49, 0x8d, 0x14, 0x38, //lea    (%r8,%rdi,1),%rdx
+	0x48, 0x81, 0xfa, 0x00, 0xca, 0x9a, 0x3b, //cmp    $0x3b9aca00,%rdx
+	0x7f, 0xe8, //jg     <clock_gettime+0x48>
+	0x48, 0x85, 0xd2, //test   %rdx,%rdx
+	0x79, 0x1e, //jns    <clock_gettime+0x83>
+	0x4a, 0x8d, 0xbc, 0x07, 0x00, 0xca, 0x9a, //lea    0x3b9aca00(%rdi,%r8,1),%rdi
+	0x3b,             //
+	0x0f, 0x1f, 0x00, //nopl   (%rax)
+	0x48, 0x89, 0xfa, //mov    %rdi,%rdx
+	0x48, 0x83, 0xe9, 0x01, //sub    $0x1,%rcx
+	0x48, 0x81, 0xc7, 0x00, 0xca, 0x9a, 0x3b, //add    $0x3b9aca00,%rdi
+	0x48, 0x85, 0xd2, //test   %rdx,%rdx
+	0x78, 0xed, //js     <clock_gettime+0x70>
+	0x48, 0x01, 0x0e, //add    %rcx,(%rsi)
+	0x48, 0x89, 0x56, 0x08, //mov    %rdx,0x8(%rsi)
+	0xc3, //retq
+	// constant
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, //CLOCK_IDS_MASK
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, //TV_SEC_DELTA
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, //TV_NSEC_DELTA
+}
+
+var fakeGettimeofday = []byte{
+	0xb8, 0x60, 0x00, 0x00, 0x00, //mov $0x60,%eax
+	0x0f, 0x05, //syscall
+	0x41, 0x89, 0xc0, //mov %eax,%r8d
+	0x48, 0x85, 0xff, //test %rdi,%rdi
+	0x0f, 0x84, 0x87, 0x00, 0x00, 0x00, //je 9a <gettimeofday+0x9a>
+	0xf6, 0x05, 0x86, 0x00, 0x00, 0x00, 0x01, //testb $0x1,0x86(%rip)
+	0x74, 0x7e, //je 9a <gettimeofday+0x9a>
+	0x48, 0x8b, 0x35, 0x8d, 0x00, 0x00, 0x00, //mov 0x8d(%rip),%rsi
+	0x48, 0x8b, 0x0d, 0x7e, 0x00, 0x00, 0x00, //mov 0x7e(%rip),%rcx
+	0x48, 0xb8, 0xcf, 0xf7, 0x53, 0xe3, 0xa5, //movabs $0x20c49ba5e353f7cf,%rax
+	0x9b, 0xc4, 0x20, //
+	0x48, 0xf7, 0xee, //imul %rsi
+	0x48, 0xc1, 0xfe, 0x3f, //sar $0x3f,%rsi
+	0x48, 0xc1, 0xfa, 0x07, //sar $0x7,%rdx
+	0x48, 0x29, 0xf2, //sub %rsi,%rdx
+	0x48, 0x8b, 0x77, 0x08, //mov 0x8(%rdi),%rsi
+	0x48, 0x8d, 0x04, 0x32, //lea (%rdx,%rsi,1),%rax
+	0x48, 0x3d, 0x3f, 0x42, 0x0f, 0x00, //cmp $0xf423f,%rax
+	0x7e, 0x1d, //jle 6f <gettimeofday+0x6f>
+	0x66, 0x0f, 0x1f, 0x44, 0x00, 0x00, //nopw 0x0(%rax,%rax,1)
+	0x48, 0x81, 0xea, 0x40, 0x42, 0x0f, 0x00, //sub $0xf4240,%rdx
+	0x48, 0x83, 0xc1, 0x01, //add $0x1,%rcx
+	0x48, 0x8d, 0x04, 0x16, //lea (%rsi,%rdx,1),%rax
+	0x48, 0x3d, 0x3f, 0x42, 0x0f, 0x00, //cmp $0xf423f,%rax
+	0x7f, 0xe9, //jg 58 <gettimeofday+0x58>
+	0x48, 0x85, 0xc0, //test %rax,%rax
+	0x79, 0x1f, //jns 93 <gettimeofday+0x93>
+	0x48, 0x8d, 0x94, 0x32, 0x40, 0x42, 0x0f, //lea 0xf4240(%rdx,%rsi,1),%rdx
+	0x00,                   //
+	0x0f, 0x1f, 0x40, 0x00, //nopl 0x0(%rax)
+	0x48, 0x89, 0xd0, //mov %rdx,%rax
+	0x48, 0x83, 0xe9, 0x01, //sub $0x1,%rcx
+	0x48, 0x81, 0xc2, 0x40, 0x42, 0x0f, 0x00, //add $0xf4240,%rdx
+	0x48, 0x85, 0xc0, //test %rax,%rax
+	0x78, 0xed, //js 80 <gettimeofday+0x80>
+	0x48, 0x01, 0x0f, //add %rcx,(%rdi)
+	0x48, 0x89, 0x47, 0x08, //mov %rax,0x8(%rdi)
+	0x44, 0x89, 0xc0, //mov %r8d,%eax
+	0xc3,       //ret
+	0x66, 0x90, //xchg %ax,%ax
+	// constant
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, //CLOCK_IDS_MASK
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, //TV_SEC_DELTA
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, //TV_NSEC_DELTA
+}
+
+var fakeTime = []byte{
+	0x48, 0x89, 0xfe, //mov %rdi,%rsi
+	0xb8, 0xc9, 0x00, 0x00, 0x00, //mov $0xc9,%eax
+	0x31, 0xff, //xor %edi,%edi
+	0x0f, 0x05, //syscall
+	0x48, 0x89, 0xc1, //mov %rax,%rcx
+	0xf6, 0x05, 0x3a, 0x00, 0x00, 0x00, 0x01, //testb $0x1,0x3a(%rip)
+	0x74, 0x29, //je 41 <time+0x41>
+	0x48, 0x8b, 0x3d, 0x41, 0x00, 0x00, 0x00, //mov 0x41(%rip),%rdi
+	0x48, 0xb8, 0xb3, 0x94, 0xd6, 0x26, 0xe8, //movabs $0x112e0be826d694b3,%rax
+	0x0b, 0x2e, 0x11, //
+	0x48, 0xf7, 0xef, //imul %rdi
+	0x48, 0xc1, 0xff, 0x3f, //sar $0x3f,%rdi
+	0x48, 0xc1, 0xfa, 0x1a, //sar $0x1a,%rdx
+	0x48, 0x29, 0xfa, //sub %rdi,%rdx
+	0x48, 0x03, 0x15, 0x1a, 0x00, 0x00, 0x00, //add 0x1a(%rip),%rdx
+	0x48, 0x01, 0xd1, //add %rdx,%rcx
+	0x48, 0x85, 0xf6, //test %rsi,%rsi
+	0x74, 0x03, //je 49 <time+0x49>
+	0x48, 0x89, 0x0e, //mov %rcx,(%rsi)
+	0x48, 0x89, 0xc8, //mov %rcx,%rax
+	0xc3,             //ret
+	0x0f, 0x1f, 0x00, //nopl (%rax)
+	// constant
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, //CLOCK_IDS_MASK
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, //TV_SEC_DELTA
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, //TV_NSEC_DELTA
+}
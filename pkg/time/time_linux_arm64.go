@@ -0,0 +1,101 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package time
+
+// vDSO on arm64 doesn't provide `time`, and libc implements it with
+// `clock_gettime` or `gettimeofday`.
+var fakeImages = []*fakeImage{
+	{
+		symbolName: "__kernel_clock_gettime",
+		content:    fakeClockGettime,
+	},
+	{
+		symbolName: "__kernel_gettimeofday",
+		content:    fakeGettimeofday,
+	},
+}
+
+var fakeClockGettime = []byte{
+	0xe9, 0x03, 0x00, 0x2a, //mov w9, w0
+	0x28, 0x0e, 0x80, 0xd2, //mov x8, #113
+	0x01, 0x00, 0x00, 0xd4, //svc #0
+	0x40, 0x03, 0x00, 0xb5, //cbnz x0, 0x74 <clock_gettime+0x74>
+	0x4a, 0x03, 0x00, 0x58, //ldr x10, 0x78 <CLOCK_IDS_MASK>
+	0x2b, 0x00, 0x80, 0xd2, //mov x11, #1
+	0x6b, 0x21, 0xc9, 0x9a, //lsl x11, x11, x9
+	0x5f, 0x01, 0x0b, 0xea, //tst x10, x11
+	0xa0, 0x02, 0x00, 0x54, //b.eq 0x74 <clock_gettime+0x74>
+	0xec, 0x02, 0x00, 0x58, //ldr x12, 0x80 <TV_SEC_DELTA>
+	0x0d, 0x03, 0x00, 0x58, //ldr x13, 0x88 <TV_NSEC_DELTA>
+	0x2e, 0x04, 0x40, 0xf9, //ldr x14, [x1, #8]
+	0xce, 0x01, 0x0d, 0x8b, //add x14, x14, x13
+	0x0f, 0x40, 0x99, 0xd2, //mov x15, #51712
+	0x4f, 0x73, 0xa7, 0xf2, //movk x15, #15258, lsl #16
+	0xdf, 0x01, 0x0f, 0xeb, //cmp x14, x15
+	0x8b, 0x00, 0x00, 0x54, //b.lt 0x50 <clock_gettime+0x50>
+	0xce, 0x01, 0x0f, 0xcb, //sub x14, x14, x15
+	0x8c, 0x05, 0x00, 0x91, //add x12, x12, #1
+	0xfc, 0xff, 0xff, 0x17, //b 0x3c <clock_gettime+0x3c>
+	0xdf, 0x01, 0x00, 0xf1, //cmp x14, #0
+	0x8a, 0x00, 0x00, 0x54, //b.ge 0x64 <clock_gettime+0x64>
+	0xce, 0x01, 0x0f, 0x8b, //add x14, x14, x15
+	0x8c, 0x05, 0x00, 0xd1, //sub x12, x12, #1
+	0xfc, 0xff, 0xff, 0x17, //b 0x50 <clock_gettime+0x50>
+	0x30, 0x00, 0x40, 0xf9, //ldr x16, [x1]
+	0x10, 0x02, 0x0c, 0x8b, //add x16, x16, x12
+	0x30, 0x00, 0x00, 0xf9, //str x16, [x1]
+	0x2e, 0x04, 0x00, 0xf9, //str x14, [x1, #8]
+	0xc0, 0x03, 0x5f, 0xd6, //ret
+	// constant
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, //CLOCK_IDS_MASK
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, //TV_SEC_DELTA
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, //TV_NSEC_DELTA
+}
+
+var fakeGettimeofday = []byte{
+	0xe9, 0x03, 0x00, 0xaa, //mov x9, x0
+	0x28, 0x15, 0x80, 0xd2, //mov x8, #169
+	0x01, 0x00, 0x00, 0xd4, //svc #0
+	0x40, 0x03, 0x00, 0xb5, //cbnz x0, 0x74 <gettimeofday+0x74>
+	0x29, 0x03, 0x00, 0xb4, //cbz x9, 0x74 <gettimeofday+0x74>
+	0x2a, 0x03, 0x00, 0x58, //ldr x10, 0x78 <CLOCK_IDS_MASK>
+	0xea, 0x02, 0x00, 0x36, //tbz w10, #0, 0x74 <gettimeofday+0x74>
+	0x2c, 0x03, 0x00, 0x58, //ldr x12, 0x80 <TV_SEC_DELTA>
+	0x4d, 0x03, 0x00, 0x58, //ldr x13, 0x88 <TV_NSEC_DELTA>
+	0x0b, 0x7d, 0x80, 0xd2, //mov x11, #1000
+	0xad, 0x0d, 0xcb, 0x9a, //sdiv x13, x13, x11
+	0x2e, 0x05, 0x40, 0xf9, //ldr x14, [x9, #8]
+	0xce, 0x01, 0x0d, 0x8b, //add x14, x14, x13
+	0x0f, 0x48, 0x88, 0xd2, //mov x15, #16960
+	0xef, 0x01, 0xa0, 0xf2, //movk x15, #15, lsl #16
+	0xdf, 0x01, 0x0f, 0xeb, //cmp x14, x15
+	0x8b, 0x00, 0x00, 0x54, //b.lt 0x50 <gettimeofday+0x50>
+	0xce, 0x01, 0x0f, 0xcb, //sub x14, x14, x15
+	0x8c, 0x05, 0x00, 0x91, //add x12, x12, #1
+	0xfc, 0xff, 0xff, 0x17, //b 0x3c <gettimeofday+0x3c>
+	0xdf, 0x01, 0x00, 0xf1, //cmp x14, #0
+	0x8a, 0x00, 0x00, 0x54, //b.ge 0x64 <gettimeofday+0x64>
+	0xce, 0x01, 0x0f, 0x8b, //add x14, x14, x15
+	0x8c, 0x05, 0x00, 0xd1, //sub x12, x12, #1
+	0xfc, 0xff, 0xff, 0x17, //b 0x50 <gettimeofday+0x50>
+	0x30, 0x01, 0x40, 0xf9, //ldr x16, [x9]
+	0x10, 0x02, 0x0c, 0x8b, //add x16, x16, x12
+	0x30, 0x01, 0x00, 0xf9, //str x16, [x9]
+	0x2e, 0x05, 0x00, 0xf9, //str x14, [x9, #8]
+	0xc0, 0x03, 0x5f, 0xd6, //ret
+	// constant
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, //CLOCK_IDS_MASK
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, //TV_SEC_DELTA
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, //TV_NSEC_DELTA
+}
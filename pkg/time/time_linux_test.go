@@ -15,6 +15,7 @@ package time
 
 import (
 	"os"
+	"runtime"
 	"testing"
 
 	"github.com/go-logr/zapr"
@@ -126,5 +127,50 @@ var _ = Describe("ModifyTime", func() {
 
 			Expect(newSec-sec).Should(BeNumerically(">=", 1), "sec %d newSec %d", sec, newSec)
 		})
+
+		It("should modify gettimeofday successfully", func() {
+			if runtime.GOARCH != "amd64" {
+				Skip("only amd64 calls gettimeofday in vDSO through syscall.Gettimeofday")
+			}
+			Expect(t).NotTo(BeNil())
+
+			now, err := t.GetTimeOfDay()
+			Expect(err).ShouldNot(HaveOccurred(), "error: %+v", err)
+
+			sec := now.Unix()
+
+			err = ModifyTime(t.Pid(), 10000, 0, 1)
+			Expect(err).ShouldNot(HaveOccurred(), "error: %+v", err)
+
+			newTime, err := t.GetTimeOfDay()
+			Expect(err).ShouldNot(HaveOccurred(), "error: %+v", err)
+
+			newSec := newTime.Unix()
+
+			Expect(newSec-sec).Should(BeNumerically(">=", 10000), "sec %d newSec %d", sec, newSec)
+		})
+
+		It("should not modify gettimeofday if CLOCK_REALTIME is not selected", func() {
+			if runtime.GOARCH != "amd64" {
+				Skip("only amd64 calls gettimeofday in vDSO through syscall.Gettimeofday")
+			}
+			Expect(t).NotTo(BeNil())
+
+			now, err := t.GetTimeOfDay()
+			Expect(err).ShouldNot(HaveOccurred(), "error: %+v", err)
+
+			sec := now.Unix()
+
+			// 2 is the mask of CLOCK_MONOTONIC
+			err = ModifyTime(t.Pid(), 10000, 0, 2)
+			Expect(err).ShouldNot(HaveOccurred(), "error: %+v", err)
+
+			newTime, err := t.GetTimeOfDay()
+			Expect(err).ShouldNot(HaveOccurred(), "error: %+v", err)
+
+			newSec := newTime.Unix()
+
+			Expect(newSec-sec).Should(BeNumerically("<", 10000), "sec %d newSec %d", sec, newSec)
+		})
 	})
 })
@@ -16,11 +16,13 @@ package utils
 import (
 	"context"
 	"math"
+	"time"
 
 	"google.golang.org/grpc"
 	v1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/chaos-mesh/chaos-mesh/controllers/common"
 	chaosdaemon "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
 	"github.com/chaos-mesh/chaos-mesh/pkg/mock"
 )
@@ -60,6 +62,34 @@ func NewChaosDaemonClient(ctx context.Context, c client.Client, pod *v1.Pod, por
 	}, nil
 }
 
+// NewChaosDaemonClientForNode would create ChaosDaemonClient for the chaos-daemon on given node
+func NewChaosDaemonClientForNode(ctx context.Context, c client.Client, nodeName string, port int) (ChaosDaemonClientInterface, error) {
+	if cli := mock.On("MockChaosDaemonClient"); cli != nil {
+		return cli.(ChaosDaemonClientInterface), nil
+	}
+	if err := mock.On("NewChaosDaemonClientError"); err != nil {
+		return nil, err.(error)
+	}
+
+	cc, err := CreateGrpcConnectionToNode(ctx, c, nodeName, port)
+	if err != nil {
+		return nil, err
+	}
+	return &GrpcChaosDaemonClient{
+		ChaosDaemonClient: chaosdaemon.NewChaosDaemonClient(cc),
+		conn:              cc,
+	}, nil
+}
+
+// LeaseDeadline returns the unix timestamp in seconds, after which chaos-daemon recovers
+// the injection by itself if the lease isn't renewed. It returns 0 if the lease is disabled.
+func LeaseDeadline() int64 {
+	if common.ControllerCfg.ChaosDaemonLeaseDuration <= 0 {
+		return 0
+	}
+	return time.Now().Add(common.ControllerCfg.ChaosDaemonLeaseDuration).Unix()
+}
+
 // MergeNetem merges two Netem protos into a new one.
 // REMEMBER to assign the return value, i.e. merged = utils.MergeNetm(merged, em)
 // For each field it takes the bigger value of the two.
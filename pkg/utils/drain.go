@@ -0,0 +1,81 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"context"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+const (
+	// instanceLabelKey is the label of the instance which the component belongs to
+	instanceLabelKey = "app.kubernetes.io/instance"
+	// chaosMeshInstance is the instance of the components of chaos mesh, which are never evicted
+	chaosMeshInstance = "chaos-mesh"
+)
+
+// SelectEvictablePods returns the pods on the node which are evicted by draining it. The finished pods,
+// the mirror pods, the pods of DaemonSets, the protected pods, the pods of chaos mesh and the pods in
+// the namespaces where chaos is not allowed are excluded.
+func SelectEvictablePods(ctx context.Context, c client.Client, r client.Reader, nodeName string) ([]v1.Pod, error) {
+	var podList v1.PodList
+	if err := r.List(ctx, &podList, &client.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.nodeName", nodeName),
+	}); err != nil {
+		return nil, err
+	}
+
+	var pods []v1.Pod
+	allowed := make(map[string]bool)
+	for _, pod := range podList.Items {
+		// the field selector is not supported by every reader, so the node of the pod is checked again
+		if pod.Spec.NodeName != nodeName || !isEvictable(&pod) {
+			continue
+		}
+
+		if _, ok := allowed[pod.Namespace]; !ok {
+			allowed[pod.Namespace] = pod.Namespace != v1alpha1.KubeSystemNamespace &&
+				IsAllowedNamespaces(ctx, c, pod.Namespace)
+		}
+		if !allowed[pod.Namespace] {
+			log.Info("skip evicting pod in namespace not allowed", "namespace", pod.Namespace, "name", pod.Name)
+			continue
+		}
+
+		pods = append(pods, pod)
+	}
+	return pods, nil
+}
+
+func isEvictable(pod *v1.Pod) bool {
+	if pod.Status.Phase == v1.PodSucceeded || pod.Status.Phase == v1.PodFailed {
+		return false
+	}
+	if _, ok := pod.Annotations[v1.MirrorPodAnnotationKey]; ok {
+		return false
+	}
+	if controller := metav1.GetControllerOf(pod); controller != nil && controller.Kind == "DaemonSet" {
+		return false
+	}
+	if IsProtected(pod.ObjectMeta) {
+		return false
+	}
+	return pod.Labels[instanceLabelKey] != chaosMeshInstance
+}
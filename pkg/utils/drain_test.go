@@ -0,0 +1,105 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/common"
+)
+
+func TestSelectEvictablePods(t *testing.T) {
+	newNamespace := func(name string, inject bool) *v1.Namespace {
+		ns := &v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		if inject {
+			ns.Annotations = map[string]string{v1alpha1.NamespaceInjectAnnotationKey: v1alpha1.NamespaceInjectEnabled}
+		}
+		return ns
+	}
+	newNodePod := func(name, namespace string, annotations, labels map[string]string) *v1.Pod {
+		pod := newPod(name, v1.PodRunning, namespace, annotations, labels, "node0")
+		return &pod
+	}
+
+	daemon := newNodePod("daemon", metav1.NamespaceDefault, nil, nil)
+	daemon.OwnerReferences = []metav1.OwnerReference{{Kind: "DaemonSet", Name: "daemon", Controller: func() *bool { b := true; return &b }()}}
+	finished := newNodePod("finished", metav1.NamespaceDefault, nil, nil)
+	finished.Status.Phase = v1.PodSucceeded
+
+	objects := []runtime.Object{
+		newNamespace(metav1.NamespaceDefault, true),
+		newNamespace("opt-out", false),
+		newNamespace(v1alpha1.KubeSystemNamespace, true),
+		newNodePod("web", metav1.NamespaceDefault, nil, nil),
+		newNodePod("web", "opt-out", nil, nil),
+		newNodePod("protected", metav1.NamespaceDefault, map[string]string{v1alpha1.ProtectedAnnotationKey: "true"}, nil),
+		newNodePod("mirror", metav1.NamespaceDefault, map[string]string{v1.MirrorPodAnnotationKey: "mirror"}, nil),
+		newNodePod("chaos-controller-manager", metav1.NamespaceDefault, nil, map[string]string{
+			"app.kubernetes.io/instance":  "chaos-mesh",
+			"app.kubernetes.io/component": "controller-manager",
+		}),
+		newNodePod("coredns", v1alpha1.KubeSystemNamespace, nil, nil),
+		daemon,
+		finished,
+	}
+	c := fake.NewFakeClient(objects...)
+
+	names := func(pods []v1.Pod) []string {
+		var names []string
+		for _, pod := range pods {
+			names = append(names, pod.Namespace+"/"+pod.Name)
+		}
+		return names
+	}
+
+	t.Run("skip protected pods and pods of chaos mesh and kube-system", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		pods, err := SelectEvictablePods(context.Background(), c, c, "node0")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(names(pods)).To(ConsistOf("default/web", "opt-out/web"))
+	})
+
+	t.Run("skip pods in ignored namespaces", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		common.ControllerCfg.IgnoredNamespaces = "opt-out"
+		defer func() {
+			common.ControllerCfg.IgnoredNamespaces = ""
+		}()
+		pods, err := SelectEvictablePods(context.Background(), c, c, "node0")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(names(pods)).To(ConsistOf("default/web"))
+	})
+
+	t.Run("skip pods in namespaces not opting in to chaos", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		common.ControllerCfg.EnableFilterNamespace = true
+		defer func() {
+			common.ControllerCfg.EnableFilterNamespace = false
+		}()
+		pods, err := SelectEvictablePods(context.Background(), c, c, "node0")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(names(pods)).To(ConsistOf("default/web"))
+	})
+}
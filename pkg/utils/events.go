@@ -29,4 +29,18 @@ const (
 
 	// The chaos just completed
 	EventChaosRecovered string = "ChaosRecovered"
+
+	// The chaos was stopped by a tripped kill switch. The message should include the reason
+	EventChaosStopped string = "ChaosStopped"
+
+	// The injected targets were rolled back after some targets failed to be injected.
+	// The message should include the injection error
+	EventChaosRolledBack string = "ChaosRolledBack"
+
+	// The chaos failed permanently after the retry limit was exceeded. The message should include the last error
+	EventChaosFailed string = "ChaosFailed"
+
+	// The chaos was previewed in the dry run mode. The message should include the number of the targets
+	// or why the chaos can't be applied
+	EventChaosDryRun string = "ChaosDryRun"
 )
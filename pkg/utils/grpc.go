@@ -33,7 +33,11 @@ var RPCTimeout = DefaultRPCTimeout
 
 // CreateGrpcConnection create a grpc connection with given port
 func CreateGrpcConnection(ctx context.Context, c client.Client, pod *v1.Pod, port int) (*grpc.ClientConn, error) {
-	nodeName := pod.Spec.NodeName
+	return CreateGrpcConnectionToNode(ctx, c, pod.Spec.NodeName, port)
+}
+
+// CreateGrpcConnectionToNode create a grpc connection to the chaos-daemon on given node
+func CreateGrpcConnectionToNode(ctx context.Context, c client.Client, nodeName string, port int) (*grpc.ClientConn, error) {
 	log.Info("Creating client to chaos-daemon", "node", nodeName)
 
 	var node v1.Node
@@ -0,0 +1,94 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// InjectionResults collects the results of the injections on the targets, it is safe for concurrent use
+type InjectionResults struct {
+	sync.Mutex
+	errors map[types.NamespacedName]error
+
+	policy *v1alpha1.FailurePolicy
+}
+
+// NewInjectionResults creates an empty InjectionResults, the failed injections are retried according to the policy
+func NewInjectionResults(policy *v1alpha1.FailurePolicy) *InjectionResults {
+	return &InjectionResults{
+		errors: make(map[types.NamespacedName]error),
+		policy: policy,
+	}
+}
+
+// Inject injects chaos into the target, and records the result. The failed injection is retried
+// with exponential backoff if the policy is RetryFailed.
+func (r *InjectionResults) Inject(namespace, name string, inject func() error) error {
+	backoff, err := r.policy.GetBackoff()
+	if err != nil {
+		backoff = v1alpha1.DefaultFailurePolicyBackoff
+	}
+
+	_ = wait.ExponentialBackoff(wait.Backoff{
+		Duration: backoff,
+		Factor:   2,
+		Steps:    int(r.policy.GetMaxRetries()) + 1,
+	}, func() (bool, error) {
+		err = inject()
+		return err == nil, nil
+	})
+	return r.Record(namespace, name, err)
+}
+
+// Record records the result of the injection on the target, and returns the error
+func (r *InjectionResults) Record(namespace, name string, err error) error {
+	r.Lock()
+	defer r.Unlock()
+
+	r.errors[types.NamespacedName{Namespace: namespace, Name: name}] = err
+	return err
+}
+
+// Fill fills the state, the error and the injected time of the records from the results,
+// the target without any failed result is taken as injected, and the target leased by other
+// experiments is queued with the Queue policy
+func (r *InjectionResults) Fill(records []v1alpha1.PodStatus) {
+	r.Lock()
+	defer r.Unlock()
+
+	now := metav1.NewTime(time.Now())
+	for i := range records {
+		record := &records[i]
+		if err := r.errors[types.NamespacedName{Namespace: record.Namespace, Name: record.Name}]; err != nil {
+			record.State = v1alpha1.TargetFailed
+			if IsTargetQueued(err) {
+				record.State = v1alpha1.TargetQueued
+			}
+			record.Error = err.Error()
+			record.InjectedAt = nil
+			continue
+		}
+		record.State = v1alpha1.TargetInjected
+		record.Error = ""
+		record.InjectedAt = &now
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func TestInjectionResults(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	results := NewInjectionResults(nil)
+	g.Expect(results.Record("default", "a", nil)).To(Succeed())
+	g.Expect(results.Record("default", "b", errors.New("failed"))).ToNot(Succeed())
+
+	records := []v1alpha1.PodStatus{
+		{Namespace: "default", Name: "a"},
+		{Namespace: "default", Name: "b"},
+		{Namespace: "default", Name: "c"},
+	}
+	results.Fill(records)
+
+	g.Expect(records[0].State).To(Equal(v1alpha1.TargetInjected))
+	g.Expect(records[0].InjectedAt).ToNot(BeNil())
+	g.Expect(records[1].State).To(Equal(v1alpha1.TargetFailed))
+	g.Expect(records[1].Error).To(Equal("failed"))
+	g.Expect(records[1].InjectedAt).To(BeNil())
+	g.Expect(records[2].State).To(Equal(v1alpha1.TargetInjected))
+}
+
+func TestInjectionResultsWithRetries(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	failures := func(n int, calls *int) func() error {
+		return func() error {
+			*calls++
+			if *calls <= n {
+				return errors.New("failed")
+			}
+			return nil
+		}
+	}
+
+	calls := 0
+	results := NewInjectionResults(nil)
+	g.Expect(results.Inject("default", "a", failures(1, &calls))).ToNot(Succeed())
+	g.Expect(calls).To(Equal(1))
+
+	maxRetries := int32(2)
+	policy := &v1alpha1.FailurePolicy{
+		Type:       v1alpha1.RetryFailedPolicy,
+		MaxRetries: &maxRetries,
+		Backoff:    "1ms",
+	}
+	results = NewInjectionResults(policy)
+
+	calls = 0
+	g.Expect(results.Inject("default", "a", failures(2, &calls))).To(Succeed())
+	g.Expect(calls).To(Equal(3))
+
+	calls = 0
+	g.Expect(results.Inject("default", "b", failures(3, &calls))).ToNot(Succeed())
+	g.Expect(calls).To(Equal(3))
+
+	records := []v1alpha1.PodStatus{
+		{Namespace: "default", Name: "a"},
+		{Namespace: "default", Name: "b"},
+	}
+	results.Fill(records)
+	g.Expect(records[0].State).To(Equal(v1alpha1.TargetInjected))
+	g.Expect(records[1].State).To(Equal(v1alpha1.TargetFailed))
+}
@@ -14,20 +14,224 @@
 package utils
 
 import (
+	"context"
 	"fmt"
+	"math/rand"
 	"time"
 
 	"github.com/robfig/cron/v3"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
 )
 
+// maxCalendarSkips is the max number of the scheduled times skipped by the calendar
+const maxCalendarSkips = 10000
+
+// NextTime returns the next scheduled time after now, the cron is evaluated in the time zone of the scheduler
 func NextTime(spec v1alpha1.SchedulerSpec, now time.Time) (*time.Time, error) {
 	scheduler, err := cron.ParseStandard(spec.Cron)
 	if err != nil {
 		return nil, fmt.Errorf("fail to parse runner rule %s, %v", spec.Cron, err)
 	}
+	loc, err := spec.Location()
+	if err != nil {
+		return nil, fmt.Errorf("fail to load time zone %s, %v", spec.TimeZone, err)
+	}
 
-	next := scheduler.Next(now)
+	next := scheduler.Next(now.In(loc))
 	return &next, nil
 }
+
+// NextAllowedTime returns the next scheduled time after now which is allowed by the calendar
+func NextAllowedTime(spec v1alpha1.SchedulerSpec, calendar *Calendar, now time.Time) (*time.Time, error) {
+	next, err := NextTime(spec, now)
+	if err != nil || calendar == nil {
+		return next, err
+	}
+	// the schedule like @every 1h has no fixed scheduled times, so it starts as soon as the calendar allows
+	scheduler, _ := cron.ParseStandard(spec.Cron)
+	_, isConstantDelay := scheduler.(cron.ConstantDelaySchedule)
+
+	for i := 0; i < maxCalendarSkips; i++ {
+		if allowed, _ := calendar.Allowed(*next); allowed {
+			return next, nil
+		}
+
+		candidate := calendar.nextCandidate(*next)
+		if candidate.IsZero() {
+			break
+		}
+		if isConstantDelay {
+			next = &candidate
+			continue
+		}
+		// the scheduled time exactly at the candidate is allowed too
+		if next, err = NextTime(spec, candidate.Add(-time.Nanosecond)); err != nil {
+			return nil, err
+		}
+	}
+
+	return nil, fmt.Errorf("no scheduled time of %s is allowed by the allowed windows and the blackouts", spec.Cron)
+}
+
+// Fire returns whether the chaos is started on the scheduled time according to the probability of the scheduler
+func Fire(spec v1alpha1.SchedulerSpec, rnd *rand.Rand) bool {
+	if spec.Probability == nil {
+		return true
+	}
+	return rnd.Int31n(100) < *spec.Probability
+}
+
+// RandomOffset returns a random offset within the jitter of the scheduler
+func RandomOffset(spec v1alpha1.SchedulerSpec, rnd *rand.Rand) (time.Duration, error) {
+	jitter, err := spec.GetJitter()
+	if err != nil {
+		return 0, fmt.Errorf("fail to parse jitter %s, %v", spec.Jitter, err)
+	}
+	if jitter == 0 {
+		return 0, nil
+	}
+	return time.Duration(rnd.Int63n(int64(jitter))), nil
+}
+
+// RandomDuration returns a random duration within the random duration range of the scheduler,
+// or the duration if the range is not set
+func RandomDuration(spec v1alpha1.SchedulerSpec, duration time.Duration, rnd *rand.Rand) (time.Duration, error) {
+	if spec.RandomDuration == nil {
+		return duration, nil
+	}
+	min, max, err := spec.RandomDuration.Parse()
+	if err != nil {
+		return 0, fmt.Errorf("fail to parse random duration %s-%s, %v", spec.RandomDuration.Min, spec.RandomDuration.Max, err)
+	}
+	return min + time.Duration(rnd.Int63n(int64(max-min)+1)), nil
+}
+
+// Calendar decides whether chaos is allowed to start at a time,
+// according to the allowed windows and the blackouts of the scheduler
+type Calendar struct {
+	location  *time.Location
+	windows   []calendarWindow
+	blackouts []calendarBlackout
+}
+
+type calendarWindow struct {
+	v1alpha1.TimeWindow
+	start time.Duration
+	end   time.Duration
+}
+
+type calendarBlackout struct {
+	name  string
+	start time.Time
+	end   time.Time
+}
+
+// NewCalendar creates the calendar of the scheduler, the blackouts defined in the ConfigMap are read from the namespace
+func NewCalendar(ctx context.Context, c client.Reader, namespace string, spec v1alpha1.SchedulerSpec) (*Calendar, error) {
+	loc, err := spec.Location()
+	if err != nil {
+		return nil, fmt.Errorf("fail to load time zone %s, %v", spec.TimeZone, err)
+	}
+	calendar := &Calendar{location: loc}
+
+	for _, window := range spec.AllowedWindows {
+		start, end, err := window.Parse()
+		if err != nil {
+			return nil, err
+		}
+		calendar.windows = append(calendar.windows, calendarWindow{TimeWindow: window, start: start, end: end})
+	}
+
+	blackouts := append([]v1alpha1.BlackoutPeriod{}, spec.Blackouts...)
+	if spec.BlackoutConfigMap != "" {
+		var configMap v1.ConfigMap
+		if err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: spec.BlackoutConfigMap}, &configMap); err != nil {
+			return nil, fmt.Errorf("fail to get blackout configmap %s/%s, %v", namespace, spec.BlackoutConfigMap, err)
+		}
+		for name, value := range configMap.Data {
+			blackouts = append(blackouts, v1alpha1.NewBlackoutPeriod(name, value))
+		}
+	}
+	for _, blackout := range blackouts {
+		start, end, err := blackout.Parse(loc)
+		if err != nil {
+			return nil, err
+		}
+		calendar.blackouts = append(calendar.blackouts, calendarBlackout{name: blackout.Name, start: start, end: end})
+	}
+
+	return calendar, nil
+}
+
+// Allowed returns whether chaos is allowed to start at the time, and the reason if it is not allowed
+func (c *Calendar) Allowed(t time.Time) (bool, string) {
+	for _, blackout := range c.blackouts {
+		if !t.Before(blackout.start) && t.Before(blackout.end) {
+			return false, fmt.Sprintf("in blackout %s", blackout.name)
+		}
+	}
+
+	if len(c.windows) > 0 && !c.inWindow(t) {
+		return false, "outside the allowed windows"
+	}
+
+	return true, ""
+}
+
+func (c *Calendar) inWindow(t time.Time) bool {
+	local := t.In(c.location)
+	for _, window := range c.windows {
+		if !window.Includes(local.Weekday()) {
+			continue
+		}
+		start, end := window.on(local)
+		if !local.Before(start) && local.Before(end) {
+			return true
+		}
+	}
+	return false
+}
+
+// nextCandidate returns the earliest time after t which may be allowed, or zero if there is no such time
+func (c *Calendar) nextCandidate(t time.Time) time.Time {
+	for _, blackout := range c.blackouts {
+		if !t.Before(blackout.start) && t.Before(blackout.end) {
+			return blackout.end
+		}
+	}
+
+	if len(c.windows) == 0 || c.inWindow(t) {
+		return t
+	}
+
+	// the windows repeat every week, so the next start is within eight days
+	local := t.In(c.location)
+	var next time.Time
+	for days := 0; days <= 7; days++ {
+		day := local.AddDate(0, 0, days)
+		for _, window := range c.windows {
+			if !window.Includes(day.Weekday()) {
+				continue
+			}
+			start, _ := window.on(day)
+			if start.After(local) && (next.IsZero() || start.Before(next)) {
+				next = start
+			}
+		}
+	}
+	return next
+}
+
+// on returns the start and the end of the window on the day,
+// they are built from the date so that the daylight saving time is respected
+func (w *calendarWindow) on(day time.Time) (time.Time, time.Time) {
+	year, month, date := day.Date()
+	timeOf := func(offset time.Duration) time.Time {
+		return time.Date(year, month, date, int(offset/time.Hour), int(offset%time.Hour/time.Minute), 0, 0, day.Location())
+	}
+	return timeOf(w.start), timeOf(w.end)
+}
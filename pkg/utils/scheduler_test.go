@@ -0,0 +1,180 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func TestNextTimeInTimeZone(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	next, err := NextTime(v1alpha1.SchedulerSpec{Cron: "0 10 * * *", TimeZone: "Europe/Berlin"},
+		time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC))
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(next.Equal(time.Date(2020, 6, 1, 10, 0, 0, 0, berlin))).Should(BeTrue(), "next: %s", next)
+
+	_, err = NextTime(v1alpha1.SchedulerSpec{Cron: "0 10 * * *", TimeZone: "Mars/Olympus"}, time.Now())
+	g.Expect(err).Should(HaveOccurred())
+}
+
+func TestCalendar(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	spec := v1alpha1.SchedulerSpec{
+		Cron:     "@every 1h",
+		TimeZone: "Europe/Berlin",
+		AllowedWindows: []v1alpha1.TimeWindow{{
+			Days:  []v1alpha1.Weekday{"Mon", "Tue", "Wed", "Thu", "Fri"},
+			Start: "10:00",
+			End:   "16:00",
+		}},
+		Blackouts: []v1alpha1.BlackoutPeriod{{Name: "release-freeze", Start: "2020-06-03", End: "2020-06-04"}},
+	}
+	calendar, err := NewCalendar(context.TODO(), nil, "default", spec)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	// 2020-06-01 is a Monday
+	allowed, _ := calendar.Allowed(time.Date(2020, 6, 1, 12, 0, 0, 0, berlin))
+	g.Expect(allowed).Should(BeTrue())
+
+	allowed, reason := calendar.Allowed(time.Date(2020, 6, 1, 16, 0, 0, 0, berlin))
+	g.Expect(allowed).Should(BeFalse())
+	g.Expect(reason).Should(Equal("outside the allowed windows"))
+
+	allowed, _ = calendar.Allowed(time.Date(2020, 6, 6, 12, 0, 0, 0, berlin))
+	g.Expect(allowed).Should(BeFalse())
+
+	allowed, reason = calendar.Allowed(time.Date(2020, 6, 4, 23, 0, 0, 0, berlin))
+	g.Expect(allowed).Should(BeFalse())
+	g.Expect(reason).Should(Equal("in blackout release-freeze"))
+
+	// the window is evaluated in the time zone of the scheduler
+	allowed, _ = calendar.Allowed(time.Date(2020, 6, 5, 8, 30, 0, 0, time.UTC))
+	g.Expect(allowed).Should(BeTrue())
+
+	// skip the evening of Tuesday and the blackout, to the window of Friday
+	next, err := NextAllowedTime(spec, calendar, time.Date(2020, 6, 2, 15, 30, 0, 0, berlin))
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(next.Equal(time.Date(2020, 6, 5, 10, 0, 0, 0, berlin))).Should(BeTrue(), "next: %s", next)
+
+	next, err = NextAllowedTime(spec, calendar, time.Date(2020, 6, 1, 11, 0, 0, 0, berlin))
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(next.Equal(time.Date(2020, 6, 1, 12, 0, 0, 0, berlin))).Should(BeTrue(), "next: %s", next)
+}
+
+func TestCalendarWithoutAllowedTime(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	spec := v1alpha1.SchedulerSpec{
+		Cron:           "0 20 * * *",
+		TimeZone:       "UTC",
+		AllowedWindows: []v1alpha1.TimeWindow{{Start: "10:00", End: "16:00"}},
+	}
+	calendar, err := NewCalendar(context.TODO(), nil, "default", spec)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	_, err = NextAllowedTime(spec, calendar, time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC))
+	g.Expect(err).Should(HaveOccurred())
+}
+
+func TestCalendarWithBlackoutConfigMap(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	c := fake.NewFakeClient(&v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "holidays"},
+		Data: map[string]string{
+			"christmas":   "2020-12-24/2020-12-26",
+			"maintenance": "2020-12-30T08:00:00Z/2020-12-30T12:00:00Z",
+		},
+	})
+	spec := v1alpha1.SchedulerSpec{Cron: "@every 1h", TimeZone: "UTC", BlackoutConfigMap: "holidays"}
+
+	calendar, err := NewCalendar(context.TODO(), c, "default", spec)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	allowed, reason := calendar.Allowed(time.Date(2020, 12, 26, 23, 0, 0, 0, time.UTC))
+	g.Expect(allowed).Should(BeFalse())
+	g.Expect(reason).Should(Equal("in blackout christmas"))
+
+	allowed, _ = calendar.Allowed(time.Date(2020, 12, 27, 0, 0, 0, 0, time.UTC))
+	g.Expect(allowed).Should(BeTrue())
+
+	allowed, reason = calendar.Allowed(time.Date(2020, 12, 30, 9, 0, 0, 0, time.UTC))
+	g.Expect(allowed).Should(BeFalse())
+	g.Expect(reason).Should(Equal("in blackout maintenance"))
+
+	// the calendar fails closed if the configmap is missing
+	_, err = NewCalendar(context.TODO(), c, "other", spec)
+	g.Expect(err).Should(HaveOccurred())
+}
+
+func TestRandomizer(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	rnd := rand.New(rand.NewSource(0))
+	half := int32(50)
+	spec := v1alpha1.SchedulerSpec{
+		Cron:           "@hourly",
+		Jitter:         "10m",
+		Probability:    &half,
+		RandomDuration: &v1alpha1.DurationRange{Min: "1m", Max: "5m"},
+	}
+
+	fired := 0
+	for i := 0; i < 1000; i++ {
+		if Fire(spec, rnd) {
+			fired++
+		}
+
+		offset, err := RandomOffset(spec, rnd)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(offset).Should(BeNumerically(">=", 0))
+		g.Expect(offset).Should(BeNumerically("<", 10*time.Minute))
+
+		duration, err := RandomDuration(spec, time.Hour, rnd)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(duration).Should(BeNumerically(">=", time.Minute))
+		g.Expect(duration).Should(BeNumerically("<=", 5*time.Minute))
+	}
+	g.Expect(fired).Should(BeNumerically("~", 500, 100))
+
+	// the scheduler without randomization always fires on time with the duration
+	spec = v1alpha1.SchedulerSpec{Cron: "@hourly"}
+	g.Expect(Fire(spec, rnd)).Should(BeTrue())
+
+	offset, err := RandomOffset(spec, rnd)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(offset).Should(BeZero())
+
+	duration, err := RandomDuration(spec, time.Minute, rnd)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(duration).Should(Equal(time.Minute))
+}
@@ -17,11 +17,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"hash/fnv"
 	"math"
 	"math/rand"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
 	"github.com/chaos-mesh/chaos-mesh/controllers/common"
@@ -30,22 +33,22 @@ import (
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/apimachinery/pkg/types"
 )
 
-type SelectSpec interface {
-	GetSelector() v1alpha1.SelectorSpec
-	GetMode() v1alpha1.PodMode
-	GetValue() string
-}
+// SelectSpec describes how the targets of chaos are selected
+type SelectSpec = v1alpha1.SelectSpec
 
-// SelectAndFilterPVC returns the list of PersistentVolumeClaims filtered by Selector and Mode
-func SelectAndFilterPVC(ctx context.Context, c client.Client, r client.Reader, spec SelectSpec) ([]v1.PersistentVolumeClaim, error) {
+// SelectAndFilterPVC returns the list of PersistentVolumeClaims filtered by Selector and Mode,
+// the random selection is determined by the seed
+func SelectAndFilterPVC(ctx context.Context, c client.Client, r client.Reader, spec SelectSpec, seed int64) ([]v1.PersistentVolumeClaim, error) {
 	if pvcs := mock.On("MockSelectAndFilterPVC"); pvcs != nil {
 		return pvcs.(func() []v1.PersistentVolumeClaim)(), nil
 	}
@@ -63,12 +66,23 @@ func SelectAndFilterPVC(ctx context.Context, c client.Client, r client.Reader, s
 		return nil, err
 	}
 
+	if selector.Exclude != nil {
+		excludedClaims, err := SelectPersistentVolumeClaims(ctx, c, r, *selector.Exclude)
+		if err != nil {
+			return nil, err
+		}
+		pvcs = excludeVolumeClaims(pvcs, excludedClaims)
+	}
+
 	if len(pvcs) == 0 {
 		err = errors.New("no volume claim is selected")
 		return nil, err
 	}
 
-	filteredClaims, err := filterVolumeClaimsByMode(pvcs, v1alpha1.VolumeMode(mode), value)
+	sort.Slice(pvcs, func(i, j int) bool {
+		return namespacedNameLess(pvcs[i].ObjectMeta, pvcs[j].ObjectMeta)
+	})
+	filteredClaims, err := filterVolumeClaimsByMode(pvcs, v1alpha1.VolumeMode(mode), value, rand.New(rand.NewSource(seed)))
 	if err != nil {
 		return nil, err
 	}
@@ -76,8 +90,9 @@ func SelectAndFilterPVC(ctx context.Context, c client.Client, r client.Reader, s
 	return filteredClaims, nil
 }
 
-// SelectAndFilterPV returns the list of PersistentVolumes filtered by Selector and Mode
-func SelectAndFilterPV(ctx context.Context, c client.Client, r client.Reader, spec SelectSpec) ([]v1.PersistentVolume, error) {
+// SelectAndFilterPV returns the list of PersistentVolumes filtered by Selector and Mode,
+// the random selection is determined by the seed
+func SelectAndFilterPV(ctx context.Context, c client.Client, r client.Reader, spec SelectSpec, seed int64) ([]v1.PersistentVolume, error) {
 	if volumes := mock.On("MockSelectAndFilterPV"); volumes != nil {
 		return volumes.(func() []v1.PersistentVolume)(), nil
 	}
@@ -94,12 +109,23 @@ func SelectAndFilterPV(ctx context.Context, c client.Client, r client.Reader, sp
 		return nil, err
 	}
 
+	if selector.Exclude != nil {
+		excludedVolumes, err := SelectPersistentVolumes(ctx, c, r, *selector.Exclude)
+		if err != nil {
+			return nil, err
+		}
+		volumes = excludeVolumes(volumes, excludedVolumes)
+	}
+
 	if len(volumes) == 0 {
 		err = errors.New("no volume is selected")
 		return nil, err
 	}
 
-	filteredVolumes, err := filterVolumesByMode(volumes, v1alpha1.VolumeMode(mode), value)
+	sort.Slice(volumes, func(i, j int) bool {
+		return namespacedNameLess(volumes[i].ObjectMeta, volumes[j].ObjectMeta)
+	})
+	filteredVolumes, err := filterVolumesByMode(volumes, v1alpha1.VolumeMode(mode), value, rand.New(rand.NewSource(seed)))
 	if err != nil {
 		return nil, err
 	}
@@ -107,8 +133,9 @@ func SelectAndFilterPV(ctx context.Context, c client.Client, r client.Reader, sp
 	return filteredVolumes, nil
 }
 
-// SelectAndFilterPods returns the list of pods that filtered by selector and PodMode
-func SelectAndFilterPods(ctx context.Context, c client.Client, r client.Reader, spec SelectSpec) ([]v1.Pod, error) {
+// SelectAndFilterPods returns the list of pods that filtered by selector and PodMode,
+// the random selection is determined by the seed
+func SelectAndFilterPods(ctx context.Context, c client.Client, r client.Reader, spec SelectSpec, seed int64) ([]v1.Pod, error) {
 	if pods := mock.On("MockSelectAndFilterPods"); pods != nil {
 		return pods.(func() []v1.Pod)(), nil
 	}
@@ -120,7 +147,7 @@ func SelectAndFilterPods(ctx context.Context, c client.Client, r client.Reader,
 	mode := spec.GetMode()
 	value := spec.GetValue()
 
-	pods, err := SelectPods(ctx, c, r, selector)
+	pods, err := SelectCandidatePods(ctx, c, r, selector)
 	if err != nil {
 		return nil, err
 	}
@@ -130,7 +157,21 @@ func SelectAndFilterPods(ctx context.Context, c client.Client, r client.Reader,
 		return nil, err
 	}
 
-	filteredPod, err := filterPodsByMode(pods, v1alpha1.PodMode(mode), value)
+	// sort the candidates so that the same seed always selects the same pods
+	sort.Slice(pods, func(i, j int) bool {
+		return namespacedNameLess(pods[i].ObjectMeta, pods[j].ObjectMeta)
+	})
+	rnd := rand.New(rand.NewSource(seed))
+	var filteredPod []v1.Pod
+	if mode.IsTopologyMode() {
+		var nodeList v1.NodeList
+		if err := c.List(ctx, &nodeList); err != nil {
+			return nil, err
+		}
+		filteredPod, err = filterPodsByTopologyMode(pods, nodeList.Items, mode, value, rnd)
+	} else {
+		filteredPod, err = filterPodsByMode(pods, mode, value, rnd)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -138,6 +179,133 @@ func SelectAndFilterPods(ctx context.Context, c client.Client, r client.Reader,
 	return filteredPod, nil
 }
 
+// SelectCandidatePods returns the pods matching the selector and not matching its exclude selector,
+// the mode is not applied to them
+func SelectCandidatePods(ctx context.Context, c client.Client, r client.Reader, selector v1alpha1.SelectorSpec) ([]v1.Pod, error) {
+	pods, err := SelectPods(ctx, c, r, selector)
+	if err != nil {
+		return nil, err
+	}
+
+	if selector.Exclude != nil {
+		excludedPods, err := SelectPods(ctx, c, r, *selector.Exclude)
+		if err != nil {
+			return nil, err
+		}
+		pods = excludePods(pods, excludedPods)
+	}
+
+	return pods, nil
+}
+
+// ErrNodesNotClusterScoped is returned when nodes are selected while chaos mesh is not cluster scoped
+var ErrNodesNotClusterScoped = errors.New("nodes can only be selected when chaos mesh is cluster scoped")
+
+// SelectAndFilterNodes returns the list of nodes filtered by Selector and Mode,
+// the random selection is determined by the seed
+func SelectAndFilterNodes(ctx context.Context, c client.Client, spec SelectSpec, seed int64) ([]v1.Node, error) {
+	if nodes := mock.On("MockSelectAndFilterNodes"); nodes != nil {
+		return nodes.(func() []v1.Node)(), nil
+	}
+	if err := mock.On("MockSelectedAndFilterNodesError"); err != nil {
+		return nil, err.(error)
+	}
+
+	selector := spec.GetSelector()
+	mode := spec.GetMode()
+	value := spec.GetValue()
+
+	nodes, err := SelectNodes(ctx, c, selector)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(nodes) == 0 {
+		err = errors.New("no node is selected")
+		return nil, err
+	}
+
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].Name < nodes[j].Name
+	})
+	filteredNodes, err := filterNodesByMode(nodes, mode, value, rand.New(rand.NewSource(seed)))
+	if err != nil {
+		return nil, err
+	}
+
+	return filteredNodes, nil
+}
+
+// SelectNodes returns the list of nodes that are available for node chaos.
+// The nodes specified by name and the nodes matching the label selectors are combined,
+// then filtered by the annotation selectors.
+func SelectNodes(ctx context.Context, c client.Client, selector v1alpha1.SelectorSpec) ([]v1.Node, error) {
+	// nodes are shared by all namespaces, so they can't be selected in the namespace scoped mode
+	if !common.ControllerCfg.ClusterScoped {
+		return nil, ErrNodesNotClusterScoped
+	}
+
+	var nodes []v1.Node
+	for _, name := range selector.Nodes {
+		var node v1.Node
+		err := c.Get(ctx, types.NamespacedName{
+			Name: name,
+		}, &node)
+		if err == nil {
+			nodes = append(nodes, node)
+			continue
+		}
+
+		if apierrors.IsNotFound(err) {
+			log.Error(err, "Node is not found", "node name", name)
+			continue
+		}
+
+		return nil, err
+	}
+
+	if len(selector.NodeSelectors) > 0 || len(selector.LabelSelectors) > 0 || len(selector.ExpressionSelectors) > 0 {
+		matchLabels := make(map[string]string)
+		for k, v := range selector.NodeSelectors {
+			matchLabels[k] = v
+		}
+		for k, v := range selector.LabelSelectors {
+			matchLabels[k] = v
+		}
+		labelSelector, err := metav1.LabelSelectorAsSelector(&metav1.LabelSelector{
+			MatchLabels:      matchLabels,
+			MatchExpressions: selector.ExpressionSelectors,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		var nodeList v1.NodeList
+		if err := c.List(ctx, &nodeList, &client.ListOptions{LabelSelector: labelSelector}); err != nil {
+			return nil, err
+		}
+
+		// if both setting Nodes and label selectors, the node list will be combined.
+		selected := make(map[string]struct{})
+		for _, node := range nodes {
+			selected[node.Name] = struct{}{}
+		}
+		for _, node := range nodeList.Items {
+			if _, ok := selected[node.Name]; !ok {
+				nodes = append(nodes, node)
+			}
+		}
+	}
+
+	annotationsSelector, err := parseSelector(label.Label(selector.AnnotationSelectors).String())
+	if err != nil {
+		return nil, err
+	}
+	nodes = filterNodesByAnnotations(nodes, annotationsSelector)
+
+	return filterProtectedNodes(nodes), nil
+}
+
 // SelectPersistentVolumes returns the list of persistent volumes that are available for
 // persistent volume chaos
 // It returns all persistent volumes that match the configured label, annotation and namespace selector
@@ -165,13 +333,17 @@ func SelectPersistentVolumes(ctx context.Context, c client.Client, r client.Read
 			return nil, err
 		}
 
-		return pvs, nil
+		return filterProtectedVolumes(pvs), nil
 	}
 	var pvList v1.PersistentVolumeList
 
 	var listOptions = client.ListOptions{}
-	if len(selector.LabelSelectors) > 0 {
-		listOptions.LabelSelector = labels.SelectorFromSet(selector.LabelSelectors)
+	if len(selector.LabelSelectors) > 0 || len(selector.ExpressionSelectors) > 0 {
+		labelSelector, err := parseLabelSelector(selector)
+		if err != nil {
+			return nil, err
+		}
+		listOptions.LabelSelector = labelSelector
 
 		if err := c.List(ctx, &pvList, &listOptions); err != nil {
 			return nil, err
@@ -184,7 +356,7 @@ func SelectPersistentVolumes(ctx context.Context, c client.Client, r client.Read
 	}
 	pvs = filterPvsByAnnotations(pvs, annotationsSelector)
 
-	return pvs, nil
+	return filterProtectedVolumes(pvs), nil
 }
 
 // SelectPersistentVolumeClaims returns the list of persistent volumes that are available for
@@ -202,7 +374,7 @@ func SelectPersistentVolumeClaims(ctx context.Context, c client.Client, r client
 					continue
 				}
 			}
-			if !IsAllowedNamespaces(ns) {
+			if !IsAllowedNamespaces(ctx, c, ns) {
 				log.Info("filter pvcs by namespaces", "namespace", ns)
 				continue
 			}
@@ -226,7 +398,7 @@ func SelectPersistentVolumeClaims(ctx context.Context, c client.Client, r client
 			}
 		}
 
-		return pvcs, nil
+		return filterProtectedVolumeClaims(pvcs), nil
 	}
 
 	if !common.ControllerCfg.ClusterScoped {
@@ -245,8 +417,12 @@ func SelectPersistentVolumeClaims(ctx context.Context, c client.Client, r client
 	if !common.ControllerCfg.ClusterScoped {
 		listOptions.Namespace = common.ControllerCfg.TargetNamespace
 	}
-	if len(selector.LabelSelectors) > 0 {
-		listOptions.LabelSelector = labels.SelectorFromSet(selector.LabelSelectors)
+	if len(selector.LabelSelectors) > 0 || len(selector.ExpressionSelectors) > 0 {
+		labelSelector, err := parseLabelSelector(selector)
+		if err != nil {
+			return nil, err
+		}
+		listOptions.LabelSelector = labelSelector
 	}
 	if len(selector.FieldSelectors) > 0 {
 		// Since FieldSelectors need to implement index creation, Reader.List is used to get the pod list.
@@ -262,7 +438,7 @@ func SelectPersistentVolumeClaims(ctx context.Context, c client.Client, r client
 	}
 	pvcs = append(pvcs, pvcList.Items...)
 
-	pvcs = filterPvcsByNamespaces(pvcs)
+	pvcs = filterPvcsByNamespaces(ctx, c, pvcs)
 
 	namespaceSelector, err := parseSelector(strings.Join(selector.Namespaces, ","))
 	if err != nil {
@@ -278,7 +454,7 @@ func SelectPersistentVolumeClaims(ctx context.Context, c client.Client, r client
 		return nil, err
 	}
 	pvcs = filterPvcsByAnnotations(pvcs, annotationsSelector)
-	return pvcs, nil
+	return filterProtectedVolumeClaims(pvcs), nil
 }
 
 // SelectPods returns the list of pods that are available for pod chaos action.
@@ -297,7 +473,7 @@ func SelectPods(ctx context.Context, c client.Client, r client.Reader, selector
 					continue
 				}
 			}
-			if !IsAllowedNamespaces(ns) {
+			if !IsAllowedNamespaces(ctx, c, ns) {
 				log.Info("filter pod by namespaces", "namespace", ns)
 				continue
 			}
@@ -321,7 +497,7 @@ func SelectPods(ctx context.Context, c client.Client, r client.Reader, selector
 			}
 		}
 
-		return pods, nil
+		return filterProtectedPods(pods), nil
 	}
 
 	if !common.ControllerCfg.ClusterScoped {
@@ -334,28 +510,42 @@ func SelectPods(ctx context.Context, c client.Client, r client.Reader, selector
 		}
 	}
 
-	var podList v1.PodList
-
 	var listOptions = client.ListOptions{}
 	if !common.ControllerCfg.ClusterScoped {
 		listOptions.Namespace = common.ControllerCfg.TargetNamespace
 	}
-	if len(selector.LabelSelectors) > 0 {
-		listOptions.LabelSelector = labels.SelectorFromSet(selector.LabelSelectors)
+	if len(selector.LabelSelectors) > 0 || len(selector.ExpressionSelectors) > 0 {
+		labelSelector, err := parseLabelSelector(selector)
+		if err != nil {
+			return nil, err
+		}
+		listOptions.LabelSelector = labelSelector
 	}
 	if len(selector.FieldSelectors) > 0 {
-		// Since FieldSelectors need to implement index creation, Reader.List is used to get the pod list.
 		listOptions.FieldSelector = fields.SelectorFromSet(selector.FieldSelectors)
-		if err := r.List(ctx, &podList, &listOptions); err != nil {
+	}
+
+	if len(selector.Workloads) > 0 || len(selector.Components) > 0 {
+		workloadPods, err := selectPodsByWorkloads(ctx, c, r, selector.Workloads, listOptions)
+		if err != nil {
 			return nil, err
 		}
+		pods = append(pods, workloadPods...)
+
+		componentPods, err := selectPodsByComponents(ctx, c, r, selector.Components, listOptions)
+		if err != nil {
+			return nil, err
+		}
+		pods = excludePods(pods, componentPods)
+		pods = append(pods, componentPods...)
 	} else {
-		// Otherwise, just call Client.List directly, which can be obtained through cache.
-		if err := c.List(ctx, &podList, &listOptions); err != nil {
+		var podList v1.PodList
+		if err := listPods(ctx, c, r, &podList, &listOptions); err != nil {
 			return nil, err
 		}
+		pods = append(pods, podList.Items...)
 	}
-	pods = append(pods, podList.Items...)
+
 	var (
 		nodes           []v1.Node
 		nodeList        v1.NodeList
@@ -384,15 +574,18 @@ func SelectPods(ctx context.Context, c client.Client, r client.Reader, selector
 		}
 		pods = filterPodByNode(pods, nodes)
 	}
-	pods = filterByNamespaces(pods)
+	pods = filterByNamespaces(ctx, c, pods)
 
-	namespaceSelector, err := parseSelector(strings.Join(selector.Namespaces, ","))
-	if err != nil {
-		return nil, err
-	}
-	pods, err = filterByNamespaceSelector(pods, namespaceSelector)
-	if err != nil {
-		return nil, err
+	// the namespaces of workloads and components take the place of the namespace selector
+	if len(selector.Workloads) == 0 && len(selector.Components) == 0 {
+		namespaceSelector, err := parseSelector(strings.Join(selector.Namespaces, ","))
+		if err != nil {
+			return nil, err
+		}
+		pods, err = filterByNamespaceSelector(pods, namespaceSelector)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	annotationsSelector, err := parseSelector(label.Label(selector.AnnotationSelectors).String())
@@ -410,12 +603,27 @@ func SelectPods(ctx context.Context, c client.Client, r client.Reader, selector
 		return nil, err
 	}
 
-	return pods, nil
+	return filterProtectedPods(pods), nil
 }
 
 // CheckPodMeetSelector checks if this pod meets the selection criteria.
 // TODO: support to check fieldsSelector
 func CheckPodMeetSelector(pod v1.Pod, selector v1alpha1.SelectorSpec) (bool, error) {
+	if IsProtected(pod.ObjectMeta) {
+		return false, nil
+	}
+
+	if selector.Exclude != nil {
+		excluded, err := CheckPodMeetSelector(pod, *selector.Exclude)
+		if err != nil {
+			return false, err
+		}
+
+		if excluded {
+			return false, nil
+		}
+	}
+
 	if len(selector.Pods) > 0 {
 		meet := false
 		for ns, names := range selector.Pods {
@@ -444,24 +652,49 @@ func CheckPodMeetSelector(pod v1.Pod, selector v1alpha1.SelectorSpec) (bool, err
 		selector.LabelSelectors = make(map[string]string)
 	}
 
-	if len(selector.LabelSelectors) > 0 {
-		ls := labels.SelectorFromSet(selector.LabelSelectors)
-		podLabels := labels.Set(pod.Labels)
-		if len(pod.Labels) == 0 || !ls.Matches(podLabels) {
+	if len(selector.LabelSelectors) > 0 || len(selector.ExpressionSelectors) > 0 {
+		ls, err := parseLabelSelector(selector)
+		if err != nil {
+			return false, err
+		}
+		if !ls.Matches(labels.Set(pod.Labels)) {
 			return false, nil
 		}
 	}
 
-	pods := []v1.Pod{pod}
+	if len(selector.Workloads) > 0 || len(selector.Components) > 0 {
+		owned := false
+		for _, workload := range selector.Workloads {
+			if IsPodOwnedByWorkload(pod, workload) {
+				owned = true
+				break
+			}
+		}
+		for _, component := range selector.Components {
+			if IsPodOfComponent(pod, component) {
+				owned = true
+				break
+			}
+		}
 
-	namespaceSelector, err := parseSelector(strings.Join(selector.Namespaces, ","))
-	if err != nil {
-		return false, err
+		if !owned {
+			return false, nil
+		}
 	}
 
-	pods, err = filterByNamespaceSelector(pods, namespaceSelector)
-	if err != nil {
-		return false, err
+	pods := []v1.Pod{pod}
+
+	// the namespaces of workloads and components take the place of the namespace selector
+	if len(selector.Workloads) == 0 && len(selector.Components) == 0 {
+		namespaceSelector, err := parseSelector(strings.Join(selector.Namespaces, ","))
+		if err != nil {
+			return false, err
+		}
+
+		pods, err = filterByNamespaceSelector(pods, namespaceSelector)
+		if err != nil {
+			return false, err
+		}
 	}
 
 	annotationsSelector, err := parseSelector(label.Label(selector.AnnotationSelectors).String())
@@ -487,6 +720,284 @@ func CheckPodMeetSelector(pod v1.Pod, selector v1alpha1.SelectorSpec) (bool, err
 	return false, nil
 }
 
+// parseLabelSelector combines the LabelSelectors and ExpressionSelectors into one label selector
+func parseLabelSelector(selector v1alpha1.SelectorSpec) (labels.Selector, error) {
+	return metav1.LabelSelectorAsSelector(&metav1.LabelSelector{
+		MatchLabels:      selector.LabelSelectors,
+		MatchExpressions: selector.ExpressionSelectors,
+	})
+}
+
+// NewSeed returns the seed if it is set, otherwise returns a new random seed
+func NewSeed(seed *int64) int64 {
+	if seed != nil {
+		return *seed
+	}
+	return time.Now().UnixNano()
+}
+
+// TargetSeed derives the seed used to select the targets of a chaos from the seed of its sources,
+// so that the two selections are still reproducible but not the same when the selectors overlap
+func TargetSeed(seed int64) int64 {
+	hasher := fnv.New64a()
+	_, _ = hasher.Write([]byte(fmt.Sprintf("%d/target", seed)))
+	return int64(hasher.Sum64())
+}
+
+func namespacedNameLess(a, b metav1.ObjectMeta) bool {
+	if a.Namespace != b.Namespace {
+		return a.Namespace < b.Namespace
+	}
+	return a.Name < b.Name
+}
+
+// IsProtected checks if the object is protected from being selected by any chaos
+func IsProtected(meta metav1.ObjectMeta) bool {
+	return meta.Annotations[v1alpha1.ProtectedAnnotationKey] == "true"
+}
+
+func filterProtectedPods(pods []v1.Pod) []v1.Pod {
+	var filteredList []v1.Pod
+	for _, pod := range pods {
+		if IsProtected(pod.ObjectMeta) {
+			log.Info("skip protected pod", "namespace", pod.Namespace, "name", pod.Name)
+			continue
+		}
+		filteredList = append(filteredList, pod)
+	}
+	return filteredList
+}
+
+func filterProtectedVolumes(pvs []v1.PersistentVolume) []v1.PersistentVolume {
+	var filteredList []v1.PersistentVolume
+	for _, pv := range pvs {
+		if IsProtected(pv.ObjectMeta) {
+			log.Info("skip protected pv", "name", pv.Name)
+			continue
+		}
+		filteredList = append(filteredList, pv)
+	}
+	return filteredList
+}
+
+func filterProtectedVolumeClaims(pvcs []v1.PersistentVolumeClaim) []v1.PersistentVolumeClaim {
+	var filteredList []v1.PersistentVolumeClaim
+	for _, pvc := range pvcs {
+		if IsProtected(pvc.ObjectMeta) {
+			log.Info("skip protected pvc", "namespace", pvc.Namespace, "name", pvc.Name)
+			continue
+		}
+		filteredList = append(filteredList, pvc)
+	}
+	return filteredList
+}
+
+func filterProtectedNodes(nodes []v1.Node) []v1.Node {
+	var filteredList []v1.Node
+	for _, node := range nodes {
+		if IsProtected(node.ObjectMeta) {
+			log.Info("skip protected node", "name", node.Name)
+			continue
+		}
+		filteredList = append(filteredList, node)
+	}
+	return filteredList
+}
+
+// excludePods removes the excluded pods from the pods
+func excludePods(pods []v1.Pod, excludedPods []v1.Pod) []v1.Pod {
+	excluded := make(map[types.NamespacedName]struct{})
+	for _, pod := range excludedPods {
+		excluded[types.NamespacedName{Namespace: pod.Namespace, Name: pod.Name}] = struct{}{}
+	}
+
+	var filteredList []v1.Pod
+	for _, pod := range pods {
+		if _, ok := excluded[types.NamespacedName{Namespace: pod.Namespace, Name: pod.Name}]; !ok {
+			filteredList = append(filteredList, pod)
+		}
+	}
+	return filteredList
+}
+
+// excludeVolumes removes the excluded persistent volumes from the persistent volumes
+func excludeVolumes(pvs []v1.PersistentVolume, excludedVolumes []v1.PersistentVolume) []v1.PersistentVolume {
+	excluded := make(map[string]struct{})
+	for _, pv := range excludedVolumes {
+		excluded[pv.Name] = struct{}{}
+	}
+
+	var filteredList []v1.PersistentVolume
+	for _, pv := range pvs {
+		if _, ok := excluded[pv.Name]; !ok {
+			filteredList = append(filteredList, pv)
+		}
+	}
+	return filteredList
+}
+
+// excludeVolumeClaims removes the excluded persistent volume claims from the persistent volume claims
+func excludeVolumeClaims(pvcs []v1.PersistentVolumeClaim, excludedClaims []v1.PersistentVolumeClaim) []v1.PersistentVolumeClaim {
+	excluded := make(map[types.NamespacedName]struct{})
+	for _, pvc := range excludedClaims {
+		excluded[types.NamespacedName{Namespace: pvc.Namespace, Name: pvc.Name}] = struct{}{}
+	}
+
+	var filteredList []v1.PersistentVolumeClaim
+	for _, pvc := range pvcs {
+		if _, ok := excluded[types.NamespacedName{Namespace: pvc.Namespace, Name: pvc.Name}]; !ok {
+			filteredList = append(filteredList, pvc)
+		}
+	}
+	return filteredList
+}
+
+// listPods lists pods with the list options
+func listPods(ctx context.Context, c client.Client, r client.Reader, podList *v1.PodList, listOptions *client.ListOptions) error {
+	if listOptions.FieldSelector != nil {
+		// Since FieldSelectors need to implement index creation, Reader.List is used to get the pod list.
+		return r.List(ctx, podList, listOptions)
+	}
+
+	// Otherwise, just call Client.List directly, which can be obtained through cache.
+	return c.List(ctx, podList, listOptions)
+}
+
+// selectPodsByWorkloads returns the pods owned by the workloads
+func selectPodsByWorkloads(ctx context.Context, c client.Client, r client.Reader, workloads []v1alpha1.WorkloadSelector, listOptions client.ListOptions) ([]v1.Pod, error) {
+	var pods []v1.Pod
+	selected := make(map[types.NamespacedName]struct{})
+
+	for _, workload := range workloads {
+		if !common.ControllerCfg.ClusterScoped {
+			if common.ControllerCfg.TargetNamespace != workload.Namespace {
+				log.Info("skip namespace because ns is out of scope within namespace scoped mode", "namespace", workload.Namespace)
+				continue
+			}
+		}
+		if !IsAllowedNamespaces(ctx, c, workload.Namespace) {
+			log.Info("filter workload by namespaces", "namespace", workload.Namespace)
+			continue
+		}
+
+		var podList v1.PodList
+		workloadListOptions := listOptions
+		workloadListOptions.Namespace = workload.Namespace
+		if err := listPods(ctx, c, r, &podList, &workloadListOptions); err != nil {
+			return nil, err
+		}
+
+		for _, pod := range podList.Items {
+			key := types.NamespacedName{Namespace: pod.Namespace, Name: pod.Name}
+			if _, ok := selected[key]; ok {
+				continue
+			}
+
+			if IsPodOwnedByWorkload(pod, workload) {
+				selected[key] = struct{}{}
+				pods = append(pods, pod)
+			}
+		}
+	}
+
+	return pods, nil
+}
+
+// selectPodsByComponents selects the pods of the native components of Kubernetes,
+// they are listed from the kube-system namespace only if the namespace is in scope and allowed
+func selectPodsByComponents(ctx context.Context, c client.Client, r client.Reader, components []v1alpha1.KubeComponent, listOptions client.ListOptions) ([]v1.Pod, error) {
+	if len(components) == 0 {
+		return nil, nil
+	}
+
+	if !common.ControllerCfg.ClusterScoped {
+		if common.ControllerCfg.TargetNamespace != v1alpha1.KubeSystemNamespace {
+			log.Info("skip namespace because ns is out of scope within namespace scoped mode", "namespace", v1alpha1.KubeSystemNamespace)
+			return nil, nil
+		}
+	}
+	if !IsAllowedNamespaces(ctx, c, v1alpha1.KubeSystemNamespace) {
+		log.Info("filter components by namespaces", "namespace", v1alpha1.KubeSystemNamespace)
+		return nil, nil
+	}
+
+	var podList v1.PodList
+	componentListOptions := listOptions
+	componentListOptions.Namespace = v1alpha1.KubeSystemNamespace
+	if err := listPods(ctx, c, r, &podList, &componentListOptions); err != nil {
+		return nil, err
+	}
+
+	var pods []v1.Pod
+	for _, pod := range podList.Items {
+		for _, component := range components {
+			if IsPodOfComponent(pod, component) {
+				pods = append(pods, pod)
+				break
+			}
+		}
+	}
+
+	return pods, nil
+}
+
+// IsPodOfComponent checks if the pod belongs to the native component of Kubernetes.
+// The static components are matched by the name of their mirror pods instead of labels,
+// and the other components are matched by the workloads owning their pods.
+func IsPodOfComponent(pod v1.Pod, component v1alpha1.KubeComponent) bool {
+	if pod.Namespace != v1alpha1.KubeSystemNamespace {
+		return false
+	}
+
+	if component.IsStaticPod() {
+		if _, ok := pod.Annotations[v1.MirrorPodAnnotationKey]; !ok {
+			return false
+		}
+		return pod.Name == string(component)+"-"+pod.Spec.NodeName
+	}
+
+	if workload := component.Workload(); workload != nil {
+		return IsPodOwnedByWorkload(pod, *workload)
+	}
+
+	return false
+}
+
+// IsPodOwnedByWorkload checks if the pod is owned by the workload according to its owner references.
+// Pods of a Deployment are owned by a ReplicaSet named `<deployment>-<pod-template-hash>`,
+// and pods of a StatefulSet are named `<statefulset>-<ordinal>`.
+func IsPodOwnedByWorkload(pod v1.Pod, workload v1alpha1.WorkloadSelector) bool {
+	if workload.Namespace != "" && pod.Namespace != workload.Namespace {
+		return false
+	}
+
+	owner := metav1.GetControllerOf(&pod)
+	if owner == nil {
+		return false
+	}
+
+	switch workload.Kind {
+	case v1alpha1.DeploymentWorkload:
+		hash, ok := pod.Labels[appsv1.DefaultDeploymentUniqueLabelKey]
+		return ok && owner.Kind == string(v1alpha1.ReplicaSetWorkload) && owner.Name == workload.Name+"-"+hash
+	case v1alpha1.StatefulSetWorkload:
+		if owner.Kind != string(workload.Kind) || owner.Name != workload.Name {
+			return false
+		}
+		if workload.Ordinals == nil {
+			return true
+		}
+
+		ordinal, err := strconv.ParseInt(strings.TrimPrefix(pod.Name, workload.Name+"-"), 10, 32)
+		if err != nil {
+			return false
+		}
+		return workload.Ordinals.Contains(int32(ordinal))
+	default:
+		return owner.Kind == string(workload.Kind) && owner.Name == workload.Name
+	}
+}
+
 func filterPodByNode(pods []v1.Pod, nodes []v1.Node) []v1.Pod {
 	if len(nodes) == 0 {
 		return nil
@@ -503,14 +1014,14 @@ func filterPodByNode(pods []v1.Pod, nodes []v1.Node) []v1.Pod {
 }
 
 // filterPodsByMode filters pods by mode from pod list
-func filterPodsByMode(pods []v1.Pod, mode v1alpha1.PodMode, value string) ([]v1.Pod, error) {
+func filterPodsByMode(pods []v1.Pod, mode v1alpha1.PodMode, value string, rnd *rand.Rand) ([]v1.Pod, error) {
 	if len(pods) == 0 {
 		return nil, errors.New("cannot generate pods from empty list")
 	}
 
 	switch mode {
 	case v1alpha1.OnePodMode:
-		index := rand.Intn(len(pods))
+		index := rnd.Intn(len(pods))
 		pod := pods[index]
 
 		return []v1.Pod{pod}, nil
@@ -530,7 +1041,7 @@ func filterPodsByMode(pods []v1.Pod, mode v1alpha1.PodMode, value string) ([]v1.
 			return nil, errors.New("cannot select any pod as value below or equal 0")
 		}
 
-		return getFixedSubListFromPodList(pods, num), nil
+		return getFixedSubListFromPodList(pods, num, rnd), nil
 	case v1alpha1.FixedPercentPodMode:
 		percentage, err := strconv.Atoi(value)
 		if err != nil {
@@ -547,7 +1058,7 @@ func filterPodsByMode(pods []v1.Pod, mode v1alpha1.PodMode, value string) ([]v1.
 
 		num := int(math.Floor(float64(len(pods)) * float64(percentage) / 100))
 
-		return getFixedSubListFromPodList(pods, num), nil
+		return getFixedSubListFromPodList(pods, num, rnd), nil
 	case v1alpha1.RandomMaxPercentPodMode:
 		maxPercentage, err := strconv.Atoi(value)
 		if err != nil {
@@ -562,24 +1073,215 @@ func filterPodsByMode(pods []v1.Pod, mode v1alpha1.PodMode, value string) ([]v1.
 			return nil, fmt.Errorf("fixed percentage value of %d is invalid, Must be [0-100]", maxPercentage)
 		}
 
-		percentage := rand.Intn(maxPercentage + 1) // + 1 because Intn works with half open interval [0,n) and we want [0,n]
+		percentage := rnd.Intn(maxPercentage + 1) // + 1 because Intn works with half open interval [0,n) and we want [0,n]
 		num := int(math.Floor(float64(len(pods)) * float64(percentage) / 100))
 
-		return getFixedSubListFromPodList(pods, num), nil
+		return getFixedSubListFromPodList(pods, num, rnd), nil
 	default:
 		return nil, fmt.Errorf("mode %s not supported", mode)
 	}
 }
 
-// filterPodsByMode filters pods by mode from pod list
-func filterVolumesByMode(pvs []v1.PersistentVolume, mode v1alpha1.VolumeMode, value string) ([]v1.PersistentVolume, error) {
+// filterPodsByTopologyMode filters pods by the topology mode from pod list,
+// the zone of a pod is read from the labels of its node
+func filterPodsByTopologyMode(pods []v1.Pod, nodes []v1.Node, mode v1alpha1.PodMode, value string, rnd *rand.Rand) ([]v1.Pod, error) {
+	if len(pods) == 0 {
+		return nil, errors.New("cannot generate pods from empty list")
+	}
+
+	switch mode {
+	case v1alpha1.OnePerZonePodMode:
+		zones, groups := groupPodsByZone(pods, nodes)
+		if len(zones) == 0 {
+			return nil, errors.New("cannot select any pod as no pod is on a node with zone label")
+		}
+
+		var filteredPods []v1.Pod
+		for _, zone := range zones {
+			group := groups[zone]
+			filteredPods = append(filteredPods, group[rnd.Intn(len(group))])
+		}
+
+		return filteredPods, nil
+	case v1alpha1.AllInOneZonePodMode:
+		zones, groups := groupPodsByZone(pods, nodes)
+		if len(zones) == 0 {
+			return nil, errors.New("cannot select any pod as no pod is on a node with zone label")
+		}
+
+		return groups[zones[rnd.Intn(len(zones))]], nil
+	case v1alpha1.FixedSpreadNodesPodMode:
+		num, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, err
+		}
+
+		if num <= 0 {
+			return nil, errors.New("cannot select any pod as value below or equal 0")
+		}
+
+		return getSpreadSubListFromPodList(pods, num, rnd), nil
+	default:
+		return nil, fmt.Errorf("mode %s not supported", mode)
+	}
+}
+
+// groupPodsByZone groups pods by the zones of their nodes, and returns the sorted zones with the groups.
+// Pods on the nodes without zone label are ignored.
+func groupPodsByZone(pods []v1.Pod, nodes []v1.Node) ([]string, map[string][]v1.Pod) {
+	nodeZones := make(map[string]string, len(nodes))
+	for _, node := range nodes {
+		if zone := getNodeZone(node); zone != "" {
+			nodeZones[node.Name] = zone
+		}
+	}
+
+	var zones []string
+	groups := make(map[string][]v1.Pod)
+	for _, pod := range pods {
+		zone, ok := nodeZones[pod.Spec.NodeName]
+		if !ok {
+			continue
+		}
+
+		if _, ok := groups[zone]; !ok {
+			zones = append(zones, zone)
+		}
+		groups[zone] = append(groups[zone], pod)
+	}
+	sort.Strings(zones)
+
+	return zones, groups
+}
+
+// getNodeZone returns the zone of the node, the deprecated beta label is used as a fallback
+func getNodeZone(node v1.Node) string {
+	if zone, ok := node.Labels[v1.LabelZoneFailureDomainStable]; ok {
+		return zone
+	}
+	return node.Labels[v1.LabelZoneFailureDomain]
+}
+
+// getSpreadSubListFromPodList selects `num` pods from the pod list and spreads them across nodes
+// as evenly as possible, it takes one pod from each node in turn.
+func getSpreadSubListFromPodList(pods []v1.Pod, num int, rnd *rand.Rand) []v1.Pod {
+	var nodeNames []string
+	groups := make(map[string][]v1.Pod)
+	for _, pod := range pods {
+		if _, ok := groups[pod.Spec.NodeName]; !ok {
+			nodeNames = append(nodeNames, pod.Spec.NodeName)
+		}
+		groups[pod.Spec.NodeName] = append(groups[pod.Spec.NodeName], pod)
+	}
+	// shuffle in a stable order so that the same seed always selects the same pods
+	sort.Strings(nodeNames)
+	for _, nodeName := range nodeNames {
+		group := groups[nodeName]
+		rnd.Shuffle(len(group), func(i, j int) {
+			group[i], group[j] = group[j], group[i]
+		})
+	}
+	rnd.Shuffle(len(nodeNames), func(i, j int) {
+		nodeNames[i], nodeNames[j] = nodeNames[j], nodeNames[i]
+	})
+
+	var filteredPods []v1.Pod
+	for round := 0; len(filteredPods) < num; round++ {
+		selected := false
+		for _, nodeName := range nodeNames {
+			group := groups[nodeName]
+			if round >= len(group) || len(filteredPods) >= num {
+				continue
+			}
+			filteredPods = append(filteredPods, group[round])
+			selected = true
+		}
+
+		if !selected {
+			break
+		}
+	}
+
+	return filteredPods
+}
+
+// filterNodesByMode filters nodes by mode from node list
+func filterNodesByMode(nodes []v1.Node, mode v1alpha1.PodMode, value string, rnd *rand.Rand) ([]v1.Node, error) {
+	if len(nodes) == 0 {
+		return nil, errors.New("cannot generate nodes from empty list")
+	}
+
+	switch mode {
+	case v1alpha1.OnePodMode:
+		index := rnd.Intn(len(nodes))
+		node := nodes[index]
+
+		return []v1.Node{node}, nil
+	case v1alpha1.AllPodMode:
+		return nodes, nil
+	case v1alpha1.FixedPodMode:
+		num, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(nodes) < num {
+			num = len(nodes)
+		}
+
+		if num <= 0 {
+			return nil, errors.New("cannot select any node as value below or equal 0")
+		}
+
+		return getFixedSubListFromNodeList(nodes, num, rnd), nil
+	case v1alpha1.FixedPercentPodMode:
+		percentage, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, err
+		}
+
+		if percentage == 0 {
+			return nil, errors.New("cannot select any node as value below or equal 0")
+		}
+
+		if percentage < 0 || percentage > 100 {
+			return nil, fmt.Errorf("fixed percentage value of %d is invalid, Must be (0,100]", percentage)
+		}
+
+		num := int(math.Floor(float64(len(nodes)) * float64(percentage) / 100))
+
+		return getFixedSubListFromNodeList(nodes, num, rnd), nil
+	case v1alpha1.RandomMaxPercentPodMode:
+		maxPercentage, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, err
+		}
+
+		if maxPercentage == 0 {
+			return nil, errors.New("cannot select any node as value below or equal 0")
+		}
+
+		if maxPercentage < 0 || maxPercentage > 100 {
+			return nil, fmt.Errorf("fixed percentage value of %d is invalid, Must be [0-100]", maxPercentage)
+		}
+
+		percentage := rnd.Intn(maxPercentage + 1) // + 1 because Intn works with half open interval [0,n) and we want [0,n]
+		num := int(math.Floor(float64(len(nodes)) * float64(percentage) / 100))
+
+		return getFixedSubListFromNodeList(nodes, num, rnd), nil
+	default:
+		return nil, fmt.Errorf("mode %s not supported", mode)
+	}
+}
+
+func filterVolumesByMode(pvs []v1.PersistentVolume, mode v1alpha1.VolumeMode, value string, rnd *rand.Rand) ([]v1.PersistentVolume, error) {
 	if len(pvs) == 0 {
 		return nil, errors.New("cannot generate persistent volumes from empty list")
 	}
 
 	switch mode {
 	case v1alpha1.OneVolumeMode:
-		index := rand.Intn(len(pvs))
+		index := rnd.Intn(len(pvs))
 		pv := pvs[index]
 
 		return []v1.PersistentVolume{pv}, nil
@@ -599,7 +1301,7 @@ func filterVolumesByMode(pvs []v1.PersistentVolume, mode v1alpha1.VolumeMode, va
 			return nil, errors.New("cannot select any persistent volume as value below or equal 0")
 		}
 
-		return getFixedSubListFromPvList(pvs, num), nil
+		return getFixedSubListFromPvList(pvs, num, rnd), nil
 	case v1alpha1.FixedPercentVolumeMode:
 		percentage, err := strconv.Atoi(value)
 		if err != nil {
@@ -616,7 +1318,7 @@ func filterVolumesByMode(pvs []v1.PersistentVolume, mode v1alpha1.VolumeMode, va
 
 		num := int(math.Floor(float64(len(pvs)) * float64(percentage) / 100))
 
-		return getFixedSubListFromPvList(pvs, num), nil
+		return getFixedSubListFromPvList(pvs, num, rnd), nil
 	case v1alpha1.RandomMaxPercentVolumeMode:
 		maxPercentage, err := strconv.Atoi(value)
 		if err != nil {
@@ -631,24 +1333,24 @@ func filterVolumesByMode(pvs []v1.PersistentVolume, mode v1alpha1.VolumeMode, va
 			return nil, fmt.Errorf("fixed percentage value of %d is invalid, Must be [0-100]", maxPercentage)
 		}
 
-		percentage := rand.Intn(maxPercentage + 1) // + 1 because Intn works with half open interval [0,n) and we want [0,n]
+		percentage := rnd.Intn(maxPercentage + 1) // + 1 because Intn works with half open interval [0,n) and we want [0,n]
 		num := int(math.Floor(float64(len(pvs)) * float64(percentage) / 100))
 
-		return getFixedSubListFromPvList(pvs, num), nil
+		return getFixedSubListFromPvList(pvs, num, rnd), nil
 	default:
 		return nil, fmt.Errorf("mode %s not supported", mode)
 	}
 }
 
 // filterPodsByMode filters pods by mode from pod list
-func filterVolumeClaimsByMode(pvcs []v1.PersistentVolumeClaim, mode v1alpha1.VolumeMode, value string) ([]v1.PersistentVolumeClaim, error) {
+func filterVolumeClaimsByMode(pvcs []v1.PersistentVolumeClaim, mode v1alpha1.VolumeMode, value string, rnd *rand.Rand) ([]v1.PersistentVolumeClaim, error) {
 	if len(pvcs) == 0 {
 		return nil, errors.New("cannot generate persistent volumes from empty list")
 	}
 
 	switch mode {
 	case v1alpha1.OneVolumeMode:
-		index := rand.Intn(len(pvcs))
+		index := rnd.Intn(len(pvcs))
 		pvc := pvcs[index]
 
 		return []v1.PersistentVolumeClaim{pvc}, nil
@@ -668,7 +1370,7 @@ func filterVolumeClaimsByMode(pvcs []v1.PersistentVolumeClaim, mode v1alpha1.Vol
 			return nil, errors.New("cannot select any persistent volume as value below or equal 0")
 		}
 
-		return getFixedSubListFromPvcList(pvcs, num), nil
+		return getFixedSubListFromPvcList(pvcs, num, rnd), nil
 	case v1alpha1.FixedPercentVolumeMode:
 		percentage, err := strconv.Atoi(value)
 		if err != nil {
@@ -685,7 +1387,7 @@ func filterVolumeClaimsByMode(pvcs []v1.PersistentVolumeClaim, mode v1alpha1.Vol
 
 		num := int(math.Floor(float64(len(pvcs)) * float64(percentage) / 100))
 
-		return getFixedSubListFromPvcList(pvcs, num), nil
+		return getFixedSubListFromPvcList(pvcs, num, rnd), nil
 	case v1alpha1.RandomMaxPercentVolumeMode:
 		maxPercentage, err := strconv.Atoi(value)
 		if err != nil {
@@ -700,10 +1402,10 @@ func filterVolumeClaimsByMode(pvcs []v1.PersistentVolumeClaim, mode v1alpha1.Vol
 			return nil, fmt.Errorf("fixed percentage value of %d is invalid, Must be [0-100]", maxPercentage)
 		}
 
-		percentage := rand.Intn(maxPercentage + 1) // + 1 because Intn works with half open interval [0,n) and we want [0,n]
+		percentage := rnd.Intn(maxPercentage + 1) // + 1 because Intn works with half open interval [0,n) and we want [0,n]
 		num := int(math.Floor(float64(len(pvcs)) * float64(percentage) / 100))
 
-		return getFixedSubListFromPvcList(pvcs, num), nil
+		return getFixedSubListFromPvcList(pvcs, num, rnd), nil
 	default:
 		return nil, fmt.Errorf("mode %s not supported", mode)
 	}
@@ -775,6 +1477,27 @@ func filterPvsByAnnotations(pvs []v1.PersistentVolume, annotations labels.Select
 	return filteredList
 }
 
+func filterNodesByAnnotations(nodes []v1.Node, annotations labels.Selector) []v1.Node {
+	// empty filter returns original list
+	if annotations.Empty() {
+		return nodes
+	}
+
+	var filteredList []v1.Node
+
+	for _, node := range nodes {
+		// convert the node's annotations to an equivalent label selector
+		selector := labels.Set(node.Annotations)
+
+		// include node if its annotations match the selector
+		if annotations.Matches(selector) {
+			filteredList = append(filteredList, node)
+		}
+	}
+
+	return filteredList
+}
+
 // filterByPhaseSet filters a list of pods by a given PodPhase selector.
 func filterByPhaseSelector(pods []v1.Pod, phases labels.Selector) ([]v1.Pod, error) {
 	if phases.Empty() {
@@ -828,11 +1551,11 @@ func filterByPhaseSelector(pods []v1.Pod, phases labels.Selector) ([]v1.Pod, err
 	return filteredList, nil
 }
 
-func filterByNamespaces(pods []v1.Pod) []v1.Pod {
+func filterByNamespaces(ctx context.Context, c client.Client, pods []v1.Pod) []v1.Pod {
 	var filteredList []v1.Pod
 
 	for _, pod := range pods {
-		if IsAllowedNamespaces(pod.Namespace) {
+		if IsAllowedNamespaces(ctx, c, pod.Namespace) {
 			filteredList = append(filteredList, pod)
 		} else {
 			log.Info("filter pod by namespaces",
@@ -842,11 +1565,11 @@ func filterByNamespaces(pods []v1.Pod) []v1.Pod {
 	return filteredList
 }
 
-func filterPvcsByNamespaces(pvcs []v1.PersistentVolumeClaim) []v1.PersistentVolumeClaim {
+func filterPvcsByNamespaces(ctx context.Context, c client.Client, pvcs []v1.PersistentVolumeClaim) []v1.PersistentVolumeClaim {
 	var filteredList []v1.PersistentVolumeClaim
 
 	for _, pvc := range pvcs {
-		if IsAllowedNamespaces(pvc.Namespace) {
+		if IsAllowedNamespaces(ctx, c, pvc.Namespace) {
 			filteredList = append(filteredList, pvc)
 		} else {
 			log.Info("filter pod by namespaces",
@@ -856,8 +1579,14 @@ func filterPvcsByNamespaces(pvcs []v1.PersistentVolumeClaim) []v1.PersistentVolu
 	return filteredList
 }
 
-// IsAllowedNamespaces returns whether namespace allows the execution of a chaos task
-func IsAllowedNamespaces(namespace string) bool {
+// IsAllowedNamespaces returns whether namespace allows the execution of a chaos task.
+// If the namespace filter is enabled, the namespace is required to be annotated with
+// `chaos-mesh.org/inject=enabled` besides matching the allowed and ignored namespaces.
+func IsAllowedNamespaces(ctx context.Context, c client.Client, namespace string) bool {
+	if common.ControllerCfg.EnableFilterNamespace && !IsNamespaceInjectEnabled(ctx, c, namespace) {
+		return false
+	}
+
 	if common.ControllerCfg.AllowedNamespaces != "" {
 		matched, err := regexp.MatchString(common.ControllerCfg.AllowedNamespaces, namespace)
 		if err != nil {
@@ -877,6 +1606,27 @@ func IsAllowedNamespaces(namespace string) bool {
 	return true
 }
 
+// IsNamespaceInjectEnabled checks whether the namespace opts in to chaos with the annotation
+func IsNamespaceInjectEnabled(ctx context.Context, c client.Client, namespace string) bool {
+	var ns v1.Namespace
+	if err := c.Get(ctx, types.NamespacedName{Name: namespace}, &ns); err != nil {
+		log.Error(err, "failed to get namespace", "namespace", namespace)
+		return false
+	}
+
+	return ns.Annotations[v1alpha1.NamespaceInjectAnnotationKey] == v1alpha1.NamespaceInjectEnabled
+}
+
+// NamespaceChecker checks the namespaces selected by the chaos in the validating webhooks
+type NamespaceChecker struct {
+	client.Client
+}
+
+// IsInjectEnabled implements v1alpha1.NamespaceChecker
+func (c *NamespaceChecker) IsInjectEnabled(ctx context.Context, namespace string) bool {
+	return IsNamespaceInjectEnabled(ctx, c.Client, namespace)
+}
+
 // filterByNamespaceSelector filters a list of pods by a given namespace selector.
 func filterByNamespaceSelector(pods []v1.Pod, namespaces labels.Selector) ([]v1.Pod, error) {
 	// empty filter returns original list
@@ -1003,8 +1753,8 @@ func parseSelector(str string) (labels.Selector, error) {
 	return selector, nil
 }
 
-func getFixedSubListFromPodList(pods []v1.Pod, num int) []v1.Pod {
-	indexes := RandomFixedIndexes(0, uint(len(pods)), uint(num))
+func getFixedSubListFromPodList(pods []v1.Pod, num int, rnd *rand.Rand) []v1.Pod {
+	indexes := randomFixedIndexes(rnd.Intn, 0, uint(len(pods)), uint(num))
 
 	var filteredPods []v1.Pod
 
@@ -1016,8 +1766,8 @@ func getFixedSubListFromPodList(pods []v1.Pod, num int) []v1.Pod {
 	return filteredPods
 }
 
-func getFixedSubListFromPvList(pvs []v1.PersistentVolume, num int) []v1.PersistentVolume {
-	indexes := RandomFixedIndexes(0, uint(len(pvs)), uint(num))
+func getFixedSubListFromPvList(pvs []v1.PersistentVolume, num int, rnd *rand.Rand) []v1.PersistentVolume {
+	indexes := randomFixedIndexes(rnd.Intn, 0, uint(len(pvs)), uint(num))
 
 	var filteredPvs []v1.PersistentVolume
 
@@ -1029,8 +1779,21 @@ func getFixedSubListFromPvList(pvs []v1.PersistentVolume, num int) []v1.Persiste
 	return filteredPvs
 }
 
-func getFixedSubListFromPvcList(pvs []v1.PersistentVolumeClaim, num int) []v1.PersistentVolumeClaim {
-	indexes := RandomFixedIndexes(0, uint(len(pvs)), uint(num))
+func getFixedSubListFromNodeList(nodes []v1.Node, num int, rnd *rand.Rand) []v1.Node {
+	indexes := randomFixedIndexes(rnd.Intn, 0, uint(len(nodes)), uint(num))
+
+	var filteredNodes []v1.Node
+
+	for _, index := range indexes {
+		index := index
+		filteredNodes = append(filteredNodes, nodes[index])
+	}
+
+	return filteredNodes
+}
+
+func getFixedSubListFromPvcList(pvs []v1.PersistentVolumeClaim, num int, rnd *rand.Rand) []v1.PersistentVolumeClaim {
+	indexes := randomFixedIndexes(rnd.Intn, 0, uint(len(pvs)), uint(num))
 
 	var filteredPvs []v1.PersistentVolumeClaim
 
@@ -1045,6 +1808,12 @@ func getFixedSubListFromPvcList(pvs []v1.PersistentVolumeClaim, num int) []v1.Pe
 // RandomFixedIndexes returns the `count` random indexes between `start` and `end`.
 // [start, end)
 func RandomFixedIndexes(start, end, count uint) []uint {
+	return randomFixedIndexes(rand.Intn, start, end, count)
+}
+
+// randomFixedIndexes returns the `count` random indexes between `start` and `end`
+// generated by `intn`.
+func randomFixedIndexes(intn func(int) int, start, end, count uint) []uint {
 	var indexes []uint
 	m := make(map[uint]uint, count)
 
@@ -1061,7 +1830,7 @@ func RandomFixedIndexes(start, end, count uint) []uint {
 	}
 
 	for i := 0; i < int(count); {
-		index := uint(rand.Intn(int(end-start))) + start
+		index := uint(intn(int(end-start))) + start
 
 		_, exist := m[index]
 		if exist {
@@ -16,6 +16,8 @@ package utils
 import (
 	"context"
 	"fmt"
+	"math/rand"
+	"strings"
 	"testing"
 
 	. "github.com/onsi/gomega"
@@ -91,6 +93,25 @@ func TestSelectPods(t *testing.T) {
 			},
 			expectedPods: nil,
 		},
+		{
+			name: "filter pods by expression selectors",
+			selector: v1alpha1.SelectorSpec{
+				ExpressionSelectors: v1alpha1.LabelSelectorRequirements{
+					{Key: "l1", Operator: metav1.LabelSelectorOpExists},
+				},
+			},
+			expectedPods: []v1.Pod{pods[0], pods[1], pods[2], pods[3], pods[4]},
+		},
+		{
+			name: "filter pods by labels and expression selectors",
+			selector: v1alpha1.SelectorSpec{
+				LabelSelectors: map[string]string{"l2": "l2"},
+				ExpressionSelectors: v1alpha1.LabelSelectorRequirements{
+					{Key: "l1", Operator: metav1.LabelSelectorOpIn, Values: []string{"l1"}},
+				},
+			},
+			expectedPods: nil,
+		},
 		{
 			name: "filter by specified node",
 			selector: v1alpha1.SelectorSpec{
@@ -164,6 +185,86 @@ func TestCheckPodMeetSelector(t *testing.T) {
 			},
 			expectedValue: false,
 		},
+		{
+			name: "meet expression selectors",
+			pod:  newPod("t1", v1.PodRunning, metav1.NamespaceDefault, nil, map[string]string{"app": "tikv"}, ""),
+			selector: v1alpha1.SelectorSpec{
+				ExpressionSelectors: v1alpha1.LabelSelectorRequirements{
+					{Key: "app", Operator: metav1.LabelSelectorOpIn, Values: []string{"tikv", "pd"}},
+				},
+			},
+			expectedValue: true,
+		},
+		{
+			name: "not meet expression selectors",
+			pod:  newPod("t1", v1.PodRunning, metav1.NamespaceDefault, nil, map[string]string{"app": "tikv"}, ""),
+			selector: v1alpha1.SelectorSpec{
+				ExpressionSelectors: v1alpha1.LabelSelectorRequirements{
+					{Key: "app", Operator: metav1.LabelSelectorOpNotIn, Values: []string{"tikv"}},
+				},
+			},
+			expectedValue: false,
+		},
+		{
+			name: "pod labels is empty and meet expression selectors",
+			pod:  newPod("t1", v1.PodRunning, metav1.NamespaceDefault, nil, nil, ""),
+			selector: v1alpha1.SelectorSpec{
+				ExpressionSelectors: v1alpha1.LabelSelectorRequirements{
+					{Key: "app", Operator: metav1.LabelSelectorOpDoesNotExist},
+				},
+			},
+			expectedValue: true,
+		},
+		{
+			name: "meet workloads",
+			pod:  withOwner(newPod("db-1", v1.PodRunning, metav1.NamespaceDefault, nil, nil, ""), "StatefulSet", "db"),
+			selector: v1alpha1.SelectorSpec{
+				Workloads: []v1alpha1.WorkloadSelector{
+					{Kind: v1alpha1.StatefulSetWorkload, Name: "db", Ordinals: &v1alpha1.OrdinalRange{Start: 1}},
+				},
+			},
+			expectedValue: true,
+		},
+		{
+			name: "not meet workloads",
+			pod:  newPod("db-1", v1.PodRunning, metav1.NamespaceDefault, nil, nil, ""),
+			selector: v1alpha1.SelectorSpec{
+				Workloads: []v1alpha1.WorkloadSelector{
+					{Kind: v1alpha1.StatefulSetWorkload, Name: "db"},
+				},
+			},
+			expectedValue: false,
+		},
+		{
+			name: "pod is protected",
+			pod:  newPod("t1", v1.PodRunning, metav1.NamespaceDefault, map[string]string{v1alpha1.ProtectedAnnotationKey: "true"}, map[string]string{"app": "tikv"}, ""),
+			selector: v1alpha1.SelectorSpec{
+				LabelSelectors: map[string]string{"app": "tikv"},
+			},
+			expectedValue: false,
+		},
+		{
+			name: "meet label and meet exclude",
+			pod:  newPod("t1", v1.PodRunning, metav1.NamespaceDefault, nil, map[string]string{"app": "tikv", "track": "canary"}, ""),
+			selector: v1alpha1.SelectorSpec{
+				LabelSelectors: map[string]string{"app": "tikv"},
+				Exclude: &v1alpha1.SelectorSpec{
+					LabelSelectors: map[string]string{"track": "canary"},
+				},
+			},
+			expectedValue: false,
+		},
+		{
+			name: "meet label and not meet exclude",
+			pod:  newPod("t1", v1.PodRunning, metav1.NamespaceDefault, nil, map[string]string{"app": "tikv"}, ""),
+			selector: v1alpha1.SelectorSpec{
+				LabelSelectors: map[string]string{"app": "tikv"},
+				Exclude: &v1alpha1.SelectorSpec{
+					LabelSelectors: map[string]string{"track": "canary"},
+				},
+			},
+			expectedValue: true,
+		},
 		{
 			name:          "selector is empty",
 			pod:           newPod("t1", v1.PodRunning, metav1.NamespaceDefault, nil, map[string]string{"app": "tidb"}, ""),
@@ -495,15 +596,74 @@ func TestIsAllowedNamespaces(t *testing.T) {
 		common.ControllerCfg.IgnoredNamespaces = ""
 	}
 
+	c := fake.NewFakeClient()
+
 	for _, tc := range tcs {
 		setRule(tc.allow, tc.ignore)
 		for index, pod := range tc.pods {
-			g.Expect(IsAllowedNamespaces(pod.Namespace)).Should(Equal(tc.ret[index]))
+			g.Expect(IsAllowedNamespaces(context.Background(), c, pod.Namespace)).Should(Equal(tc.ret[index]))
 		}
 		clean()
 	}
 }
 
+func TestIsAllowedNamespacesWithFilter(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	enabled := v1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "enabled",
+			Annotations: map[string]string{v1alpha1.NamespaceInjectAnnotationKey: v1alpha1.NamespaceInjectEnabled},
+		},
+	}
+	disabled := v1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "disabled",
+			Annotations: map[string]string{v1alpha1.NamespaceInjectAnnotationKey: "disabled"},
+		},
+	}
+	unannotated := v1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "unannotated",
+		},
+	}
+	pod := newPod("p1", v1.PodRunning, "enabled", nil, map[string]string{"app": "tikv"}, "")
+	pod2 := newPod("p2", v1.PodRunning, "unannotated", nil, map[string]string{"app": "tikv"}, "")
+	c := fake.NewFakeClient(&enabled, &disabled, &unannotated, &pod, &pod2)
+	var r client.Reader
+
+	// the annotation is ignored if the filter is disabled
+	g.Expect(IsAllowedNamespaces(context.Background(), c, "unannotated")).To(BeTrue())
+
+	common.ControllerCfg.EnableFilterNamespace = true
+	defer func() {
+		common.ControllerCfg.EnableFilterNamespace = false
+	}()
+
+	g.Expect(IsAllowedNamespaces(context.Background(), c, "enabled")).To(BeTrue())
+	g.Expect(IsAllowedNamespaces(context.Background(), c, "disabled")).To(BeFalse())
+	g.Expect(IsAllowedNamespaces(context.Background(), c, "unannotated")).To(BeFalse())
+	g.Expect(IsAllowedNamespaces(context.Background(), c, "not-found")).To(BeFalse())
+
+	pods, err := SelectPods(context.Background(), c, r, v1alpha1.SelectorSpec{
+		LabelSelectors: map[string]string{"app": "tikv"},
+	})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(pods).To(ConsistOf(pod))
+
+	// the annotation is checked every time
+	unannotated.Annotations = map[string]string{v1alpha1.NamespaceInjectAnnotationKey: v1alpha1.NamespaceInjectEnabled}
+	g.Expect(c.Update(context.Background(), &unannotated)).To(Succeed())
+	g.Expect(IsAllowedNamespaces(context.Background(), c, "unannotated")).To(BeTrue())
+
+	// the allowed and ignored namespaces still take effect
+	common.ControllerCfg.IgnoredNamespaces = "unannotated"
+	defer func() {
+		common.ControllerCfg.IgnoredNamespaces = ""
+	}()
+	g.Expect(IsAllowedNamespaces(context.Background(), c, "unannotated")).To(BeFalse())
+}
+
 func TestFilterNamespaceSelector(t *testing.T) {
 	g := NewGomegaWithT(t)
 
@@ -617,6 +777,625 @@ func TestFilterPodByNode(t *testing.T) {
 
 }
 
+func TestSelectPodsByWorkloads(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	var objects []runtime.Object
+	var pods []v1.Pod
+	for _, pod := range []v1.Pod{
+		withOwner(newPod("web-5d8f7-abcde", v1.PodRunning, metav1.NamespaceDefault, nil, map[string]string{"pod-template-hash": "5d8f7"}, ""), "ReplicaSet", "web-5d8f7"),
+		withOwner(newPod("web-5d8f7-fghij", v1.PodRunning, metav1.NamespaceDefault, nil, map[string]string{"pod-template-hash": "5d8f7", "track": "canary"}, ""), "ReplicaSet", "web-5d8f7"),
+		withOwner(newPod("db-0", v1.PodRunning, metav1.NamespaceDefault, nil, nil, ""), "StatefulSet", "db"),
+		withOwner(newPod("db-1", v1.PodRunning, metav1.NamespaceDefault, nil, nil, ""), "StatefulSet", "db"),
+		withOwner(newPod("db-2", v1.PodRunning, metav1.NamespaceDefault, nil, nil, ""), "StatefulSet", "db"),
+		withOwner(newPod("db-0", v1.PodRunning, "test-s", nil, nil, ""), "StatefulSet", "db"),
+		newPod("standalone", v1.PodRunning, metav1.NamespaceDefault, nil, nil, ""),
+	} {
+		pod := pod
+		objects = append(objects, &pod)
+		pods = append(pods, pod)
+	}
+
+	c := fake.NewFakeClient(objects...)
+	var r client.Reader
+
+	end := int32(1)
+
+	type TestCase struct {
+		name         string
+		selector     v1alpha1.SelectorSpec
+		expectedPods []v1.Pod
+	}
+
+	tcs := []TestCase{
+		{
+			name: "filter pods of deployment",
+			selector: v1alpha1.SelectorSpec{
+				Workloads: []v1alpha1.WorkloadSelector{
+					{Kind: v1alpha1.DeploymentWorkload, Namespace: metav1.NamespaceDefault, Name: "web"},
+				},
+			},
+			expectedPods: []v1.Pod{pods[0], pods[1]},
+		},
+		{
+			name: "filter pods of deployment and expression selectors",
+			selector: v1alpha1.SelectorSpec{
+				Workloads: []v1alpha1.WorkloadSelector{
+					{Kind: v1alpha1.DeploymentWorkload, Namespace: metav1.NamespaceDefault, Name: "web"},
+				},
+				ExpressionSelectors: v1alpha1.LabelSelectorRequirements{
+					{Key: "track", Operator: metav1.LabelSelectorOpNotIn, Values: []string{"canary"}},
+				},
+			},
+			expectedPods: []v1.Pod{pods[0]},
+		},
+		{
+			name: "filter pods of statefulset",
+			selector: v1alpha1.SelectorSpec{
+				Workloads: []v1alpha1.WorkloadSelector{
+					{Kind: v1alpha1.StatefulSetWorkload, Namespace: metav1.NamespaceDefault, Name: "db"},
+				},
+			},
+			expectedPods: []v1.Pod{pods[2], pods[3], pods[4]},
+		},
+		{
+			name: "filter pods of statefulset with ordinals",
+			selector: v1alpha1.SelectorSpec{
+				Workloads: []v1alpha1.WorkloadSelector{
+					{Kind: v1alpha1.StatefulSetWorkload, Namespace: metav1.NamespaceDefault, Name: "db", Ordinals: &v1alpha1.OrdinalRange{Start: 1}},
+				},
+			},
+			expectedPods: []v1.Pod{pods[3], pods[4]},
+		},
+		{
+			name: "filter pods of statefulsets in different namespaces",
+			selector: v1alpha1.SelectorSpec{
+				Namespaces: []string{metav1.NamespaceDefault},
+				Workloads: []v1alpha1.WorkloadSelector{
+					{Kind: v1alpha1.StatefulSetWorkload, Namespace: metav1.NamespaceDefault, Name: "db", Ordinals: &v1alpha1.OrdinalRange{Start: 0, End: &end}},
+					{Kind: v1alpha1.StatefulSetWorkload, Namespace: "test-s", Name: "db"},
+				},
+			},
+			expectedPods: []v1.Pod{pods[2], pods[3], pods[5]},
+		},
+		{
+			name: "workload not found",
+			selector: v1alpha1.SelectorSpec{
+				Workloads: []v1alpha1.WorkloadSelector{
+					{Kind: v1alpha1.DaemonSetWorkload, Namespace: metav1.NamespaceDefault, Name: "db"},
+				},
+			},
+			expectedPods: nil,
+		},
+	}
+
+	for _, tc := range tcs {
+		filteredPods, err := SelectPods(context.Background(), c, r, tc.selector)
+		g.Expect(err).ShouldNot(HaveOccurred(), tc.name)
+		g.Expect(len(filteredPods)).To(Equal(len(tc.expectedPods)), tc.name)
+		for _, pod := range tc.expectedPods {
+			g.Expect(filteredPods).To(ContainElement(pod), tc.name)
+		}
+	}
+}
+
+func TestIsPodOwnedByWorkload(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type TestCase struct {
+		name          string
+		pod           v1.Pod
+		workload      v1alpha1.WorkloadSelector
+		expectedValue bool
+	}
+
+	tcs := []TestCase{
+		{
+			name:          "owned by deployment",
+			pod:           withOwner(newPod("web-5d8f7-abcde", v1.PodRunning, metav1.NamespaceDefault, nil, map[string]string{"pod-template-hash": "5d8f7"}, ""), "ReplicaSet", "web-5d8f7"),
+			workload:      v1alpha1.WorkloadSelector{Kind: v1alpha1.DeploymentWorkload, Name: "web"},
+			expectedValue: true,
+		},
+		{
+			name:          "owned by another deployment",
+			pod:           withOwner(newPod("web-api-5d8f7-abcde", v1.PodRunning, metav1.NamespaceDefault, nil, map[string]string{"pod-template-hash": "5d8f7"}, ""), "ReplicaSet", "web-api-5d8f7"),
+			workload:      v1alpha1.WorkloadSelector{Kind: v1alpha1.DeploymentWorkload, Name: "web"},
+			expectedValue: false,
+		},
+		{
+			name:          "owned by replicaset",
+			pod:           withOwner(newPod("web-5d8f7-abcde", v1.PodRunning, metav1.NamespaceDefault, nil, nil, ""), "ReplicaSet", "web-5d8f7"),
+			workload:      v1alpha1.WorkloadSelector{Kind: v1alpha1.ReplicaSetWorkload, Name: "web-5d8f7"},
+			expectedValue: true,
+		},
+		{
+			name:          "owned by job",
+			pod:           withOwner(newPod("backup-x7k2p", v1.PodRunning, metav1.NamespaceDefault, nil, nil, ""), "Job", "backup"),
+			workload:      v1alpha1.WorkloadSelector{Kind: v1alpha1.JobWorkload, Name: "backup"},
+			expectedValue: true,
+		},
+		{
+			name:          "owned by workload in another namespace",
+			pod:           withOwner(newPod("agent-x7k2p", v1.PodRunning, metav1.NamespaceDefault, nil, nil, ""), "DaemonSet", "agent"),
+			workload:      v1alpha1.WorkloadSelector{Kind: v1alpha1.DaemonSetWorkload, Namespace: "kube-system", Name: "agent"},
+			expectedValue: false,
+		},
+		{
+			name:          "ordinal out of range",
+			pod:           withOwner(newPod("db-0", v1.PodRunning, metav1.NamespaceDefault, nil, nil, ""), "StatefulSet", "db"),
+			workload:      v1alpha1.WorkloadSelector{Kind: v1alpha1.StatefulSetWorkload, Name: "db", Ordinals: &v1alpha1.OrdinalRange{Start: 1}},
+			expectedValue: false,
+		},
+		{
+			name:          "no owner",
+			pod:           newPod("db-0", v1.PodRunning, metav1.NamespaceDefault, nil, nil, ""),
+			workload:      v1alpha1.WorkloadSelector{Kind: v1alpha1.StatefulSetWorkload, Name: "db"},
+			expectedValue: false,
+		},
+	}
+
+	for _, tc := range tcs {
+		g.Expect(IsPodOwnedByWorkload(tc.pod, tc.workload)).To(Equal(tc.expectedValue), tc.name)
+	}
+}
+
+func TestSelectPodsByComponents(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	mirror := map[string]string{v1.MirrorPodAnnotationKey: "mirror"}
+	var objects []runtime.Object
+	var pods []v1.Pod
+	for _, pod := range []v1.Pod{
+		newPod("kube-apiserver-master0", v1.PodRunning, v1alpha1.KubeSystemNamespace, mirror, map[string]string{"component": "kube-apiserver"}, "master0"),
+		newPod("kube-apiserver-master1", v1.PodRunning, v1alpha1.KubeSystemNamespace, mirror, nil, "master1"),
+		newPod("etcd-master0", v1.PodRunning, v1alpha1.KubeSystemNamespace, mirror, nil, "master0"),
+		withOwner(newPod("coredns-5644d7b6d9-4hbxq", v1.PodRunning, v1alpha1.KubeSystemNamespace, nil, map[string]string{"pod-template-hash": "5644d7b6d9"}, "node0"), "ReplicaSet", "coredns-5644d7b6d9"),
+		newPod("kube-apiserver-proxy", v1.PodRunning, v1alpha1.KubeSystemNamespace, nil, nil, "node0"),
+		newPod("kube-apiserver-master0", v1.PodRunning, metav1.NamespaceDefault, mirror, nil, "master0"),
+	} {
+		pod := pod
+		objects = append(objects, &pod)
+		pods = append(pods, pod)
+	}
+	master0 := newNode("master0", nil)
+	objects = append(objects, &master0)
+
+	c := fake.NewFakeClient(objects...)
+	var r client.Reader
+
+	type TestCase struct {
+		name         string
+		selector     v1alpha1.SelectorSpec
+		expectedPods []v1.Pod
+	}
+
+	tcs := []TestCase{
+		{
+			name: "filter pods of static component",
+			selector: v1alpha1.SelectorSpec{
+				Namespaces: []string{metav1.NamespaceDefault},
+				Components: []v1alpha1.KubeComponent{v1alpha1.KubeAPIServerComponent},
+			},
+			expectedPods: []v1.Pod{pods[0], pods[1]},
+		},
+		{
+			name: "filter pods of components on nodes",
+			selector: v1alpha1.SelectorSpec{
+				Nodes:      []string{"master0"},
+				Components: []v1alpha1.KubeComponent{v1alpha1.KubeAPIServerComponent, v1alpha1.EtcdComponent},
+			},
+			expectedPods: []v1.Pod{pods[0], pods[2]},
+		},
+		{
+			name: "filter pods of coredns",
+			selector: v1alpha1.SelectorSpec{
+				Components: []v1alpha1.KubeComponent{v1alpha1.CoreDNSComponent},
+			},
+			expectedPods: []v1.Pod{pods[3]},
+		},
+		{
+			name: "combine components and workloads",
+			selector: v1alpha1.SelectorSpec{
+				Components: []v1alpha1.KubeComponent{v1alpha1.EtcdComponent, v1alpha1.CoreDNSComponent},
+				Workloads: []v1alpha1.WorkloadSelector{
+					{Kind: v1alpha1.DeploymentWorkload, Namespace: v1alpha1.KubeSystemNamespace, Name: "coredns"},
+				},
+			},
+			expectedPods: []v1.Pod{pods[2], pods[3]},
+		},
+	}
+
+	for _, tc := range tcs {
+		filteredPods, err := SelectPods(context.Background(), c, r, tc.selector)
+		g.Expect(err).ShouldNot(HaveOccurred(), tc.name)
+		g.Expect(len(filteredPods)).To(Equal(len(tc.expectedPods)), tc.name)
+		for _, pod := range tc.expectedPods {
+			g.Expect(filteredPods).To(ContainElement(pod), tc.name)
+		}
+	}
+
+	common.ControllerCfg.IgnoredNamespaces = v1alpha1.KubeSystemNamespace
+	defer func() {
+		common.ControllerCfg.IgnoredNamespaces = ""
+	}()
+	filteredPods, err := SelectPods(context.Background(), c, r, v1alpha1.SelectorSpec{
+		Components: []v1alpha1.KubeComponent{v1alpha1.KubeAPIServerComponent},
+	})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(filteredPods).To(BeEmpty())
+}
+
+func TestSelectAndFilterPodsWithExclude(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	protected := map[string]string{v1alpha1.ProtectedAnnotationKey: "true"}
+	objects, pods := generateNPods("p", 3, v1.PodRunning, metav1.NamespaceDefault, nil, map[string]string{"app": "tikv"}, "")
+	objects2, pods2 := generateNPods("canary", 2, v1.PodRunning, metav1.NamespaceDefault, nil, map[string]string{"app": "tikv", "track": "canary"}, "")
+	objects3, pods3 := generateNPods("protected", 1, v1.PodRunning, metav1.NamespaceDefault, protected, map[string]string{"app": "tikv"}, "")
+
+	objects = append(objects, objects2...)
+	objects = append(objects, objects3...)
+	pods = append(pods, pods2...)
+	pods = append(pods, pods3...)
+
+	c := fake.NewFakeClient(objects...)
+	var r client.Reader
+
+	type TestCase struct {
+		name         string
+		selector     v1alpha1.SelectorSpec
+		expectedPods []v1.Pod
+	}
+
+	tcs := []TestCase{
+		{
+			name: "protected pods are never selected",
+			selector: v1alpha1.SelectorSpec{
+				LabelSelectors: map[string]string{"app": "tikv"},
+			},
+			expectedPods: []v1.Pod{pods[0], pods[1], pods[2], pods[3], pods[4]},
+		},
+		{
+			name: "protected pods are never selected by names",
+			selector: v1alpha1.SelectorSpec{
+				Pods: map[string][]string{
+					metav1.NamespaceDefault: {"p0", "protected0"},
+				},
+			},
+			expectedPods: []v1.Pod{pods[0]},
+		},
+		{
+			name: "exclude pods by labels",
+			selector: v1alpha1.SelectorSpec{
+				Namespaces: []string{metav1.NamespaceDefault},
+				Exclude: &v1alpha1.SelectorSpec{
+					LabelSelectors: map[string]string{"track": "canary"},
+				},
+			},
+			expectedPods: []v1.Pod{pods[0], pods[1], pods[2]},
+		},
+		{
+			name: "exclude pods by names",
+			selector: v1alpha1.SelectorSpec{
+				LabelSelectors: map[string]string{"app": "tikv"},
+				Exclude: &v1alpha1.SelectorSpec{
+					Pods: map[string][]string{
+						metav1.NamespaceDefault: {"p1", "canary0"},
+					},
+				},
+			},
+			expectedPods: []v1.Pod{pods[0], pods[2], pods[4]},
+		},
+	}
+
+	for _, tc := range tcs {
+		spec := &v1alpha1.PodChaosSpec{
+			Selector: tc.selector,
+			Mode:     v1alpha1.AllPodMode,
+		}
+		filteredPods, err := SelectAndFilterPods(context.Background(), c, r, spec, 0)
+		g.Expect(err).ShouldNot(HaveOccurred(), tc.name)
+		g.Expect(filteredPods).To(ConsistOf(tc.expectedPods), tc.name)
+	}
+}
+
+func TestSelectAndFilterPodsWithSeed(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	objects, _ := generateNPods("p", 10, v1.PodRunning, metav1.NamespaceDefault, nil, map[string]string{"app": "tikv"}, "")
+	reversed := make([]runtime.Object, 0, len(objects))
+	for i := len(objects) - 1; i >= 0; i-- {
+		reversed = append(reversed, objects[i])
+	}
+
+	c := fake.NewFakeClient(objects...)
+	c2 := fake.NewFakeClient(reversed...)
+	var r client.Reader
+
+	modes := []struct {
+		mode  v1alpha1.PodMode
+		value string
+	}{
+		{v1alpha1.OnePodMode, ""},
+		{v1alpha1.FixedPodMode, "3"},
+		{v1alpha1.FixedPercentPodMode, "50"},
+		{v1alpha1.RandomMaxPercentPodMode, "80"},
+	}
+
+	for _, m := range modes {
+		spec := &v1alpha1.PodChaosSpec{
+			Mode:  m.mode,
+			Value: m.value,
+		}
+
+		for seed := int64(0); seed < 5; seed++ {
+			pods, err := SelectAndFilterPods(context.Background(), c, r, spec, seed)
+			g.Expect(err).ShouldNot(HaveOccurred(), string(m.mode))
+
+			pods2, err := SelectAndFilterPods(context.Background(), c2, r, spec, seed)
+			g.Expect(err).ShouldNot(HaveOccurred(), string(m.mode))
+			g.Expect(pods2).To(Equal(pods), string(m.mode))
+		}
+	}
+}
+
+func TestFilterPodsByTopologyMode(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	_, az1Nodes := generateNNodes("az1-node", 2, map[string]string{v1.LabelZoneFailureDomainStable: "az1"})
+	_, az2Nodes := generateNNodes("az2-node", 1, map[string]string{v1.LabelZoneFailureDomain: "az2"})
+	_, noZoneNodes := generateNNodes("node", 1, nil)
+	nodes := append(append(az1Nodes, az2Nodes...), noZoneNodes...)
+
+	_, az1Pods0 := generateNPods("az1-node0-p", 3, v1.PodRunning, metav1.NamespaceDefault, nil, nil, "az1-node0")
+	_, az1Pods1 := generateNPods("az1-node1-p", 1, v1.PodRunning, metav1.NamespaceDefault, nil, nil, "az1-node1")
+	_, az2Pods := generateNPods("az2-node0-p", 2, v1.PodRunning, metav1.NamespaceDefault, nil, nil, "az2-node0")
+	_, noZonePods := generateNPods("node0-p", 2, v1.PodRunning, metav1.NamespaceDefault, nil, nil, "node0")
+	az1Pods := append(az1Pods0, az1Pods1...)
+	pods := append(append(append([]v1.Pod{}, az1Pods...), az2Pods...), noZonePods...)
+
+	zoneOf := func(pod v1.Pod) string {
+		switch {
+		case strings.HasPrefix(pod.Spec.NodeName, "az1"):
+			return "az1"
+		case strings.HasPrefix(pod.Spec.NodeName, "az2"):
+			return "az2"
+		}
+		return ""
+	}
+
+	for seed := int64(0); seed < 10; seed++ {
+		filteredPods, err := filterPodsByTopologyMode(pods, nodes, v1alpha1.OnePerZonePodMode, "", rand.New(rand.NewSource(seed)))
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(filteredPods).To(HaveLen(2))
+		g.Expect([]string{zoneOf(filteredPods[0]), zoneOf(filteredPods[1])}).To(Equal([]string{"az1", "az2"}))
+
+		filteredPods, err = filterPodsByTopologyMode(pods, nodes, v1alpha1.AllInOneZonePodMode, "", rand.New(rand.NewSource(seed)))
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(filteredPods).To(Or(Equal(az1Pods), Equal(az2Pods)))
+
+		filteredPods, err = filterPodsByTopologyMode(pods, nodes, v1alpha1.FixedSpreadNodesPodMode, "4", rand.New(rand.NewSource(seed)))
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(filteredPods).To(HaveLen(4))
+		podsOnNode := make(map[string]int)
+		for _, pod := range filteredPods {
+			podsOnNode[pod.Spec.NodeName]++
+		}
+		g.Expect(podsOnNode).To(HaveLen(4))
+
+		filteredPods, err = filterPodsByTopologyMode(pods, nodes, v1alpha1.FixedSpreadNodesPodMode, "100", rand.New(rand.NewSource(seed)))
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(filteredPods).To(ConsistOf(pods))
+	}
+
+	_, err := filterPodsByTopologyMode(noZonePods, nodes, v1alpha1.OnePerZonePodMode, "", rand.New(rand.NewSource(0)))
+	g.Expect(err).Should(HaveOccurred())
+
+	_, err = filterPodsByTopologyMode(pods, nodes, v1alpha1.FixedSpreadNodesPodMode, "0", rand.New(rand.NewSource(0)))
+	g.Expect(err).Should(HaveOccurred())
+}
+
+func TestSelectAndFilterPodsByTopologyMode(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	objects, _ := generateNPods("az1-p", 3, v1.PodRunning, metav1.NamespaceDefault, nil, map[string]string{"app": "tikv"}, "az1-node0")
+	objects2, _ := generateNPods("az2-p", 3, v1.PodRunning, metav1.NamespaceDefault, nil, map[string]string{"app": "tikv"}, "az2-node0")
+	objects3, _ := generateNNodes("az1-node", 1, map[string]string{v1.LabelZoneFailureDomainStable: "az1"})
+	objects4, _ := generateNNodes("az2-node", 1, map[string]string{v1.LabelZoneFailureDomainStable: "az2"})
+	objects = append(objects, objects2...)
+	objects = append(objects, objects3...)
+	objects = append(objects, objects4...)
+
+	c := fake.NewFakeClient(objects...)
+	var r client.Reader
+
+	spec := &v1alpha1.PodChaosSpec{
+		Selector: v1alpha1.SelectorSpec{
+			LabelSelectors: map[string]string{"app": "tikv"},
+		},
+		Mode: v1alpha1.AllInOneZonePodMode,
+	}
+	filteredPods, err := SelectAndFilterPods(context.Background(), c, r, spec, 0)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(filteredPods).To(HaveLen(3))
+	for _, pod := range filteredPods {
+		g.Expect(pod.Spec.NodeName).To(Equal(filteredPods[0].Spec.NodeName))
+	}
+}
+
+func TestSelectTargetsWithSameSelector(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	objects, _ := generateNPods("p", 10, v1.PodRunning, metav1.NamespaceDefault, nil, map[string]string{"app": "tikv"}, "")
+	c := fake.NewFakeClient(objects...)
+	var r client.Reader
+
+	// the sources and the targets of a NetworkChaos are selected by the same selector
+	spec := &v1alpha1.PodChaosSpec{
+		Mode: v1alpha1.OnePodMode,
+		Selector: v1alpha1.SelectorSpec{
+			LabelSelectors: map[string]string{"app": "tikv"},
+		},
+	}
+
+	same := 0
+	for seed := int64(0); seed < 20; seed++ {
+		sources, err := SelectAndFilterPods(context.Background(), c, r, spec, seed)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		targets, err := SelectAndFilterPods(context.Background(), c, r, spec, TargetSeed(seed))
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(sources).To(HaveLen(1))
+		g.Expect(targets).To(HaveLen(1))
+
+		// the targets are still reproducible from the seed
+		again, err := SelectAndFilterPods(context.Background(), c, r, spec, TargetSeed(seed))
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(again).To(Equal(targets))
+
+		if sources[0].Name == targets[0].Name {
+			same++
+		}
+	}
+	// the targets are drawn independently, so they aren't always the same as the sources
+	g.Expect(same).To(BeNumerically("<", 20))
+	g.Expect(TargetSeed(1)).ToNot(Equal(int64(1)))
+}
+
+func TestNewSeed(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	seed := int64(9527)
+	g.Expect(NewSeed(&seed)).To(Equal(seed))
+	g.Expect(NewSeed(nil)).ToNot(BeZero())
+}
+
+func TestSelectPersistentVolumeClaimsWithExclude(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	var objects []runtime.Object
+	var pvcs []v1.PersistentVolumeClaim
+	for _, pvc := range []v1.PersistentVolumeClaim{
+		newPVC("data-0", metav1.NamespaceDefault, nil, map[string]string{"app": "tikv"}),
+		newPVC("data-1", metav1.NamespaceDefault, nil, map[string]string{"app": "tikv"}),
+		newPVC("data-2", metav1.NamespaceDefault, map[string]string{v1alpha1.ProtectedAnnotationKey: "true"}, map[string]string{"app": "tikv"}),
+	} {
+		pvc := pvc
+		objects = append(objects, &pvc)
+		pvcs = append(pvcs, pvc)
+	}
+
+	c := fake.NewFakeClient(objects...)
+	var r client.Reader
+
+	spec := &v1alpha1.PersistentVolumeClaimChaosSpec{
+		Selector: v1alpha1.SelectorSpec{
+			LabelSelectors: map[string]string{"app": "tikv"},
+		},
+		Mode: v1alpha1.PodMode(v1alpha1.AllVolumeMode),
+	}
+	filteredClaims, err := SelectAndFilterPVC(context.Background(), c, r, spec, 0)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(filteredClaims).To(ConsistOf(pvcs[0], pvcs[1]))
+
+	spec.Selector.Exclude = &v1alpha1.SelectorSpec{
+		PersistentVolumeClaims: map[string][]string{
+			metav1.NamespaceDefault: {"data-1"},
+		},
+	}
+	filteredClaims, err = SelectAndFilterPVC(context.Background(), c, r, spec, 0)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(filteredClaims).To(ConsistOf(pvcs[0]))
+}
+
+func TestSelectAndFilterNodes(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	objects, nodes := generateNNodes("tikv-node", 3, map[string]string{"role": "tikv"})
+	objects2, nodes2 := generateNNodes("pd-node", 2, map[string]string{"role": "pd"})
+	protected := newNode("protected-node", map[string]string{"role": "tikv"})
+	protected.Annotations = map[string]string{v1alpha1.ProtectedAnnotationKey: "true"}
+	objects = append(objects, objects2...)
+	objects = append(objects, &protected)
+
+	c := fake.NewFakeClient(objects...)
+
+	type TestCase struct {
+		name          string
+		spec          *v1alpha1.NodeChaosSpec
+		expectedNodes []v1.Node
+	}
+
+	tcs := []TestCase{
+		{
+			name: "select nodes by name",
+			spec: &v1alpha1.NodeChaosSpec{
+				Selector: v1alpha1.SelectorSpec{
+					Nodes: []string{"tikv-node0", "pd-node1", "not-exist-node"},
+				},
+				Mode: v1alpha1.AllPodMode,
+			},
+			expectedNodes: []v1.Node{nodes[0], nodes2[1]},
+		},
+		{
+			name: "select nodes by node selectors",
+			spec: &v1alpha1.NodeChaosSpec{
+				Selector: v1alpha1.SelectorSpec{
+					NodeSelectors: map[string]string{"role": "tikv"},
+				},
+				Mode: v1alpha1.AllPodMode,
+			},
+			expectedNodes: nodes,
+		},
+		{
+			name: "combine nodes and expression selectors",
+			spec: &v1alpha1.NodeChaosSpec{
+				Selector: v1alpha1.SelectorSpec{
+					Nodes: []string{"tikv-node0"},
+					ExpressionSelectors: v1alpha1.LabelSelectorRequirements{
+						{Key: "role", Operator: metav1.LabelSelectorOpIn, Values: []string{"pd"}},
+					},
+				},
+				Mode: v1alpha1.AllPodMode,
+			},
+			expectedNodes: append([]v1.Node{nodes[0]}, nodes2...),
+		},
+	}
+
+	for _, tc := range tcs {
+		filteredNodes, err := SelectAndFilterNodes(context.Background(), c, tc.spec, 0)
+		g.Expect(err).ShouldNot(HaveOccurred(), tc.name)
+		g.Expect(filteredNodes).To(ConsistOf(tc.expectedNodes), tc.name)
+	}
+
+	spec := &v1alpha1.NodeChaosSpec{
+		Selector: v1alpha1.SelectorSpec{
+			NodeSelectors: map[string]string{"role": "tikv"},
+		},
+		Mode:  v1alpha1.FixedPodMode,
+		Value: "2",
+	}
+	filteredNodes, err := SelectAndFilterNodes(context.Background(), c, spec, 9527)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(filteredNodes).To(HaveLen(2))
+	for i := 0; i < 3; i++ {
+		again, err := SelectAndFilterNodes(context.Background(), c, spec, 9527)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(again).To(Equal(filteredNodes))
+	}
+
+	spec.Selector.NodeSelectors = map[string]string{"role": "tidb"}
+	_, err = SelectAndFilterNodes(context.Background(), c, spec, 0)
+	g.Expect(err).Should(HaveOccurred())
+
+	common.ControllerCfg.ClusterScoped = false
+	defer func() {
+		common.ControllerCfg.ClusterScoped = true
+	}()
+	spec.Selector.NodeSelectors = map[string]string{"role": "tikv"}
+	_, err = SelectAndFilterNodes(context.Background(), c, spec, 0)
+	g.Expect(err).To(Equal(ErrNodesNotClusterScoped))
+}
+
 func newPod(
 	name string,
 	status v1.PodPhase,
@@ -665,6 +1444,35 @@ func generateNPods(
 	return podObjects, pods
 }
 
+func newPVC(name string, namespace string, ans map[string]string, ls map[string]string) v1.PersistentVolumeClaim {
+	return v1.PersistentVolumeClaim{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "PersistentVolumeClaim",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   namespace,
+			Labels:      ls,
+			Annotations: ans,
+		},
+	}
+}
+
+func withOwner(pod v1.Pod, kind string, name string) v1.Pod {
+	controller := true
+	pod.OwnerReferences = []metav1.OwnerReference{
+		{
+			APIVersion: "apps/v1",
+			Kind:       kind,
+			Name:       name,
+			Controller: &controller,
+		},
+	}
+
+	return pod
+}
+
 func newNode(
 	name string,
 	label map[string]string,
@@ -0,0 +1,236 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	v1 "k8s.io/api/core/v1"
+	k8serror "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// TargetConflictError means the target is leased by other experiments of the same kind
+type TargetConflictError struct {
+	Namespace string
+	Name      string
+	Kind      string
+	Holders   []string
+	Policy    v1alpha1.ConflictPolicyType
+}
+
+func (e *TargetConflictError) Error() string {
+	if len(e.Holders) == 0 {
+		return fmt.Sprintf("pod %s/%s waits for the other targets leased by %s experiments", e.Namespace, e.Name, e.Kind)
+	}
+	return fmt.Sprintf("pod %s/%s is leased by %s %s", e.Namespace, e.Name, e.Kind, strings.Join(e.Holders, ", "))
+}
+
+// IsTargetQueued returns whether the error means some targets are leased by other experiments,
+// and the chaos waits for them with the Queue policy
+func IsTargetQueued(err error) bool {
+	if merr, ok := err.(*multierror.Error); ok {
+		for _, err := range merr.Errors {
+			if IsTargetQueued(err) {
+				return true
+			}
+		}
+		return false
+	}
+	conflict, ok := err.(*TargetConflictError)
+	return ok && conflict.Policy == v1alpha1.QueueConflictPolicy
+}
+
+// TargetLeaseAnnotationKey returns the key of the pod annotation, which records the experiments of the kind leasing the pod
+func TargetLeaseAnnotationKey(kind string) string {
+	return fmt.Sprintf("%s.org/%s-lease", AnnotationPrefix, strings.ToLower(kind))
+}
+
+// AcquireTargetLeases leases the pods to the chaos, and records the pods leased by other experiments of the same kind
+// in the results. It returns the leased pods, which are refreshed after the leases are recorded in them.
+// With the Queue policy, no pod is leased if any of them is leased by other experiments.
+func AcquireTargetLeases(ctx context.Context, c client.Client, pods []v1.Pod, chaos v1alpha1.ConflictPolicyObject, results *InjectionResults) ([]v1.Pod, error) {
+	return leaseTargets(ctx, c, pods, chaos, results, true)
+}
+
+// CheckTargetLeases is like AcquireTargetLeases, but doesn't lease the pods. It's used by the actions which
+// don't stay on the pods, e.g. killing the pods.
+func CheckTargetLeases(ctx context.Context, c client.Client, pods []v1.Pod, chaos v1alpha1.ConflictPolicyObject, results *InjectionResults) ([]v1.Pod, error) {
+	return leaseTargets(ctx, c, pods, chaos, results, false)
+}
+
+// ReleaseTargetLease removes the chaos from the experiments leasing the pod
+func ReleaseTargetLease(ctx context.Context, c client.Client, pod *v1.Pod, chaos v1alpha1.InnerObject) error {
+	instance := chaos.GetChaos()
+	key := TargetLeaseAnnotationKey(instance.Kind)
+	holder := instance.Namespace + "/" + instance.Name
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if err := c.Get(ctx, types.NamespacedName{Namespace: pod.Namespace, Name: pod.Name}, pod); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+
+		holders := parseHolders(pod.Annotations[key])
+		remaining := removeHolder(holders, holder)
+		if len(remaining) == len(holders) {
+			return nil
+		}
+		if len(remaining) == 0 {
+			delete(pod.Annotations, key)
+		} else {
+			pod.Annotations[key] = strings.Join(remaining, ",")
+		}
+		return client.IgnoreNotFound(c.Update(ctx, pod))
+	})
+}
+
+// OtherTargetLeaseHolders returns the experiments of the same kind leasing the pod besides the chaos,
+// the experiments which have been deleted are not returned
+func OtherTargetLeaseHolders(ctx context.Context, c client.Client, pod *v1.Pod, chaos v1alpha1.InnerObject) ([]string, error) {
+	instance := chaos.GetChaos()
+	key := TargetLeaseAnnotationKey(instance.Kind)
+	holder := instance.Namespace + "/" + instance.Name
+
+	return aliveHolders(ctx, c, instance.Kind, removeHolder(parseHolders(pod.Annotations[key]), holder))
+}
+
+func leaseTargets(ctx context.Context, c client.Client, pods []v1.Pod, chaos v1alpha1.ConflictPolicyObject, results *InjectionResults, hold bool) ([]v1.Pod, error) {
+	policy := chaos.GetConflictPolicy()
+
+	var leased []v1.Pod
+	var conflicts error
+	for i := range pods {
+		pod := pods[i].DeepCopy()
+		err := leaseTarget(ctx, c, pod, chaos, policy, hold)
+		if err != nil {
+			results.Record(pod.Namespace, pod.Name, err)
+			conflicts = multierror.Append(conflicts, err)
+			continue
+		}
+		leased = append(leased, *pod)
+	}
+
+	if IsTargetQueued(conflicts) {
+		for i := range leased {
+			pod := &leased[i]
+			if hold {
+				if err := ReleaseTargetLease(ctx, c, pod, chaos); err != nil {
+					conflicts = multierror.Append(conflicts, err)
+				}
+			}
+			results.Record(pod.Namespace, pod.Name, &TargetConflictError{
+				Namespace: pod.Namespace,
+				Name:      pod.Name,
+				Kind:      chaos.GetChaos().Kind,
+				Policy:    policy,
+			})
+		}
+		return nil, conflicts
+	}
+	return leased, conflicts
+}
+
+func leaseTarget(ctx context.Context, c client.Client, pod *v1.Pod, chaos v1alpha1.InnerObject, policy v1alpha1.ConflictPolicyType, hold bool) error {
+	instance := chaos.GetChaos()
+	key := TargetLeaseAnnotationKey(instance.Kind)
+	holder := instance.Namespace + "/" + instance.Name
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if err := c.Get(ctx, types.NamespacedName{Namespace: pod.Namespace, Name: pod.Name}, pod); err != nil {
+			return err
+		}
+
+		others, err := aliveHolders(ctx, c, instance.Kind, removeHolder(parseHolders(pod.Annotations[key]), holder))
+		if err != nil {
+			return err
+		}
+		if len(others) > 0 && policy != v1alpha1.MergeConflictPolicy {
+			return &TargetConflictError{
+				Namespace: pod.Namespace,
+				Name:      pod.Name,
+				Kind:      instance.Kind,
+				Holders:   others,
+				Policy:    policy,
+			}
+		}
+		if !hold {
+			return nil
+		}
+
+		value := strings.Join(append(others, holder), ",")
+		if pod.Annotations[key] == value {
+			return nil
+		}
+		if pod.Annotations == nil {
+			pod.Annotations = make(map[string]string)
+		}
+		pod.Annotations[key] = value
+		return c.Update(ctx, pod)
+	})
+}
+
+// aliveHolders drops the experiments which have been deleted from the holders, so the leases
+// are not kept forever if their finalizers are removed forcibly
+func aliveHolders(ctx context.Context, c client.Client, kind string, holders []string) ([]string, error) {
+	chaosKind, ok := v1alpha1.AllKinds()[kind]
+	if !ok {
+		return holders, nil
+	}
+
+	var alive []string
+	for _, holder := range holders {
+		ns, name := "", holder
+		if parts := strings.SplitN(holder, "/", 2); len(parts) == 2 {
+			ns, name = parts[0], parts[1]
+		}
+
+		obj := chaosKind.Chaos.DeepCopyObject()
+		err := c.Get(ctx, types.NamespacedName{Namespace: ns, Name: name}, obj)
+		if k8serror.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		alive = append(alive, holder)
+	}
+	return alive, nil
+}
+
+func parseHolders(value string) []string {
+	var holders []string
+	for _, holder := range strings.Split(value, ",") {
+		if holder = strings.TrimSpace(holder); holder != "" {
+			holders = append(holders, holder)
+		}
+	}
+	return holders
+}
+
+func removeHolder(holders []string, holder string) []string {
+	var remaining []string
+	for _, h := range holders {
+		if h != holder {
+			remaining = append(remaining, h)
+		}
+	}
+	return remaining
+}
@@ -0,0 +1,135 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func TestTargetLeases(t *testing.T) {
+	leaseKey := TargetLeaseAnnotationKey(v1alpha1.KindStressChaos)
+
+	newStressChaos := func(name string, policy v1alpha1.ConflictPolicyType) *v1alpha1.StressChaos {
+		return &v1alpha1.StressChaos{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: metav1.NamespaceDefault},
+			Spec:       v1alpha1.StressChaosSpec{ConflictPolicy: policy},
+		}
+	}
+	newPod := func(name, holders string) v1.Pod {
+		pod := v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: metav1.NamespaceDefault}}
+		if holders != "" {
+			pod.Annotations = map[string]string{leaseKey: holders}
+		}
+		return pod
+	}
+	// setup creates a pod "leased" held by the StressChaos "other", and a free pod "free"
+	setup := func(g *WithT, leased string) (client.Client, []v1.Pod) {
+		scheme := runtime.NewScheme()
+		g.Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+		g.Expect(v1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		pods := []v1.Pod{newPod("leased", leased), newPod("free", "")}
+		c := fake.NewFakeClientWithScheme(scheme, newStressChaos("other", ""), &pods[0], &pods[1])
+		return c, pods
+	}
+	holdersOf := func(g *WithT, c client.Client, name string) string {
+		var pod v1.Pod
+		g.Expect(c.Get(context.TODO(), types.NamespacedName{Namespace: metav1.NamespaceDefault, Name: name}, &pod)).To(Succeed())
+		return pod.Annotations[leaseKey]
+	}
+
+	t.Run("reject", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+		c, pods := setup(g, "default/other")
+
+		results := NewInjectionResults(nil)
+		leased, err := AcquireTargetLeases(context.TODO(), c, pods, newStressChaos("stress", v1alpha1.RejectConflictPolicy), results)
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(IsTargetQueued(err)).To(BeFalse())
+		g.Expect(leased).To(HaveLen(1))
+		g.Expect(leased[0].Name).To(Equal("free"))
+		g.Expect(holdersOf(g, c, "free")).To(Equal("default/stress"))
+		g.Expect(holdersOf(g, c, "leased")).To(Equal("default/other"))
+
+		records := []v1alpha1.PodStatus{{Namespace: "default", Name: "leased"}, {Namespace: "default", Name: "free"}}
+		results.Fill(records)
+		g.Expect(records[0].State).To(Equal(v1alpha1.TargetFailed))
+		g.Expect(records[0].Error).To(Equal("pod default/leased is leased by StressChaos default/other"))
+		g.Expect(records[1].State).To(Equal(v1alpha1.TargetInjected))
+	})
+
+	t.Run("queue", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+		c, pods := setup(g, "default/other")
+
+		results := NewInjectionResults(nil)
+		leased, err := AcquireTargetLeases(context.TODO(), c, pods, newStressChaos("stress", v1alpha1.QueueConflictPolicy), results)
+		g.Expect(IsTargetQueued(err)).To(BeTrue())
+		g.Expect(leased).To(BeEmpty())
+		g.Expect(holdersOf(g, c, "free")).To(BeEmpty())
+
+		records := []v1alpha1.PodStatus{{Namespace: "default", Name: "leased"}, {Namespace: "default", Name: "free"}}
+		results.Fill(records)
+		g.Expect(records[0].State).To(Equal(v1alpha1.TargetQueued))
+		g.Expect(records[1].State).To(Equal(v1alpha1.TargetQueued))
+	})
+
+	t.Run("merge", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+		c, pods := setup(g, "default/other")
+
+		chaos := newStressChaos("stress", "")
+		leased, err := AcquireTargetLeases(context.TODO(), c, pods, chaos, NewInjectionResults(nil))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(leased).To(HaveLen(2))
+		g.Expect(holdersOf(g, c, "leased")).To(Equal("default/other,default/stress"))
+
+		g.Expect(ReleaseTargetLease(context.TODO(), c, &leased[0], chaos)).To(Succeed())
+		g.Expect(ReleaseTargetLease(context.TODO(), c, &leased[1], chaos)).To(Succeed())
+		g.Expect(holdersOf(g, c, "leased")).To(Equal("default/other"))
+		g.Expect(holdersOf(g, c, "free")).To(BeEmpty())
+	})
+
+	t.Run("deleted holder", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+		c, pods := setup(g, "default/deleted")
+
+		leased, err := AcquireTargetLeases(context.TODO(), c, pods, newStressChaos("stress", v1alpha1.RejectConflictPolicy), NewInjectionResults(nil))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(leased).To(HaveLen(2))
+		g.Expect(holdersOf(g, c, "leased")).To(Equal("default/stress"))
+	})
+
+	t.Run("check", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+		c, pods := setup(g, "default/other")
+
+		free, err := CheckTargetLeases(context.TODO(), c, pods, newStressChaos("stress", v1alpha1.RejectConflictPolicy), NewInjectionResults(nil))
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(free).To(HaveLen(1))
+		g.Expect(holdersOf(g, c, "free")).To(BeEmpty())
+	})
+}
@@ -140,7 +140,7 @@ func injectRequired(metadata *metav1.ObjectMeta, cli client.Client, cfg *config.
 			return "", false
 		}
 	}
-	if !utils.IsAllowedNamespaces(metadata.Namespace) {
+	if !utils.IsAllowedNamespaces(context.Background(), cli, metadata.Namespace) {
 		log.Info("Skip mutation for it' in special namespace", "name", metadata.Name, "namespace", metadata.Namespace)
 		return "", false
 	}
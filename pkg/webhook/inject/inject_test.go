@@ -18,7 +18,10 @@ import (
 	. "github.com/onsi/gomega"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/common"
 	"github.com/chaos-mesh/chaos-mesh/pkg/webhook/config"
 
 	admissionv1beta1 "k8s.io/api/admission/v1beta1"
@@ -134,6 +137,37 @@ var _ = Describe("webhook inject", func() {
 			_, flag := injectRequired(&metadata, k8sClient, &cfg)
 			Expect(flag).To(Equal(false))
 		})
+
+		It("should only inject the namespaces opting in to chaos", func() {
+			common.ControllerCfg.EnableFilterNamespace = true
+			defer func() {
+				common.ControllerCfg.EnableFilterNamespace = false
+			}()
+
+			cli := fake.NewFakeClient(&corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "enabled",
+					Annotations: map[string]string{v1alpha1.NamespaceInjectAnnotationKey: v1alpha1.NamespaceInjectEnabled},
+				},
+			}, &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "disabled",
+				},
+			})
+			var cfg config.Config
+			cfg.AnnotationNamespace = "testNamespace"
+
+			var metadata metav1.ObjectMeta
+			metadata.Annotations = map[string]string{"testNamespace/request": "test"}
+			metadata.Namespace = "disabled"
+			_, flag := injectRequired(&metadata, cli, &cfg)
+			Expect(flag).To(Equal(false))
+
+			metadata.Namespace = "enabled"
+			str, flag := injectRequired(&metadata, cli, &cfg)
+			Expect(str).To(Equal("test"))
+			Expect(flag).To(Equal(true))
+		})
 	})
 
 	Context("injectByNamespaceRequired", func() {
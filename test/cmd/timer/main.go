@@ -17,6 +17,7 @@ import (
 	"bufio"
 	"fmt"
 	"os"
+	"syscall"
 	"time"
 )
 
@@ -28,6 +29,18 @@ func main() {
 			break
 		}
 
+		if input == "GETTIMEOFDAY" {
+			// syscall.Gettimeofday calls `gettimeofday` in vDSO on amd64
+			var tv syscall.Timeval
+			err := syscall.Gettimeofday(&tv)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "gettimeofday failed: %v\n", err)
+				os.Exit(1)
+			}
+			fmt.Printf("%d %d\n", tv.Sec, tv.Usec*1000)
+			continue
+		}
+
 		t := time.Now()
 		fmt.Printf("%d %d\n", t.Unix(), t.Nanosecond())
 	}
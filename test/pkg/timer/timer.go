@@ -111,6 +111,21 @@ func (timer *Timer) GetTime() (*time.Time, error) {
 	return result.Time, nil
 }
 
+// GetTimeOfDay will run `syscall.Gettimeofday` in timer
+func (timer *Timer) GetTimeOfDay() (*time.Time, error) {
+	_, err := fmt.Fprintf(timer.Stdin, "GETTIMEOFDAY\n")
+	if err != nil {
+		return nil, err
+	}
+
+	result := <-timer.TimeChan
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return result.Time, nil
+}
+
 // Stop stops the process
 func (timer *Timer) Stop() error {
 	_, err := fmt.Fprintf(timer.Stdin, "STOP\n")